@@ -17,7 +17,7 @@ var file_map = map[string]string{
 
 	"clients.baml":       "client<llm> CustomOllama {\n  provider openai-generic\n  options {\n    base_url \"http://localhost:11434/v1\"\n    model \"gpt-oss:120b-cloud\"\n    default_role \"user\" // Most local models prefer the user role\n    // No API key needed for local Ollama\n  }\n}\n\n// Latest Anthropic Claude 4 models\nclient<llm> CustomOpus4 {\n  provider anthropic\n  options {\n    model \"claude-opus-4-1-20250805\"\n    api_key env.ANTHROPIC_API_KEY\n  }\n}\n\nclient<llm> CustomSonnet4 {\n  provider anthropic\n  options {\n    model \"claude-sonnet-4-20250514\"\n    api_key env.ANTHROPIC_API_KEY\n  }\n}\n\nclient<llm> CustomHaiku {\n  provider anthropic\n  retry_policy Constant\n  options {\n    model \"claude-3-5-haiku-20241022\"\n    api_key env.ANTHROPIC_API_KEY\n  }\n}\n\n// https://docs.boundaryml.com/docs/snippets/clients/round-robin\nclient<llm> CustomFast {\n  provider round-robin\n  options {\n    // This will alternate between the two clients\n    strategy [CustomOllama, CustomHaiku]\n  }\n}\n\n// https://docs.boundaryml.com/docs/snippets/clients/fallback\nclient<llm> AnthropicFallback {\n  provider fallback\n  options {\n    // This will try the clients in order until one succeeds\n    strategy [CustomSonnet4, CustomOpus4]\n  }\n}\n\n// https://docs.boundaryml.com/docs/snippets/clients/retry\nretry_policy Constant {\n  max_retries 3\n  strategy {\n    type constant_delay\n    delay_ms 200\n  }\n}\n\nretry_policy Exponential {\n  max_retries 2\n  strategy {\n    type exponential_backoff\n    delay_ms 300\n    multiplier 1.5\n    max_delay_ms 10000\n  }\n}\n",
 	"generators.baml":    "// This helps use auto generate libraries you can use in the language of\n// your choice. You can have multiple generators if you use multiple languages.\n// Just ensure that the output_dir is different for each generator.\ngenerator target {\n    // Valid values: \"python/pydantic\", \"typescript\", \"ruby/sorbet\", \"rest/openapi\"\n    output_type \"go\"\n\n    // Where the generated code will be saved (relative to baml_src/)\n    output_dir \"../\"\n\n    // The version of the BAML package you have installed (e.g. same version as your baml-py or @boundaryml/baml).\n    // The BAML VSCode extension version should also match this version.\n    version \"0.213.0\"\n\n    // 'baml-cli generate' will run this after generating go code\n    // This command will be run from within $output_dir/baml_client\n    on_generate \"gofmt -w . && goimports -w .\"\n\n    // Your Go packages name as specified in go.mod\n    // We need this to generate correct imports in the generated baml_client\n    client_package_name \"github.com/geoffjay/pres\"\n}\n",
-	"presentations.baml": "// Presentation Generation Functions\n// These functions help create, update, and generate presentations using reveal.js\n\n// ============================================================================\n// DATA MODELS\n// ============================================================================\n\n// Represents a single slide in a presentation\nclass Slide {\n  title string @description(\"Slide title, can be empty for title slides\")\n  content string @description(\"Markdown content for the slide\")\n  notes string @description(\"Speaker notes for the slide\")\n  layout string @description(\"Layout type: title, content, two-column, or blank\")\n  background_color string @description(\"Optional background color (e.g., #1a1a1a)\")\n}\n\n// Represents a complete presentation\nclass Presentation {\n  title string @description(\"Presentation title\")\n  subtitle string @description(\"Presentation subtitle\")\n  author string @description(\"Author name\")\n  date string @description(\"Presentation date\")\n  theme string @description(\"reveal.js theme: black, white, league, beige, sky, night, serif, simple, solarized\")\n  slides Slide[] @description(\"Array of slides in the presentation\")\n  tags string[] @description(\"Tags for categorization\")\n}\n\n// Represents contextual questions for gathering information\nclass PresentationQuestion {\n  question string @description(\"The question to ask the user\")\n  help_text string @description(\"Optional help text explaining the question\")\n  iteration int @description(\"Which iteration this question belongs to\")\n}\n\n// Represents the preparation phase for creating/updating a presentation\nclass PresentationPreparation {\n  questions PresentationQuestion[] @description(\"3-5 questions to gather context\")\n  rationale string @description(\"Why these questions will help create a better presentation\")\n  confidence_score float @description(\"Confidence that we have enough information (0.0-1.0)\")\n  confidence_reasoning string @description(\"Why this confidence score was assigned\")\n  needs_more_info bool @description(\"Whether another iteration is recommended\")\n}\n\n// Represents an update operation on an existing presentation\nclass PresentationUpdate {\n  operation string @description(\"Type of update: add_slide, modify_slide, delete_slide, reorder_slides, update_metadata\")\n  slide_index int @description(\"Index of slide to modify/delete (0-based), -1 for add/reorder/metadata operations\")\n  new_slide Slide @description(\"New slide content for add/modify operations\")\n  new_order int[] @description(\"New slide order for reorder operation (array of indices)\")\n  metadata_updates map<string, string> @description(\"Metadata updates for update_metadata operation\")\n  rationale string @description(\"Explanation of the update\")\n}\n\n// ============================================================================\n// PRESENTATION CREATION\n// ============================================================================\n\n// Prepare questions to gather context for creating a presentation\nfunction PrepareCreatePresentation(\n  description: string,\n  iteration: int,\n  previous_responses: string[]\n) -> PresentationPreparation {\n  client CustomHaiku\n  prompt #\"\n    You are helping create a presentation by gathering contextual information.\n\n    Presentation description: {{ description }}\n    Current iteration: {{ iteration }}\n    Max iterations: 3\n\n    {% if previous_responses %}\n    Previous responses from user:\n    {{ previous_responses }}\n    {% endif %}\n\n    Generate 3-5 thoughtful questions that will help gather the information needed\n    to create an effective presentation.\n\n    Iteration focus:\n    - Iteration 0: Audience, purpose, key message, desired outcome\n    - Iteration 1: Main topics, structure, level of detail, time constraints\n    - Iteration 2: Visual preferences, specific examples, supporting data\n\n    Questions should:\n    1. Build on previous responses when provided\n    2. Gather specific information about audience and context\n    3. Understand the key message and takeaways\n    4. Identify the structure and flow\n    5. Determine appropriate depth and complexity\n    6. NOT be redundant with previous iterations\n\n    After generating questions, assign a confidence score (0.0-1.0):\n    - 0.0-0.4: Need much more information\n    - 0.4-0.8: Have basic info, more details would help\n    - 0.8-1.0: Have sufficient information to create presentation\n\n    Consider:\n    - Do we understand the audience and their needs?\n    - Is the main message and structure clear?\n    - Do we have enough detail to create meaningful slides?\n    - Are there gaps that would make the presentation generic?\n\n    Set needs_more_info to true if confidence < 0.8 OR if this is iteration 0 or 1.\n    Set needs_more_info to false if confidence >= 0.8 AND iteration >= 2.\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Generate a complete presentation from user responses\nfunction GeneratePresentation(\n  description: string,\n  qa_responses: string[],\n  today_date: string\n) -> Presentation {\n  client AnthropicFallback\n  prompt #\"\n    You are creating a reveal.js presentation based on user-provided information.\n\n    IMPORTANT: Today's date is {{ today_date }}.\n\n    Presentation description: {{ description }}\n\n    User's responses to contextual questions:\n    {{ qa_responses }}\n\n    Generate a complete, well-structured presentation that:\n    - Creates an engaging title and subtitle\n    - Includes a title slide with author and date\n    - Organizes content into logical, focused slides\n    - Uses appropriate slide layouts (title, content, two-column)\n    - Keeps each slide focused and not overwhelming (3-5 points max per slide)\n    - Uses markdown formatting effectively (lists, emphasis, code blocks)\n    - Includes speaker notes with additional context\n    - Follows presentation best practices:\n      * One main idea per slide\n      * Clear visual hierarchy\n      * Concise bullet points\n      * Smooth narrative flow\n    - Chooses an appropriate reveal.js theme\n    - Suggests relevant tags for categorization\n\n    Available reveal.js themes:\n    - black: Dark background, white text (modern, professional)\n    - white: White background, dark text (clean, minimal)\n    - league: Gray background (neutral, versatile)\n    - beige: Beige background (warm, approachable)\n    - sky: Sky blue background (calm, friendly)\n    - night: Black background with orange highlights (bold, energetic)\n    - serif: Serif fonts (classic, formal)\n    - simple: Simple and minimal (understated)\n    - solarized: Solarized colors (eye-friendly, technical)\n\n    Slide layouts:\n    - title: For section introductions (large centered text)\n    - content: Standard content slide with title and bullet points\n    - two-column: Split content into two columns\n    - blank: Minimal slide for images or quotes\n\n    Use ONLY the information provided by the user. Create 8-15 slides for a\n    complete presentation. Format slide content in markdown.\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// ============================================================================\n// PRESENTATION UPDATES\n// ============================================================================\n\n// Prepare questions to gather context for updating a presentation\nfunction PrepareUpdatePresentation(\n  update_request: string,\n  current_presentation: string,\n  iteration: int,\n  previous_responses: string[]\n) -> PresentationPreparation {\n  client CustomHaiku\n  prompt #\"\n    You are helping update an existing presentation by gathering contextual information.\n\n    Update request: {{ update_request }}\n    Current iteration: {{ iteration }}\n    Max iterations: 3\n\n    Current presentation summary:\n    {{ current_presentation }}\n\n    {% if previous_responses %}\n    Previous responses from user:\n    {{ previous_responses }}\n    {% endif %}\n\n    Generate 2-4 thoughtful questions that will help understand exactly what\n    changes the user wants to make.\n\n    Iteration focus:\n    - Iteration 0: What specifically to change, where in the presentation, why\n    - Iteration 1: Specific content details, placement preferences\n    - Iteration 2: Visual preferences, final clarifications\n\n    Questions should:\n    1. Build on previous responses\n    2. Clarify the specific changes needed\n    3. Understand the rationale for changes\n    4. Determine placement and structure\n    5. NOT be redundant with previous iterations\n\n    Confidence scoring (0.0-1.0):\n    - 0.0-0.4: Don't understand what to change yet\n    - 0.4-0.8: Have general idea, need specific details\n    - 0.8-1.0: Clear on exactly what changes to make\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Generate update operations for an existing presentation\nfunction GenerateUpdateOperations(\n  update_request: string,\n  current_presentation: string,\n  qa_responses: string[]\n) -> PresentationUpdate[] {\n  client AnthropicFallback\n  prompt #\"\n    You are updating an existing presentation based on user requests.\n\n    Update request: {{ update_request }}\n\n    Current presentation:\n    {{ current_presentation }}\n\n    User's responses to contextual questions:\n    {{ qa_responses }}\n\n    Generate the specific update operations needed to fulfill the user's request.\n\n    Available operations:\n    - add_slide: Add a new slide at a specific position\n      * Set slide_index to where to insert (0 = beginning)\n      * Provide complete new_slide content\n    - modify_slide: Change content of an existing slide\n      * Set slide_index to the slide to modify\n      * Provide updated new_slide content\n    - delete_slide: Remove a slide\n      * Set slide_index to the slide to remove\n    - reorder_slides: Change slide order\n      * Provide new_order array with reordered indices\n    - update_metadata: Change presentation title, author, theme, etc.\n      * Provide metadata_updates map with key-value changes\n\n    Guidelines:\n    - Make minimal, focused changes to address the request\n    - Maintain the presentation's overall structure and flow\n    - Ensure slide indices are correct (0-based)\n    - Provide clear rationale for each operation\n    - If adding multiple slides, create separate operations for each\n    - When modifying slides, preserve good formatting and structure\n\n    Return an array of operations to apply in sequence.\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// ============================================================================\n// TESTS\n// ============================================================================\n\ntest prepare_create_iter0 {\n  functions [PrepareCreatePresentation]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    iteration 0\n    previous_responses []\n  }\n}\n\ntest prepare_create_iter1 {\n  functions [PrepareCreatePresentation]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    iteration 1\n    previous_responses [\n      \"Q: Who is your target audience?\\nA: Intermediate Go developers who are new to concurrency\",\n      \"Q: What's the main goal of this presentation?\\nA: Help them understand goroutines, channels, and common patterns\",\n      \"Q: How long should the presentation be?\\nA: About 30 minutes with examples\"\n    ]\n  }\n}\n\ntest generate_presentation {\n  functions [GeneratePresentation]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    qa_responses [\n      \"Q: Who is your target audience?\\nA: Intermediate Go developers new to concurrency\",\n      \"Q: What's the main goal?\\nA: Understand goroutines, channels, and patterns\",\n      \"Q: How long?\\nA: 30 minutes with examples\",\n      \"Q: What level of depth?\\nA: Practical examples, not too theoretical\",\n      \"Q: Any specific patterns to cover?\\nA: Worker pools, fan-out/fan-in, pipelines\"\n    ]\n    today_date \"2025-01-15\"\n  }\n}\n\ntest prepare_update_iter0 {\n  functions [PrepareUpdatePresentation]\n  args {\n    update_request \"Add a slide at the beginning with an executive summary\"\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Slides: 12\n      Topics: Goroutines, Channels, Select, Patterns\n    \"#\n    iteration 0\n    previous_responses []\n  }\n}\n\ntest generate_updates {\n  functions [GenerateUpdateOperations]\n  args {\n    update_request \"Add an executive summary at the beginning and a Q&A slide at the end\"\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Author: John Doe\n      Theme: black\n      Slides:\n      1. Title slide\n      2. What is concurrency?\n      3. Goroutines basics\n      ...\n      12. Conclusion\n    \"#\n    qa_responses [\n      \"Q: What should the executive summary include?\\nA: Key takeaways, who should attend, time estimate\",\n      \"Q: What about the Q&A slide?\\nA: Just a simple slide inviting questions\"\n    ]\n  }\n}\n",
+	"presentations.baml": "// Presentation Generation Functions\n// These functions help create, update, and generate presentations using reveal.js\n\n// ============================================================================\n// DATA MODELS\n// ============================================================================\n\n// Represents a single slide in a presentation\nclass Slide {\n  title string @description(\"Slide title, can be empty for title slides\")\n  content string @description(\"Markdown content for the slide\")\n  notes string @description(\"Speaker notes for the slide\")\n  layout string @description(\"Layout type: title, content, two-column, assertion-evidence, or blank\")\n  background_color string @description(\"Optional background color (e.g., #1a1a1a)\")\n  image_prompt string @description(\"Optional prompt for an illustration generated for the slide, describing the image in a sentence or two (e.g., a photo of a busy data center at night); empty when the slide needs no image\")\n}\n\n// Represents a complete presentation\nclass Presentation {\n  title string @description(\"Presentation title\")\n  subtitle string @description(\"Presentation subtitle\")\n  author string @description(\"Author name\")\n  date string @description(\"Presentation date\")\n  theme string @description(\"reveal.js theme: black, white, league, beige, sky, night, serif, simple, solarized\")\n  slides Slide[] @description(\"Array of slides in the presentation\")\n  tags string[] @description(\"Tags for categorization\")\n}\n\n// Represents contextual questions for gathering information\nclass PresentationQuestion {\n  question string @description(\"The question to ask the user\")\n  help_text string @description(\"Optional help text explaining the question\")\n  iteration int @description(\"Which iteration this question belongs to\")\n  optional bool @description(\"True when the user may reasonably have no answer, e.g. a nice-to-have detail; the user can skip it\")\n  kind string @description(\"How the question is answered: text (free text), choice (pick one of the options), multi_select (pick any of the options), or yes_no\")\n  options string[] @description(\"The options to pick from for choice and multi_select questions; empty for text and yes_no\")\n}\n\n// Represents the preparation phase for creating/updating a presentation\nclass PresentationPreparation {\n  questions PresentationQuestion[] @description(\"3-5 questions to gather context\")\n  rationale string @description(\"Why these questions will help create a better presentation\")\n  confidence_score float @description(\"Confidence that we have enough information (0.0-1.0)\")\n  confidence_reasoning string @description(\"Why this confidence score was assigned\")\n  needs_more_info bool @description(\"Whether another iteration is recommended\")\n}\n\n// Represents an update operation on an existing presentation\nclass PresentationUpdate {\n  operation string @description(\"Type of update: add_slide, modify_slide, delete_slide, reorder_slides, update_metadata\")\n  slide_index int @description(\"Index of slide to insert at/modify/delete (0-based), -1 for reorder/metadata operations\")\n  slide_id string @description(\"ID of the slide to modify/delete as listed in the presentation, empty for other operations or when no ID is listed\")\n  new_slide Slide @description(\"New slide content for add/modify operations\")\n  new_order int[] @description(\"New slide order for reorder operation (array of indices)\")\n  metadata_updates map<string, string> @description(\"Metadata updates for update_metadata operation\")\n  rationale string @description(\"Explanation of the update\")\n}\n\n// Represents a factual claim on a slide that needs a reviewer's attention\nclass FlaggedClaim {\n  slide_index int @description(\"Index of the slide making the claim (0-based)\")\n  claim string @description(\"The claim, quoted or closely paraphrased from the slide\")\n  verdict string @description(\"unverifiable or likely_wrong\")\n  reason string @description(\"Why the claim is suspect and what a reviewer should check\")\n}\n\n// Score given to a generated presentation on one rubric criterion\nclass RubricScore {\n  criterion string @description(\"The criterion, exactly as given in the rubric\")\n  score int @description(\"Score from 1 (poor) to 5 (excellent)\")\n  reason string @description(\"One or two sentences justifying the score\")\n}\n\n// Rubric-based judgement of a generated presentation\nclass PresentationJudgement {\n  scores RubricScore[] @description(\"One score per rubric criterion, in rubric order\")\n  summary string @description(\"The main strengths and weaknesses of the presentation\")\n}\n\n// ============================================================================\n// PRESENTATION CREATION\n// ============================================================================\n\n// Prepare questions to gather context for creating a presentation\nfunction PrepareCreatePresentation(\n  description: string,\n  iteration: int,\n  previous_responses: string[]\n) -> PresentationPreparation {\n  client CustomHaiku\n  prompt #\"\n    You are helping create a presentation by gathering contextual information.\n\n    Presentation description: {{ description }}\n    Current iteration: {{ iteration }}\n    Max iterations: 3\n\n    {% if previous_responses %}\n    Previous responses from user:\n    {{ previous_responses }}\n    {% endif %}\n\n    Generate 3-5 thoughtful questions that will help gather the information needed\n    to create an effective presentation.\n\n    Iteration focus:\n    - Iteration 0: Audience, purpose, key message, desired outcome\n    - Iteration 1: Main topics, structure, level of detail, time constraints\n    - Iteration 2: Visual preferences, specific examples, supporting data\n\n    Questions should:\n    1. Build on previous responses when provided\n    2. Gather specific information about audience and context\n    3. Understand the key message and takeaways\n    4. Identify the structure and flow\n    5. Determine appropriate depth and complexity\n    6. NOT be redundant with previous iterations\n\n    Mark a question optional when the presentation can be made without its\n    answer. A skipped question appears in the previous responses as\n    \"A: (skipped)\"; don't ask it again.\n\n    When the likely answers are a few known options, e.g. the audience's\n    level (beginner, intermediate, expert), make the question a choice (or\n    multi_select, if several may apply) and list the options, so the user\n    can pick rather than type. Use yes_no for questions answered yes or no,\n    and text for everything else.\n\n    After generating questions, assign a confidence score (0.0-1.0):\n    - 0.0-0.4: Need much more information\n    - 0.4-0.8: Have basic info, more details would help\n    - 0.8-1.0: Have sufficient information to create presentation\n\n    Consider:\n    - Do we understand the audience and their needs?\n    - Is the main message and structure clear?\n    - Do we have enough detail to create meaningful slides?\n    - Are there gaps that would make the presentation generic?\n\n    Set needs_more_info to true if confidence < 0.8 OR if this is iteration 0 or 1.\n    Set needs_more_info to false if confidence >= 0.8 AND iteration >= 2.\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Generate a complete presentation from user responses\nfunction GeneratePresentation(\n  description: string,\n  qa_responses: string[],\n  today_date: string\n) -> Presentation {\n  client AnthropicFallback\n  prompt #\"\n    You are creating a reveal.js presentation based on user-provided information.\n\n    IMPORTANT: Today's date is {{ today_date }}.\n\n    Presentation description: {{ description }}\n\n    User's responses to contextual questions:\n    {{ qa_responses }}\n\n    Generate a complete, well-structured presentation that:\n    - Creates an engaging title and subtitle\n    - Includes a title slide with author and date\n    - Organizes content into logical, focused slides\n    - Uses appropriate slide layouts (title, content, two-column)\n    - Keeps each slide focused and not overwhelming (3-5 points max per slide)\n    - Uses markdown formatting effectively (lists, emphasis, code blocks)\n    - Includes speaker notes with additional context\n    - Follows presentation best practices:\n      * One main idea per slide\n      * Clear visual hierarchy\n      * Concise bullet points\n      * Smooth narrative flow\n    - Chooses an appropriate reveal.js theme\n    - Suggests relevant tags for categorization\n    - Gives an image_prompt only to the few slides a picture would genuinely\n      help, such as title slides and assertion-evidence slides, and leaves it\n      empty on the rest; images never contain text\n\n    Available reveal.js themes:\n    - black: Dark background, white text (modern, professional)\n    - white: White background, dark text (clean, minimal)\n    - league: Gray background (neutral, versatile)\n    - beige: Beige background (warm, approachable)\n    - sky: Sky blue background (calm, friendly)\n    - night: Black background with orange highlights (bold, energetic)\n    - serif: Serif fonts (classic, formal)\n    - simple: Simple and minimal (understated)\n    - solarized: Solarized colors (eye-friendly, technical)\n\n    Slide layouts:\n    - title: For section introductions (large centered text)\n    - content: Standard content slide with title and bullet points\n    - two-column: Split content into two columns\n    - assertion-evidence: Full-sentence headline stating the takeaway, with a\n      single supporting visual (image, diagram, chart, table, or code) instead\n      of bullet points\n    - blank: Minimal slide for images or quotes\n\n    Use ONLY the information provided by the user. Create 8-15 slides for a\n    complete presentation. Format slide content in markdown.\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Write one slide of a presentation whose structure is given by an outline\nfunction ExpandOutlineSlide(\n  description: string,\n  outline: string,\n  slide_index: int\n) -> Slide {\n  client AnthropicFallback\n  prompt #\"\n    You are writing a presentation from an outline written by its author. The\n    outline fixes the structure: which slides there are, in what order, and\n    their titles. Your job is only to write the content and speaker notes of\n    one slide.\n\n    Presentation description: {{ description }}\n\n    Outline:\n    {{ outline }}\n\n    Write the slide with index {{ slide_index }} (0-based).\n\n    Guidelines:\n    - Keep the slide's title exactly as written in the outline\n    - Expand the slide's points into content; don't add points the outline\n      doesn't call for, and don't cover what other slides cover\n    - For a section title slide, write at most a short subtitle as content\n    - For other slides, use the content, two-column, or assertion-evidence\n      layout, whichever suits the points best\n    - Keep the content concise (3-5 points at most) and in markdown\n    - Write speaker notes that say what the presenter should tell the audience\n      and lead into the next slide\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// ============================================================================\n// PRESENTATION UPDATES\n// ============================================================================\n\n// Prepare questions to gather context for updating a presentation\nfunction PrepareUpdatePresentation(\n  update_request: string,\n  current_presentation: string,\n  iteration: int,\n  previous_responses: string[]\n) -> PresentationPreparation {\n  client CustomHaiku\n  prompt #\"\n    You are helping update an existing presentation by gathering contextual information.\n\n    Update request: {{ update_request }}\n    Current iteration: {{ iteration }}\n    Max iterations: 3\n\n    Current presentation summary:\n    {{ current_presentation }}\n\n    {% if previous_responses %}\n    Previous responses from user:\n    {{ previous_responses }}\n    {% endif %}\n\n    Generate 2-4 thoughtful questions that will help understand exactly what\n    changes the user wants to make.\n\n    Iteration focus:\n    - Iteration 0: What specifically to change, where in the presentation, why\n    - Iteration 1: Specific content details, placement preferences\n    - Iteration 2: Visual preferences, final clarifications\n\n    Questions should:\n    1. Build on previous responses\n    2. Clarify the specific changes needed\n    3. Understand the rationale for changes\n    4. Determine placement and structure\n    5. NOT be redundant with previous iterations\n\n    Mark a question optional when the update can be made without its answer.\n    A skipped question appears in the previous responses as \"A: (skipped)\";\n    don't ask it again.\n\n    When the likely answers are a few known options, e.g. which slides to\n    change, make the question a choice (or multi_select, if several may\n    apply) and list the options. Use yes_no for questions answered yes or\n    no, and text for everything else.\n\n    Confidence scoring (0.0-1.0):\n    - 0.0-0.4: Don't understand what to change yet\n    - 0.4-0.8: Have general idea, need specific details\n    - 0.8-1.0: Clear on exactly what changes to make\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Generate update operations for an existing presentation\nfunction GenerateUpdateOperations(\n  update_request: string,\n  current_presentation: string,\n  qa_responses: string[]\n) -> PresentationUpdate[] {\n  client AnthropicFallback\n  prompt #\"\n    You are updating an existing presentation based on user requests.\n\n    Update request: {{ update_request }}\n\n    Current presentation:\n    {{ current_presentation }}\n\n    User's responses to contextual questions:\n    {{ qa_responses }}\n\n    Generate the specific update operations needed to fulfill the user's request.\n\n    Available operations:\n    - add_slide: Add a new slide at a specific position\n      * Set slide_index to where to insert (0 = beginning)\n      * Provide complete new_slide content\n    - modify_slide: Change content of an existing slide\n      * Set slide_index to the slide to modify, and slide_id to its ID when listed\n      * Provide updated new_slide content\n    - delete_slide: Remove a slide\n      * Set slide_index to the slide to remove, and slide_id to its ID when listed\n    - reorder_slides: Change slide order\n      * Provide new_order array with reordered indices\n    - update_metadata: Change presentation title, author, theme, etc.\n      * Provide metadata_updates map with key-value changes\n      * Supported keys: title, subtitle, author, date, theme, tags (comma-separated, replaces all tags), event_date (YYYY-MM-DD), venue\n      * Custom metadata fields use keys of the form \"custom.<name>\"\n\n    Guidelines:\n    - Make minimal, focused changes to address the request\n    - Maintain the presentation's overall structure and flow\n    - Never modify or delete slides marked \"Locked: yes\"; add new slides around them instead\n    - Ensure slide indices are correct (0-based)\n    - Provide clear rationale for each operation\n    - If adding multiple slides, create separate operations for each\n    - When modifying slides, preserve good formatting and structure\n\n    Return an array of operations to apply in sequence.\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Plan the cuts that bring a presentation within a time slot\nfunction FitPresentationToDuration(\n  current_presentation: string,\n  timing: string,\n  target_seconds: int\n) -> PresentationUpdate[] {\n  client AnthropicFallback\n  prompt #\"\n    You are trimming a presentation so it can be delivered within its time\n    slot of {{ target_seconds }} seconds.\n\n    Current presentation:\n    {{ current_presentation }}\n\n    How long each slide takes to present:\n    {{ timing }}\n\n    A slide's time is estimated from the words of its speaker notes (or its\n    content when it has no notes) at 130 words per minute, with a minimum of\n    30 seconds (15 for title slides). A slide with a time budget takes its\n    budget however much it is condensed; only deleting or merging it saves\n    its time.\n\n    Generate the operations that bring the total time of the main flow within\n    the slot, cutting no more than needed:\n    - modify_slide to condense a slide: shorten its speaker notes and content\n      to what matters most\n      * Set slide_index to the slide to modify, and slide_id to its ID\n      * Provide the complete condensed new_slide\n    - delete_slide to cut a slide that is least essential to the story, or\n      one whose points were merged into another slide\n      * Set slide_index to the slide to remove, and slide_id to its ID\n    - To merge two slides, modify one to cover both and delete the other\n\n    Guidelines:\n    - Prefer condensing long notes and merging overlapping slides to cutting\n      whole topics\n    - Keep the opening title slide, section title slides, and the conclusion\n    - Never modify or delete slides marked \"Locked: yes\"\n    - Leave hidden (backup) slides alone; they don't count towards the time\n    - Keep slide titles unless a merge calls for a new one\n    - Operations are applied in sequence; after a delete, later slides move\n      up one index, so set slide_id to identify slides\n    - Give each operation a rationale saying what was cut or merged and why\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Generate a new section to append to an existing presentation\nfunction GenerateSection(\n  current_presentation: string,\n  request: string\n) -> Slide[] {\n  client AnthropicFallback\n  prompt #\"\n    You are adding a new section to the end of an existing presentation. The\n    slides already in the presentation stay as they are.\n\n    Presentation:\n    {{ current_presentation }}\n\n    What the new section should cover: {{ request }}\n\n    Write the slides of the new section.\n\n    Guidelines:\n    - Start with a section title slide (layout \"title\") naming the section\n    - Follow on from where the presentation leaves off, matching its tone,\n      depth, and audience\n    - Don't repeat what earlier slides already cover; refer back to them\n      where it helps\n    - Keep each slide concise (3-5 points at most) with speaker notes\n    - Use layouts: title, content, two-column, assertion-evidence, or blank\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Regenerate the slides of one section of a presentation\nfunction RegenerateSection(\n  current_presentation: string,\n  section_title: string,\n  outline: string,\n  latest_context: string\n) -> Slide[] {\n  client AnthropicFallback\n  prompt #\"\n    You are regenerating one section of an existing presentation because part\n    of its story has changed. The rest of the presentation stays as it is.\n\n    Presentation:\n    {{ current_presentation }}\n\n    Section to regenerate: {{ section_title }}\n\n    Outline of the section, one slide per line, in order:\n    {{ outline }}\n\n    Latest context (new facts, data, and instructions that supersede what the\n    section says now):\n    {{ latest_context }}\n\n    Write the slides of the section again, following the outline and bringing\n    them up to date with the latest context.\n\n    Guidelines:\n    - Start with the section's title slide (layout \"title\"), keeping its title\n    - Follow the outline's order and structure; add or drop a slide only when\n      the latest context calls for it\n    - Keep the section consistent with the slides before and after it, and\n      don't repeat what they cover\n    - Keep useful speaker notes, updating them to match the new content\n    - Use layouts: title, content, two-column, assertion-evidence, or blank\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// ============================================================================\n// FACT CHECKING\n// ============================================================================\n\n// Flag factual claims in a presentation that are unverifiable or likely wrong\nfunction FactCheckPresentation(\n  current_presentation: string\n) -> FlaggedClaim[] {\n  client AnthropicFallback\n  prompt #\"\n    You are fact-checking a presentation before it is given.\n\n    Presentation:\n    {{ current_presentation }}\n\n    Extract the factual claims made on the slides: statistics, dates, names,\n    quotes, version numbers, benchmarks, and statements about how things work.\n    Flag only the claims a reviewer should check:\n    - unverifiable: no source is given and the claim is specific enough that\n      it matters whether it is true (e.g. \"80% of teams use X\")\n    - likely_wrong: the claim contradicts what you know, is outdated, or is\n      internally inconsistent with other slides\n\n    Guidelines:\n    - Do not flag opinions, recommendations, or common knowledge\n    - Do not flag claims that cite a source on the slide or in its notes\n    - Use the slide index shown for each slide (0-based)\n    - Keep each reason to one or two sentences saying what to check\n    - Return an empty array when nothing needs checking\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// ============================================================================\n// PER-SLIDE PASSES\n// ============================================================================\n\n// Write the speaker notes for one slide of a presentation\nfunction WriteSpeakerNotes(\n  current_presentation: string,\n  slide_index: int\n) -> string {\n  client AnthropicFallback\n  prompt #\"\n    You are writing the speaker notes for a presentation, one slide at a time.\n\n    Presentation:\n    {{ current_presentation }}\n\n    Write the speaker notes for the slide with index {{ slide_index }} (0-based).\n\n    Guidelines:\n    - Say what the presenter should tell the audience, not what the slide shows\n    - Expand on the slide's points with explanations, examples, and transitions\n    - Lead into the next slide where it helps the flow\n    - Keep to what can be said in one or two minutes\n    - Keep useful points from the slide's existing notes\n    - Write plain prose without headings; return only the notes\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// Rewrite a bullet-heavy slide in the assertion-evidence style\nfunction ConvertToAssertionEvidence(\n  current_presentation: string,\n  slide_index: int\n) -> Slide {\n  client AnthropicFallback\n  prompt #\"\n    You are rewriting a slide of a presentation in the assertion-evidence\n    style used in technical communication: a headline that states the slide's\n    takeaway as a full sentence, supported by a single piece of visual\n    evidence rather than bullet points.\n\n    Presentation:\n    {{ current_presentation }}\n\n    Rewrite the slide with index {{ slide_index }} (0-based).\n\n    Guidelines:\n    - Title: one complete sentence of at most two lines that states the\n      conclusion the audience should draw (e.g. \"Worker pools cap memory use\n      under load\", not \"Worker pools\")\n    - Content: one visual that supports the assertion, in markdown: an image\n      already on the slide, a table, a short code example, or a simple diagram\n      as a fenced code block; no bullet lists\n    - If no visual can be derived from the slide, use a short table or a\n      placeholder image such as ![Chart of ...](placeholder.png) describing\n      the visual to add\n    - Notes: keep the slide's existing notes and add the points from the\n      bullets that no longer appear on the slide, as prose\n    - Layout: assertion-evidence; keep the background color\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// ============================================================================\n// EVALUATION\n// ============================================================================\n\n// Judge a generated presentation against a rubric, for evaluating prompts\n// and models on a fixed suite of descriptions\nfunction JudgePresentation(\n  description: string,\n  rubric: string[],\n  current_presentation: string\n) -> PresentationJudgement {\n  client AnthropicFallback\n  prompt #\"\n    You are reviewing a presentation that was generated from this request:\n    {{ description }}\n\n    Presentation:\n    {{ current_presentation }}\n\n    Score the presentation on each criterion of the rubric, from 1 (poor) to\n    5 (excellent):\n    {% for criterion in rubric %}\n    - {{ criterion }}\n    {% endfor %}\n\n    Guidelines:\n    - Score every criterion, using its text exactly as the criterion name\n    - Judge the presentation as delivered, not what it could become\n    - Be consistent: the same presentation should always get the same scores\n    - Reserve 5 for presentations a reviewer would approve without changes\n\n    {{ ctx.output_format }}\n  \"#\n}\n\n// ============================================================================\n// TESTS\n// ============================================================================\n\ntest prepare_create_iter0 {\n  functions [PrepareCreatePresentation]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    iteration 0\n    previous_responses []\n  }\n}\n\ntest prepare_create_iter1 {\n  functions [PrepareCreatePresentation]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    iteration 1\n    previous_responses [\n      \"Q: Who is your target audience?\\nA: Intermediate Go developers who are new to concurrency\",\n      \"Q: What's the main goal of this presentation?\\nA: Help them understand goroutines, channels, and common patterns\",\n      \"Q: How long should the presentation be?\\nA: About 30 minutes with examples\"\n    ]\n  }\n}\n\ntest generate_presentation {\n  functions [GeneratePresentation]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    qa_responses [\n      \"Q: Who is your target audience?\\nA: Intermediate Go developers new to concurrency\",\n      \"Q: What's the main goal?\\nA: Understand goroutines, channels, and patterns\",\n      \"Q: How long?\\nA: 30 minutes with examples\",\n      \"Q: What level of depth?\\nA: Practical examples, not too theoretical\",\n      \"Q: Any specific patterns to cover?\\nA: Worker pools, fan-out/fan-in, pipelines\"\n    ]\n    today_date \"2025-01-15\"\n  }\n}\n\ntest expand_outline_slide {\n  functions [ExpandOutlineSlide]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    outline #\"\n      Title: Introduction to Go Concurrency\n\n      Slide 1 (index 0, section title slide): Introduction to Go Concurrency\n\n      Slide 2 (index 1, slide): Worker pools\n      - Bound the number of goroutines\n        - Jobs and results channels\n      - Stop with close and a WaitGroup\n\n      Slide 3 (index 2, slide): Pipelines\n      - Stages connected by channels\n    \"#\n    slide_index 1\n  }\n}\n\ntest prepare_update_iter0 {\n  functions [PrepareUpdatePresentation]\n  args {\n    update_request \"Add a slide at the beginning with an executive summary\"\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Slides: 12\n      Topics: Goroutines, Channels, Select, Patterns\n    \"#\n    iteration 0\n    previous_responses []\n  }\n}\n\ntest generate_updates {\n  functions [GenerateUpdateOperations]\n  args {\n    update_request \"Add an executive summary at the beginning and a Q&A slide at the end\"\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Author: John Doe\n      Theme: black\n      Slides:\n      1. Title slide\n      2. What is concurrency?\n      3. Goroutines basics\n      ...\n      12. Conclusion\n    \"#\n    qa_responses [\n      \"Q: What should the executive summary include?\\nA: Key takeaways, who should attend, time estimate\",\n      \"Q: What about the Q&A slide?\\nA: Just a simple slide inviting questions\"\n    ]\n  }\n}\n\ntest generate_section {\n  functions [GenerateSection]\n  args {\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Number of Slides: 3\n\n      Slide 1 (index 0)\n      Title: Introduction to Go Concurrency\n      Layout: title\n      Content:\n\n      Slide 2 (index 1)\n      Title: Goroutines\n      Layout: content\n      Content:\n      - Lightweight threads managed by the runtime\n\n      Slide 3 (index 2)\n      Title: Channels\n      Layout: content\n      Content:\n      - Typed pipes between goroutines\n    \"#\n    request \"add a section about benchmarking concurrent code\"\n  }\n}\n\ntest regenerate_section {\n  functions [RegenerateSection]\n  args {\n    current_presentation #\"\n      Title: Platform Review\n      Number of Slides: 4\n\n      Slide 1 (index 0)\n      Title: Platform Review\n      Layout: title\n      Content:\n\n      Slide 2 (index 1)\n      Title: Performance\n      Layout: title\n      Content:\n\n      Slide 3 (index 2)\n      Title: Latency is within target\n      Layout: content\n      Content:\n      - p99 latency is 180ms against a 250ms target\n\n      Slide 4 (index 3)\n      Title: Next Steps\n      Layout: title\n      Content:\n    \"#\n    section_title \"Performance\"\n    outline #\"\n      1. Performance (title)\n      2. Latency is within target (content)\n    \"#\n    latest_context #\"\n      p99 latency rose to 320ms after the storage migration; a fix ships next week.\n    \"#\n  }\n}\n\ntest fit_presentation_to_duration {\n  functions [FitPresentationToDuration]\n  args {\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Number of Slides: 4\n\n      Slide 1 (index 0)\n      ID: s1\n      Title: Introduction to Go Concurrency\n      Layout: title\n      Content:\n\n      Slide 2 (index 1)\n      ID: s2\n      Title: Goroutines\n      Layout: content\n      Content:\n      - Lightweight threads managed by the runtime\n      Notes:\n      Goroutines start with a small stack that grows as needed, so a program can run hundreds of thousands of them. They are multiplexed onto OS threads by the scheduler, which parks them while they wait on channels or I/O.\n\n      Slide 3 (index 2)\n      ID: s3\n      Title: Starting goroutines\n      Layout: content\n      Content:\n      - The go keyword starts a function in a new goroutine\n      Notes:\n      Any function call can be prefixed with go. The caller does not wait for it, so use a WaitGroup or channel to know when it is done.\n\n      Slide 4 (index 3)\n      ID: s4\n      Title: Questions\n      Layout: title\n      Content:\n    \"#\n    timing #\"\n      Slide 1 (s1): Introduction to Go Concurrency - 0:15\n      Slide 2 (s2): Goroutines - 0:30\n      Slide 3 (s3): Starting goroutines - 0:30\n      Slide 4 (s4): Questions - 0:15\n      Total: 1:30\n    \"#\n    target_seconds 60\n  }\n}\n\ntest factcheck_presentation {\n  functions [FactCheckPresentation]\n  args {\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Number of Slides: 3\n\n      Slide 1 (index 0)\n      Title: Introduction to Go Concurrency\n      Layout: title\n      Content:\n\n      Slide 2 (index 1)\n      Title: Why Go?\n      Layout: content\n      Content:\n      - Go was released by Google in 2005\n      - 90% of cloud-native projects are written in Go\n\n      Slide 3 (index 2)\n      Title: Goroutines\n      Layout: content\n      Content:\n      - Goroutines start with a few kilobytes of stack\n    \"#\n  }\n}\n\ntest write_speaker_notes {\n  functions [WriteSpeakerNotes]\n  args {\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Number of Slides: 2\n\n      Slide 1 (index 0)\n      Title: Introduction to Go Concurrency\n      Layout: title\n      Content:\n\n      Slide 2 (index 1)\n      Title: Goroutines\n      Layout: content\n      Content:\n      - Lightweight threads managed by the Go runtime\n      - Start one with the go keyword\n    \"#\n    slide_index 1\n  }\n}\n\ntest convert_to_assertion_evidence {\n  functions [ConvertToAssertionEvidence]\n  args {\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Number of Slides: 2\n\n      Slide 1 (index 0)\n      Title: Introduction to Go Concurrency\n      Layout: title\n      Content:\n\n      Slide 2 (index 1)\n      Title: Worker Pools\n      Layout: content\n      Content:\n      - A fixed number of goroutines read jobs from a channel\n      - Limits concurrency and memory use\n      - Results are sent on a second channel\n      - Close the jobs channel to stop the workers\n      - Use a WaitGroup to wait for them to finish\n    \"#\n    slide_index 1\n  }\n}\n\ntest judge_presentation {\n  functions [JudgePresentation]\n  args {\n    description \"Introduction to Go concurrency patterns\"\n    rubric [\n      \"Coverage: the slides cover what the request asks for\",\n      \"Structure: the slides follow a clear, logical flow\"\n    ]\n    current_presentation #\"\n      Title: Introduction to Go Concurrency\n      Number of Slides: 2\n\n      Slide 1 (index 0)\n      Title: Introduction to Go Concurrency\n      Layout: title\n      Content:\n\n      Slide 2 (index 1)\n      Title: Goroutines\n      Layout: content\n      Content:\n      - Lightweight threads managed by the Go runtime\n      - Started with the go keyword\n    \"#\n  }\n}\n",
 }
 
 func getBamlFiles() map[string]string {
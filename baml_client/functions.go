@@ -21,6 +21,270 @@ import (
 	"github.com/geoffjay/pres/baml_client/types"
 )
 
+func ConvertToAssertionEvidence(ctx context.Context, current_presentation string, slide_index int64, opts ...CallOptionFunc) (types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"current_presentation": current_presentation, "slide_index": slide_index},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "ConvertToAssertionEvidence", encoded, callOpts.onTick)
+		if err != nil {
+			return types.Slide{}, err
+		}
+
+		if result.Error != nil {
+			return types.Slide{}, result.Error
+		}
+
+		casted := (result.Data).(types.Slide)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "ConvertToAssertionEvidence", encoded, callOpts.onTick)
+		if err != nil {
+			return types.Slide{}, err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return types.Slide{}, result.Error
+			}
+
+			if result.HasData {
+				return result.Data.(types.Slide), nil
+			}
+		}
+
+		return types.Slide{}, fmt.Errorf("No data returned from stream")
+	}
+}
+
+func ExpandOutlineSlide(ctx context.Context, description string, outline string, slide_index int64, opts ...CallOptionFunc) (types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"description": description, "outline": outline, "slide_index": slide_index},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "ExpandOutlineSlide", encoded, callOpts.onTick)
+		if err != nil {
+			return types.Slide{}, err
+		}
+
+		if result.Error != nil {
+			return types.Slide{}, result.Error
+		}
+
+		casted := (result.Data).(types.Slide)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "ExpandOutlineSlide", encoded, callOpts.onTick)
+		if err != nil {
+			return types.Slide{}, err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return types.Slide{}, result.Error
+			}
+
+			if result.HasData {
+				return result.Data.(types.Slide), nil
+			}
+		}
+
+		return types.Slide{}, fmt.Errorf("No data returned from stream")
+	}
+}
+
+func FactCheckPresentation(ctx context.Context, current_presentation string, opts ...CallOptionFunc) ([]types.FlaggedClaim, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"current_presentation": current_presentation},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "FactCheckPresentation", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		casted := (result.Data).([]types.FlaggedClaim)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "FactCheckPresentation", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return nil, result.Error
+			}
+
+			if result.HasData {
+				return result.Data.([]types.FlaggedClaim), nil
+			}
+		}
+
+		return nil, fmt.Errorf("No data returned from stream")
+	}
+}
+
+func FitPresentationToDuration(ctx context.Context, current_presentation string, timing string, target_seconds int64, opts ...CallOptionFunc) ([]types.PresentationUpdate, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"current_presentation": current_presentation, "timing": timing, "target_seconds": target_seconds},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "FitPresentationToDuration", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		casted := (result.Data).([]types.PresentationUpdate)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "FitPresentationToDuration", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return nil, result.Error
+			}
+
+			if result.HasData {
+				return result.Data.([]types.PresentationUpdate), nil
+			}
+		}
+
+		return nil, fmt.Errorf("No data returned from stream")
+	}
+}
+
 func GeneratePresentation(ctx context.Context, description string, qa_responses []string, today_date string, opts ...CallOptionFunc) (types.Presentation, error) {
 
 	var callOpts callOption
@@ -87,6 +351,72 @@ func GeneratePresentation(ctx context.Context, description string, qa_responses
 	}
 }
 
+func GenerateSection(ctx context.Context, current_presentation string, request string, opts ...CallOptionFunc) ([]types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"current_presentation": current_presentation, "request": request},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "GenerateSection", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		casted := (result.Data).([]types.Slide)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "GenerateSection", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return nil, result.Error
+			}
+
+			if result.HasData {
+				return result.Data.([]types.Slide), nil
+			}
+		}
+
+		return nil, fmt.Errorf("No data returned from stream")
+	}
+}
+
 func GenerateUpdateOperations(ctx context.Context, update_request string, current_presentation string, qa_responses []string, opts ...CallOptionFunc) ([]types.PresentationUpdate, error) {
 
 	var callOpts callOption
@@ -153,6 +483,72 @@ func GenerateUpdateOperations(ctx context.Context, update_request string, curren
 	}
 }
 
+func JudgePresentation(ctx context.Context, description string, rubric []string, current_presentation string, opts ...CallOptionFunc) (types.PresentationJudgement, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"description": description, "rubric": rubric, "current_presentation": current_presentation},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "JudgePresentation", encoded, callOpts.onTick)
+		if err != nil {
+			return types.PresentationJudgement{}, err
+		}
+
+		if result.Error != nil {
+			return types.PresentationJudgement{}, result.Error
+		}
+
+		casted := (result.Data).(types.PresentationJudgement)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "JudgePresentation", encoded, callOpts.onTick)
+		if err != nil {
+			return types.PresentationJudgement{}, err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return types.PresentationJudgement{}, result.Error
+			}
+
+			if result.HasData {
+				return result.Data.(types.PresentationJudgement), nil
+			}
+		}
+
+		return types.PresentationJudgement{}, fmt.Errorf("No data returned from stream")
+	}
+}
+
 func PrepareCreatePresentation(ctx context.Context, description string, iteration int64, previous_responses []string, opts ...CallOptionFunc) (types.PresentationPreparation, error) {
 
 	var callOpts callOption
@@ -284,3 +680,135 @@ func PrepareUpdatePresentation(ctx context.Context, update_request string, curre
 		return types.PresentationPreparation{}, fmt.Errorf("No data returned from stream")
 	}
 }
+
+func RegenerateSection(ctx context.Context, current_presentation string, section_title string, outline string, latest_context string, opts ...CallOptionFunc) ([]types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"current_presentation": current_presentation, "section_title": section_title, "outline": outline, "latest_context": latest_context},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "RegenerateSection", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		casted := (result.Data).([]types.Slide)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "RegenerateSection", encoded, callOpts.onTick)
+		if err != nil {
+			return nil, err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return nil, result.Error
+			}
+
+			if result.HasData {
+				return result.Data.([]types.Slide), nil
+			}
+		}
+
+		return nil, fmt.Errorf("No data returned from stream")
+	}
+}
+
+func WriteSpeakerNotes(ctx context.Context, current_presentation string, slide_index int64, opts ...CallOptionFunc) (string, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"current_presentation": current_presentation, "slide_index": slide_index},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		panic(err)
+	}
+
+	if callOpts.onTick == nil {
+		result, err := bamlRuntime.CallFunction(ctx, "WriteSpeakerNotes", encoded, callOpts.onTick)
+		if err != nil {
+			return "", err
+		}
+
+		if result.Error != nil {
+			return "", result.Error
+		}
+
+		casted := (result.Data).(string)
+
+		return casted, nil
+	} else {
+		channel, err := bamlRuntime.CallFunctionStream(ctx, "WriteSpeakerNotes", encoded, callOpts.onTick)
+		if err != nil {
+			return "", err
+		}
+
+		for result := range channel {
+			if result.Error != nil {
+				return "", result.Error
+			}
+
+			if result.HasData {
+				return result.Data.(string), nil
+			}
+		}
+
+		return "", fmt.Errorf("No data returned from stream")
+	}
+}
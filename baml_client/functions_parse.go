@@ -25,6 +25,194 @@ type parse struct{}
 
 var Parse = &parse{}
 
+// / Parse version of ConvertToAssertionEvidence (Takes in string and returns types.Slide)
+func (*parse) ConvertToAssertionEvidence(text string, opts ...CallOptionFunc) (types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: ConvertToAssertionEvidence: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "ConvertToAssertionEvidence", encoded)
+	if err != nil {
+		return types.Slide{}, err
+	}
+
+	casted := (result).(types.Slide)
+
+	return casted, nil
+}
+
+// / Parse version of ExpandOutlineSlide (Takes in string and returns types.Slide)
+func (*parse) ExpandOutlineSlide(text string, opts ...CallOptionFunc) (types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: ExpandOutlineSlide: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "ExpandOutlineSlide", encoded)
+	if err != nil {
+		return types.Slide{}, err
+	}
+
+	casted := (result).(types.Slide)
+
+	return casted, nil
+}
+
+// / Parse version of FactCheckPresentation (Takes in string and returns []types.FlaggedClaim)
+func (*parse) FactCheckPresentation(text string, opts ...CallOptionFunc) ([]types.FlaggedClaim, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: FactCheckPresentation: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "FactCheckPresentation", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]types.FlaggedClaim)
+
+	return casted, nil
+}
+
+// / Parse version of FitPresentationToDuration (Takes in string and returns []types.PresentationUpdate)
+func (*parse) FitPresentationToDuration(text string, opts ...CallOptionFunc) ([]types.PresentationUpdate, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: FitPresentationToDuration: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "FitPresentationToDuration", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]types.PresentationUpdate)
+
+	return casted, nil
+}
+
 // / Parse version of GeneratePresentation (Takes in string and returns types.Presentation)
 func (*parse) GeneratePresentation(text string, opts ...CallOptionFunc) (types.Presentation, error) {
 
@@ -72,6 +260,53 @@ func (*parse) GeneratePresentation(text string, opts ...CallOptionFunc) (types.P
 	return casted, nil
 }
 
+// / Parse version of GenerateSection (Takes in string and returns []types.Slide)
+func (*parse) GenerateSection(text string, opts ...CallOptionFunc) ([]types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: GenerateSection: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "GenerateSection", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]types.Slide)
+
+	return casted, nil
+}
+
 // / Parse version of GenerateUpdateOperations (Takes in string and returns []types.PresentationUpdate)
 func (*parse) GenerateUpdateOperations(text string, opts ...CallOptionFunc) ([]types.PresentationUpdate, error) {
 
@@ -119,6 +354,53 @@ func (*parse) GenerateUpdateOperations(text string, opts ...CallOptionFunc) ([]t
 	return casted, nil
 }
 
+// / Parse version of JudgePresentation (Takes in string and returns types.PresentationJudgement)
+func (*parse) JudgePresentation(text string, opts ...CallOptionFunc) (types.PresentationJudgement, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: JudgePresentation: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "JudgePresentation", encoded)
+	if err != nil {
+		return types.PresentationJudgement{}, err
+	}
+
+	casted := (result).(types.PresentationJudgement)
+
+	return casted, nil
+}
+
 // / Parse version of PrepareCreatePresentation (Takes in string and returns types.PresentationPreparation)
 func (*parse) PrepareCreatePresentation(text string, opts ...CallOptionFunc) (types.PresentationPreparation, error) {
 
@@ -212,3 +494,97 @@ func (*parse) PrepareUpdatePresentation(text string, opts ...CallOptionFunc) (ty
 
 	return casted, nil
 }
+
+// / Parse version of RegenerateSection (Takes in string and returns []types.Slide)
+func (*parse) RegenerateSection(text string, opts ...CallOptionFunc) ([]types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: RegenerateSection: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "RegenerateSection", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]types.Slide)
+
+	return casted, nil
+}
+
+// / Parse version of WriteSpeakerNotes (Takes in string and returns string)
+func (*parse) WriteSpeakerNotes(text string, opts ...CallOptionFunc) (string, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": false},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: WriteSpeakerNotes: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "WriteSpeakerNotes", encoded)
+	if err != nil {
+		return "", err
+	}
+
+	casted := (result).(string)
+
+	return casted, nil
+}
@@ -25,6 +25,194 @@ type parse_stream struct{}
 
 var ParseStream = &parse_stream{}
 
+// / Parse version of ConvertToAssertionEvidence (Takes in string and returns stream_types.Slide)
+func (*parse_stream) ConvertToAssertionEvidence(text string, opts ...CallOptionFunc) (stream_types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: ConvertToAssertionEvidence: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "ConvertToAssertionEvidence", encoded)
+	if err != nil {
+		return stream_types.Slide{}, err
+	}
+
+	casted := (result).(stream_types.Slide)
+
+	return casted, nil
+}
+
+// / Parse version of ExpandOutlineSlide (Takes in string and returns stream_types.Slide)
+func (*parse_stream) ExpandOutlineSlide(text string, opts ...CallOptionFunc) (stream_types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: ExpandOutlineSlide: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "ExpandOutlineSlide", encoded)
+	if err != nil {
+		return stream_types.Slide{}, err
+	}
+
+	casted := (result).(stream_types.Slide)
+
+	return casted, nil
+}
+
+// / Parse version of FactCheckPresentation (Takes in string and returns []stream_types.FlaggedClaim)
+func (*parse_stream) FactCheckPresentation(text string, opts ...CallOptionFunc) ([]stream_types.FlaggedClaim, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: FactCheckPresentation: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "FactCheckPresentation", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]stream_types.FlaggedClaim)
+
+	return casted, nil
+}
+
+// / Parse version of FitPresentationToDuration (Takes in string and returns []stream_types.PresentationUpdate)
+func (*parse_stream) FitPresentationToDuration(text string, opts ...CallOptionFunc) ([]stream_types.PresentationUpdate, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: FitPresentationToDuration: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "FitPresentationToDuration", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]stream_types.PresentationUpdate)
+
+	return casted, nil
+}
+
 // / Parse version of GeneratePresentation (Takes in string and returns stream_types.Presentation)
 func (*parse_stream) GeneratePresentation(text string, opts ...CallOptionFunc) (stream_types.Presentation, error) {
 
@@ -72,6 +260,53 @@ func (*parse_stream) GeneratePresentation(text string, opts ...CallOptionFunc) (
 	return casted, nil
 }
 
+// / Parse version of GenerateSection (Takes in string and returns []stream_types.Slide)
+func (*parse_stream) GenerateSection(text string, opts ...CallOptionFunc) ([]stream_types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: GenerateSection: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "GenerateSection", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]stream_types.Slide)
+
+	return casted, nil
+}
+
 // / Parse version of GenerateUpdateOperations (Takes in string and returns []stream_types.PresentationUpdate)
 func (*parse_stream) GenerateUpdateOperations(text string, opts ...CallOptionFunc) ([]stream_types.PresentationUpdate, error) {
 
@@ -119,6 +354,53 @@ func (*parse_stream) GenerateUpdateOperations(text string, opts ...CallOptionFun
 	return casted, nil
 }
 
+// / Parse version of JudgePresentation (Takes in string and returns stream_types.PresentationJudgement)
+func (*parse_stream) JudgePresentation(text string, opts ...CallOptionFunc) (stream_types.PresentationJudgement, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: JudgePresentation: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "JudgePresentation", encoded)
+	if err != nil {
+		return stream_types.PresentationJudgement{}, err
+	}
+
+	casted := (result).(stream_types.PresentationJudgement)
+
+	return casted, nil
+}
+
 // / Parse version of PrepareCreatePresentation (Takes in string and returns stream_types.PresentationPreparation)
 func (*parse_stream) PrepareCreatePresentation(text string, opts ...CallOptionFunc) (stream_types.PresentationPreparation, error) {
 
@@ -212,3 +494,97 @@ func (*parse_stream) PrepareUpdatePresentation(text string, opts ...CallOptionFu
 
 	return casted, nil
 }
+
+// / Parse version of RegenerateSection (Takes in string and returns []stream_types.Slide)
+func (*parse_stream) RegenerateSection(text string, opts ...CallOptionFunc) ([]stream_types.Slide, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: RegenerateSection: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "RegenerateSection", encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	casted := (result).([]stream_types.Slide)
+
+	return casted, nil
+}
+
+// / Parse version of WriteSpeakerNotes (Takes in string and returns string)
+func (*parse_stream) WriteSpeakerNotes(text string, opts ...CallOptionFunc) (string, error) {
+
+	var callOpts callOption
+	for _, opt := range opts {
+		opt(&callOpts)
+	}
+
+	args := baml.BamlFunctionArguments{
+		Kwargs: map[string]any{"text": text, "stream": true},
+		Env:    getEnvVars(callOpts.env),
+	}
+
+	if callOpts.clientRegistry != nil {
+		args.ClientRegistry = callOpts.clientRegistry
+	}
+
+	if callOpts.collectors != nil {
+		args.Collectors = callOpts.collectors
+	}
+
+	if callOpts.typeBuilder != nil {
+		args.TypeBuilder = callOpts.typeBuilder
+	}
+
+	if callOpts.tags != nil {
+		args.Tags = callOpts.tags
+	}
+
+	encoded, err := args.Encode()
+	if err != nil {
+		// This should never happen. if it does, please file an issue at https://github.com/boundaryml/baml/issues
+		// and include the type of the args you're passing in.
+		wrapped_err := fmt.Errorf("BAML INTERNAL ERROR: WriteSpeakerNotes: %w", err)
+		panic(wrapped_err)
+	}
+
+	result, err := bamlRuntime.CallFunctionParse(context.Background(), "WriteSpeakerNotes", encoded)
+	if err != nil {
+		return "", err
+	}
+
+	casted := (result).(string)
+
+	return casted, nil
+}
@@ -20,6 +20,73 @@ import (
 	"github.com/boundaryml/baml/engine/language_client_go/pkg/cffi"
 )
 
+type FlaggedClaim struct {
+	Slide_index *int64  `json:"slide_index"`
+	Claim       *string `json:"claim"`
+	Verdict     *string `json:"verdict"`
+	Reason      *string `json:"reason"`
+}
+
+func (c *FlaggedClaim) Decode(holder *cffi.CFFIValueClass, typeMap baml.TypeMap) {
+	typeName := holder.Name
+	if typeName.Namespace != cffi.CFFITypeNamespace_STREAM_TYPES {
+		panic(fmt.Sprintf("expected cffi.CFFITypeNamespace_STREAM_TYPES, got %s", string(typeName.Namespace.String())))
+	}
+	if typeName.Name != "FlaggedClaim" {
+		panic(fmt.Sprintf("expected FlaggedClaim, got %s", typeName.Name))
+	}
+
+	for _, field := range holder.Fields {
+		key := field.Key
+		valueHolder := field.Value
+		switch key {
+
+		case "slide_index":
+			c.Slide_index = baml.Decode(valueHolder).Interface().(*int64)
+
+		case "claim":
+			c.Claim = baml.Decode(valueHolder).Interface().(*string)
+
+		case "verdict":
+			c.Verdict = baml.Decode(valueHolder).Interface().(*string)
+
+		case "reason":
+			c.Reason = baml.Decode(valueHolder).Interface().(*string)
+
+		default:
+
+			panic(fmt.Sprintf("unexpected field: %s in class FlaggedClaim", key))
+
+		}
+	}
+
+}
+
+func (c FlaggedClaim) Encode() (*cffi.CFFIValueHolder, error) {
+	fields := map[string]any{}
+
+	fields["slide_index"] = c.Slide_index
+
+	fields["claim"] = c.Claim
+
+	fields["verdict"] = c.Verdict
+
+	fields["reason"] = c.Reason
+
+	return baml.EncodeClass(c.BamlEncodeName, fields, nil)
+}
+
+func (c FlaggedClaim) BamlTypeName() string {
+	return "FlaggedClaim"
+}
+
+func (u FlaggedClaim) BamlEncodeName() *cffi.CFFITypeName {
+	return &cffi.CFFITypeName{
+		Namespace: cffi.CFFITypeNamespace_STREAM_TYPES,
+		Name:      "FlaggedClaim",
+	}
+}
+
 type Presentation struct {
 	Title    *string  `json:"title"`
 	Subtitle *string  `json:"subtitle"`
@@ -105,6 +172,61 @@ func (u Presentation) BamlEncodeName() *cffi.CFFITypeName {
 	}
 }
 
+type PresentationJudgement struct {
+	Scores  []RubricScore `json:"scores"`
+	Summary *string       `json:"summary"`
+}
+
+func (c *PresentationJudgement) Decode(holder *cffi.CFFIValueClass, typeMap baml.TypeMap) {
+	typeName := holder.Name
+	if typeName.Namespace != cffi.CFFITypeNamespace_STREAM_TYPES {
+		panic(fmt.Sprintf("expected cffi.CFFITypeNamespace_STREAM_TYPES, got %s", string(typeName.Namespace.String())))
+	}
+	if typeName.Name != "PresentationJudgement" {
+		panic(fmt.Sprintf("expected PresentationJudgement, got %s", typeName.Name))
+	}
+
+	for _, field := range holder.Fields {
+		key := field.Key
+		valueHolder := field.Value
+		switch key {
+
+		case "scores":
+			c.Scores = baml.Decode(valueHolder).Interface().([]RubricScore)
+
+		case "summary":
+			c.Summary = baml.Decode(valueHolder).Interface().(*string)
+
+		default:
+
+			panic(fmt.Sprintf("unexpected field: %s in class PresentationJudgement", key))
+
+		}
+	}
+
+}
+
+func (c PresentationJudgement) Encode() (*cffi.CFFIValueHolder, error) {
+	fields := map[string]any{}
+
+	fields["scores"] = c.Scores
+
+	fields["summary"] = c.Summary
+
+	return baml.EncodeClass(c.BamlEncodeName, fields, nil)
+}
+
+func (c PresentationJudgement) BamlTypeName() string {
+	return "PresentationJudgement"
+}
+
+func (u PresentationJudgement) BamlEncodeName() *cffi.CFFITypeName {
+	return &cffi.CFFITypeName{
+		Namespace: cffi.CFFITypeNamespace_STREAM_TYPES,
+		Name:      "PresentationJudgement",
+	}
+}
+
 type PresentationPreparation struct {
 	Questions            []PresentationQuestion `json:"questions"`
 	Rationale            *string                `json:"rationale"`
@@ -179,9 +301,12 @@ func (u PresentationPreparation) BamlEncodeName() *cffi.CFFITypeName {
 }
 
 type PresentationQuestion struct {
-	Question  *string `json:"question"`
-	Help_text *string `json:"help_text"`
-	Iteration *int64  `json:"iteration"`
+	Question  *string  `json:"question"`
+	Help_text *string  `json:"help_text"`
+	Iteration *int64   `json:"iteration"`
+	Optional  *bool    `json:"optional"`
+	Kind      *string  `json:"kind"`
+	Options   []string `json:"options"`
 }
 
 func (c *PresentationQuestion) Decode(holder *cffi.CFFIValueClass, typeMap baml.TypeMap) {
@@ -207,6 +332,15 @@ func (c *PresentationQuestion) Decode(holder *cffi.CFFIValueClass, typeMap baml.
 		case "iteration":
 			c.Iteration = baml.Decode(valueHolder).Interface().(*int64)
 
+		case "optional":
+			c.Optional = baml.Decode(valueHolder).Interface().(*bool)
+
+		case "kind":
+			c.Kind = baml.Decode(valueHolder).Interface().(*string)
+
+		case "options":
+			c.Options = baml.Decode(valueHolder).Interface().([]string)
+
 		default:
 
 			panic(fmt.Sprintf("unexpected field: %s in class PresentationQuestion", key))
@@ -225,6 +359,12 @@ func (c PresentationQuestion) Encode() (*cffi.CFFIValueHolder, error) {
 
 	fields["iteration"] = c.Iteration
 
+	fields["optional"] = c.Optional
+
+	fields["kind"] = c.Kind
+
+	fields["options"] = c.Options
+
 	return baml.EncodeClass(c.BamlEncodeName, fields, nil)
 }
 
@@ -242,6 +382,7 @@ func (u PresentationQuestion) BamlEncodeName() *cffi.CFFITypeName {
 type PresentationUpdate struct {
 	Operation        *string           `json:"operation"`
 	Slide_index      *int64            `json:"slide_index"`
+	Slide_id         *string           `json:"slide_id"`
 	New_slide        *Slide            `json:"new_slide"`
 	New_order        []int64           `json:"new_order"`
 	Metadata_updates map[string]string `json:"metadata_updates"`
@@ -268,6 +409,9 @@ func (c *PresentationUpdate) Decode(holder *cffi.CFFIValueClass, typeMap baml.Ty
 		case "slide_index":
 			c.Slide_index = baml.Decode(valueHolder).Interface().(*int64)
 
+		case "slide_id":
+			c.Slide_id = baml.Decode(valueHolder).Interface().(*string)
+
 		case "new_slide":
 			c.New_slide = baml.Decode(valueHolder).Interface().(*Slide)
 
@@ -296,6 +440,8 @@ func (c PresentationUpdate) Encode() (*cffi.CFFIValueHolder, error) {
 
 	fields["slide_index"] = c.Slide_index
 
+	fields["slide_id"] = c.Slide_id
+
 	fields["new_slide"] = c.New_slide
 
 	fields["new_order"] = c.New_order
@@ -318,12 +464,74 @@ func (u PresentationUpdate) BamlEncodeName() *cffi.CFFITypeName {
 	}
 }
 
+type RubricScore struct {
+	Criterion *string `json:"criterion"`
+	Score     *int64  `json:"score"`
+	Reason    *string `json:"reason"`
+}
+
+func (c *RubricScore) Decode(holder *cffi.CFFIValueClass, typeMap baml.TypeMap) {
+	typeName := holder.Name
+	if typeName.Namespace != cffi.CFFITypeNamespace_STREAM_TYPES {
+		panic(fmt.Sprintf("expected cffi.CFFITypeNamespace_STREAM_TYPES, got %s", string(typeName.Namespace.String())))
+	}
+	if typeName.Name != "RubricScore" {
+		panic(fmt.Sprintf("expected RubricScore, got %s", typeName.Name))
+	}
+
+	for _, field := range holder.Fields {
+		key := field.Key
+		valueHolder := field.Value
+		switch key {
+
+		case "criterion":
+			c.Criterion = baml.Decode(valueHolder).Interface().(*string)
+
+		case "score":
+			c.Score = baml.Decode(valueHolder).Interface().(*int64)
+
+		case "reason":
+			c.Reason = baml.Decode(valueHolder).Interface().(*string)
+
+		default:
+
+			panic(fmt.Sprintf("unexpected field: %s in class RubricScore", key))
+
+		}
+	}
+
+}
+
+func (c RubricScore) Encode() (*cffi.CFFIValueHolder, error) {
+	fields := map[string]any{}
+
+	fields["criterion"] = c.Criterion
+
+	fields["score"] = c.Score
+
+	fields["reason"] = c.Reason
+
+	return baml.EncodeClass(c.BamlEncodeName, fields, nil)
+}
+
+func (c RubricScore) BamlTypeName() string {
+	return "RubricScore"
+}
+
+func (u RubricScore) BamlEncodeName() *cffi.CFFITypeName {
+	return &cffi.CFFITypeName{
+		Namespace: cffi.CFFITypeNamespace_STREAM_TYPES,
+		Name:      "RubricScore",
+	}
+}
+
 type Slide struct {
 	Title            *string `json:"title"`
 	Content          *string `json:"content"`
 	Notes            *string `json:"notes"`
 	Layout           *string `json:"layout"`
 	Background_color *string `json:"background_color"`
+	Image_prompt     *string `json:"image_prompt"`
 }
 
 func (c *Slide) Decode(holder *cffi.CFFIValueClass, typeMap baml.TypeMap) {
@@ -355,6 +563,9 @@ func (c *Slide) Decode(holder *cffi.CFFIValueClass, typeMap baml.TypeMap) {
 		case "background_color":
 			c.Background_color = baml.Decode(valueHolder).Interface().(*string)
 
+		case "image_prompt":
+			c.Image_prompt = baml.Decode(valueHolder).Interface().(*string)
+
 		default:
 
 			panic(fmt.Sprintf("unexpected field: %s in class Slide", key))
@@ -377,6 +588,8 @@ func (c Slide) Encode() (*cffi.CFFIValueHolder, error) {
 
 	fields["background_color"] = c.Background_color
 
+	fields["image_prompt"] = c.Image_prompt
+
 	return baml.EncodeClass(c.BamlEncodeName, fields, nil)
 }
 
@@ -15,6 +15,50 @@ package type_builder
 
 import baml "github.com/boundaryml/baml/engine/language_client_go/pkg"
 
+type FlaggedClaimClassView struct {
+	inner baml.ClassBuilder
+}
+
+func (t *FlaggedClaimClassView) ListProperties() ([]ClassPropertyView, error) {
+	result, err := t.inner.ListProperties()
+	if err != nil {
+		return nil, err
+	}
+	builders := make([]ClassPropertyView, len(result))
+	for i, p := range result {
+		builders[i] = p
+	}
+	return builders, nil
+}
+
+func (t *FlaggedClaimClassView) PropertySlide_index() (ClassPropertyView, error) {
+	return t.inner.Property("slide_index")
+}
+
+func (t *FlaggedClaimClassView) PropertyClaim() (ClassPropertyView, error) {
+	return t.inner.Property("claim")
+}
+
+func (t *FlaggedClaimClassView) PropertyVerdict() (ClassPropertyView, error) {
+	return t.inner.Property("verdict")
+}
+
+func (t *FlaggedClaimClassView) PropertyReason() (ClassPropertyView, error) {
+	return t.inner.Property("reason")
+}
+
+func (t *TypeBuilder) FlaggedClaim() (*FlaggedClaimClassView, error) {
+	bld, err := t.inner.Class("FlaggedClaim")
+	if err != nil {
+		return nil, err
+	}
+	return &FlaggedClaimClassView{inner: bld}, nil
+}
+
+func (t *FlaggedClaimClassView) Type() (baml.Type, error) {
+	return t.inner.Type()
+}
+
 type PresentationClassView struct {
 	inner baml.ClassBuilder
 }
@@ -71,6 +115,42 @@ func (t *PresentationClassView) Type() (baml.Type, error) {
 	return t.inner.Type()
 }
 
+type PresentationJudgementClassView struct {
+	inner baml.ClassBuilder
+}
+
+func (t *PresentationJudgementClassView) ListProperties() ([]ClassPropertyView, error) {
+	result, err := t.inner.ListProperties()
+	if err != nil {
+		return nil, err
+	}
+	builders := make([]ClassPropertyView, len(result))
+	for i, p := range result {
+		builders[i] = p
+	}
+	return builders, nil
+}
+
+func (t *PresentationJudgementClassView) PropertyScores() (ClassPropertyView, error) {
+	return t.inner.Property("scores")
+}
+
+func (t *PresentationJudgementClassView) PropertySummary() (ClassPropertyView, error) {
+	return t.inner.Property("summary")
+}
+
+func (t *TypeBuilder) PresentationJudgement() (*PresentationJudgementClassView, error) {
+	bld, err := t.inner.Class("PresentationJudgement")
+	if err != nil {
+		return nil, err
+	}
+	return &PresentationJudgementClassView{inner: bld}, nil
+}
+
+func (t *PresentationJudgementClassView) Type() (baml.Type, error) {
+	return t.inner.Type()
+}
+
 type PresentationPreparationClassView struct {
 	inner baml.ClassBuilder
 }
@@ -147,6 +227,18 @@ func (t *PresentationQuestionClassView) PropertyIteration() (ClassPropertyView,
 	return t.inner.Property("iteration")
 }
 
+func (t *PresentationQuestionClassView) PropertyOptional() (ClassPropertyView, error) {
+	return t.inner.Property("optional")
+}
+
+func (t *PresentationQuestionClassView) PropertyKind() (ClassPropertyView, error) {
+	return t.inner.Property("kind")
+}
+
+func (t *PresentationQuestionClassView) PropertyOptions() (ClassPropertyView, error) {
+	return t.inner.Property("options")
+}
+
 func (t *TypeBuilder) PresentationQuestion() (*PresentationQuestionClassView, error) {
 	bld, err := t.inner.Class("PresentationQuestion")
 	if err != nil {
@@ -183,6 +275,10 @@ func (t *PresentationUpdateClassView) PropertySlide_index() (ClassPropertyView,
 	return t.inner.Property("slide_index")
 }
 
+func (t *PresentationUpdateClassView) PropertySlide_id() (ClassPropertyView, error) {
+	return t.inner.Property("slide_id")
+}
+
 func (t *PresentationUpdateClassView) PropertyNew_slide() (ClassPropertyView, error) {
 	return t.inner.Property("new_slide")
 }
@@ -211,6 +307,46 @@ func (t *PresentationUpdateClassView) Type() (baml.Type, error) {
 	return t.inner.Type()
 }
 
+type RubricScoreClassView struct {
+	inner baml.ClassBuilder
+}
+
+func (t *RubricScoreClassView) ListProperties() ([]ClassPropertyView, error) {
+	result, err := t.inner.ListProperties()
+	if err != nil {
+		return nil, err
+	}
+	builders := make([]ClassPropertyView, len(result))
+	for i, p := range result {
+		builders[i] = p
+	}
+	return builders, nil
+}
+
+func (t *RubricScoreClassView) PropertyCriterion() (ClassPropertyView, error) {
+	return t.inner.Property("criterion")
+}
+
+func (t *RubricScoreClassView) PropertyScore() (ClassPropertyView, error) {
+	return t.inner.Property("score")
+}
+
+func (t *RubricScoreClassView) PropertyReason() (ClassPropertyView, error) {
+	return t.inner.Property("reason")
+}
+
+func (t *TypeBuilder) RubricScore() (*RubricScoreClassView, error) {
+	bld, err := t.inner.Class("RubricScore")
+	if err != nil {
+		return nil, err
+	}
+	return &RubricScoreClassView{inner: bld}, nil
+}
+
+func (t *RubricScoreClassView) Type() (baml.Type, error) {
+	return t.inner.Type()
+}
+
 type SlideClassView struct {
 	inner baml.ClassBuilder
 }
@@ -247,6 +383,10 @@ func (t *SlideClassView) PropertyBackground_color() (ClassPropertyView, error) {
 	return t.inner.Property("background_color")
 }
 
+func (t *SlideClassView) PropertyImage_prompt() (ClassPropertyView, error) {
+	return t.inner.Property("image_prompt")
+}
+
 func (t *TypeBuilder) Slide() (*SlideClassView, error) {
 	bld, err := t.inner.Class("Slide")
 	if err != nil {
@@ -21,14 +21,20 @@ import (
 )
 
 var typeMap = map[string]reflect.Type{
+	"TYPES.FlaggedClaim":                   reflect.TypeOf(types.FlaggedClaim{}),
+	"STREAM_TYPES.FlaggedClaim":            reflect.TypeOf(stream_types.FlaggedClaim{}),
 	"TYPES.Presentation":                   reflect.TypeOf(types.Presentation{}),
 	"STREAM_TYPES.Presentation":            reflect.TypeOf(stream_types.Presentation{}),
+	"TYPES.PresentationJudgement":          reflect.TypeOf(types.PresentationJudgement{}),
+	"STREAM_TYPES.PresentationJudgement":   reflect.TypeOf(stream_types.PresentationJudgement{}),
 	"TYPES.PresentationPreparation":        reflect.TypeOf(types.PresentationPreparation{}),
 	"STREAM_TYPES.PresentationPreparation": reflect.TypeOf(stream_types.PresentationPreparation{}),
 	"TYPES.PresentationQuestion":           reflect.TypeOf(types.PresentationQuestion{}),
 	"STREAM_TYPES.PresentationQuestion":    reflect.TypeOf(stream_types.PresentationQuestion{}),
 	"TYPES.PresentationUpdate":             reflect.TypeOf(types.PresentationUpdate{}),
 	"STREAM_TYPES.PresentationUpdate":      reflect.TypeOf(stream_types.PresentationUpdate{}),
+	"TYPES.RubricScore":                    reflect.TypeOf(types.RubricScore{}),
+	"STREAM_TYPES.RubricScore":             reflect.TypeOf(stream_types.RubricScore{}),
 	"TYPES.Slide":                          reflect.TypeOf(types.Slide{}),
 	"STREAM_TYPES.Slide":                   reflect.TypeOf(stream_types.Slide{}),
 }
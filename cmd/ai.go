@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/geoffjay/pres/baml_client"
+	"github.com/geoffjay/pres/baml_client/stream_types"
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/ratelimit"
+	"github.com/geoffjay/pres/internal/replay"
+)
+
+// The functions below call the BAML functions of the same name through
+// replay, so PRES_AI_MODE can record them to fixtures or serve them back, and
+// through the rate limiter shared by every call of the command.
+
+var (
+	aiLimiter     *ratelimit.Limiter
+	aiLimiterErr  error
+	aiLimiterOnce sync.Once
+)
+
+// limited paces a call that reaches the model by PRES_AI_RPM and
+// PRES_AI_TPM. The input tokens are estimated from the arguments up front and
+// the output tokens from the result once it arrives.
+func limited[T any](ctx context.Context, args replay.Args, call func() (T, error)) func() (T, error) {
+	return func() (T, error) {
+		aiLimiterOnce.Do(func() {
+			aiLimiter, aiLimiterErr = ratelimit.FromEnv()
+		})
+		if aiLimiterErr != nil {
+			var zero T
+			return zero, aiLimiterErr
+		}
+		if !aiLimiter.Enabled() {
+			return call()
+		}
+
+		if err := aiLimiter.Wait(ctx, estimateTokens(args)); err != nil {
+			var zero T
+			return zero, err
+		}
+		result, err := call()
+		if err == nil {
+			aiLimiter.Add(estimateTokens(result))
+		}
+		return result, err
+	}
+}
+
+// estimateTokens approximates the tokens of a value from its JSON encoding
+func estimateTokens(v any) int {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return 0
+	}
+	return presentation.EstimateTokens(string(encoded))
+}
+
+func prepareCreatePresentation(ctx context.Context, description string, iteration int64, responses []string, opts ...baml_client.CallOptionFunc) (types.PresentationPreparation, error) {
+	args := replay.Args{"description": description, "iteration": iteration, "previous_responses": responses}
+	return replay.Call("PrepareCreatePresentation", args, limited(ctx, args, func() (types.PresentationPreparation, error) {
+		return baml_client.PrepareCreatePresentation(ctx, description, iteration, responses, withProvider(opts)...)
+	}))
+}
+
+// generatePresentation leaves the date out of the fixture key, so recordings
+// replay on any day
+func generatePresentation(ctx context.Context, description string, responses []string, today string, opts ...baml_client.CallOptionFunc) (types.Presentation, error) {
+	args := replay.Args{"description": description, "qa_responses": responses}
+	return replay.Call("GeneratePresentation", args, limited(ctx, args, func() (types.Presentation, error) {
+		return baml_client.GeneratePresentation(ctx, description, responses, today, withProvider(opts)...)
+	}))
+}
+
+// streamGeneratePresentation is generatePresentation with the presentation
+// streamed as it is written: progress is called with each partial
+// presentation. A replayed call reports no progress. Cancelling ctx stops the
+// model.
+func streamGeneratePresentation(ctx context.Context, description string, responses []string, today string, progress func(stream_types.Presentation), opts ...baml_client.CallOptionFunc) (types.Presentation, error) {
+	args := replay.Args{"description": description, "qa_responses": responses}
+	return replay.Call("GeneratePresentation", args, limited(ctx, args, func() (types.Presentation, error) {
+		stream, err := baml_client.Stream.GeneratePresentation(ctx, description, responses, today, withProvider(opts)...)
+		if err != nil {
+			return types.Presentation{}, err
+		}
+
+		var final *types.Presentation
+		for value := range stream {
+			switch {
+			case value.IsError:
+				err = value.Error
+			case value.IsFinal:
+				final = value.Final()
+			case value.Stream() != nil:
+				progress(*value.Stream())
+			}
+		}
+		if err == nil && final == nil {
+			if err = ctx.Err(); err == nil {
+				err = fmt.Errorf("the response ended before the presentation was complete")
+			}
+		}
+		if err != nil {
+			return types.Presentation{}, err
+		}
+		return *final, nil
+	}))
+}
+
+func expandOutlineSlide(ctx context.Context, description, outline string, slide int64, opts ...baml_client.CallOptionFunc) (types.Slide, error) {
+	args := replay.Args{"description": description, "outline": outline, "slide_index": slide}
+	return replay.Call("ExpandOutlineSlide", args, limited(ctx, args, func() (types.Slide, error) {
+		return baml_client.ExpandOutlineSlide(ctx, description, outline, slide, withProvider(opts)...)
+	}))
+}
+
+func prepareUpdatePresentation(ctx context.Context, request, deck string, iteration int64, responses []string, opts ...baml_client.CallOptionFunc) (types.PresentationPreparation, error) {
+	args := replay.Args{"update_request": request, "current_presentation": deck, "iteration": iteration, "previous_responses": responses}
+	return replay.Call("PrepareUpdatePresentation", args, limited(ctx, args, func() (types.PresentationPreparation, error) {
+		return baml_client.PrepareUpdatePresentation(ctx, request, deck, iteration, responses, withProvider(opts)...)
+	}))
+}
+
+func generateUpdateOperations(ctx context.Context, request, deck string, responses []string, opts ...baml_client.CallOptionFunc) ([]types.PresentationUpdate, error) {
+	args := replay.Args{"update_request": request, "current_presentation": deck, "qa_responses": responses}
+	return replay.Call("GenerateUpdateOperations", args, limited(ctx, args, func() ([]types.PresentationUpdate, error) {
+		return baml_client.GenerateUpdateOperations(ctx, request, deck, responses, withProvider(opts)...)
+	}))
+}
+
+func factCheckPresentation(ctx context.Context, deck string, opts ...baml_client.CallOptionFunc) ([]types.FlaggedClaim, error) {
+	args := replay.Args{"current_presentation": deck}
+	return replay.Call("FactCheckPresentation", args, limited(ctx, args, func() ([]types.FlaggedClaim, error) {
+		return baml_client.FactCheckPresentation(ctx, deck, withProvider(opts)...)
+	}))
+}
+
+func judgePresentation(ctx context.Context, description string, rubric []string, deck string, opts ...baml_client.CallOptionFunc) (types.PresentationJudgement, error) {
+	args := replay.Args{"description": description, "rubric": rubric, "current_presentation": deck}
+	return replay.Call("JudgePresentation", args, limited(ctx, args, func() (types.PresentationJudgement, error) {
+		return baml_client.JudgePresentation(ctx, description, rubric, deck, withProvider(opts)...)
+	}))
+}
+
+func fitPresentationToDuration(ctx context.Context, deck, timing string, target int64, opts ...baml_client.CallOptionFunc) ([]types.PresentationUpdate, error) {
+	args := replay.Args{"current_presentation": deck, "timing": timing, "target_seconds": target}
+	return replay.Call("FitPresentationToDuration", args, limited(ctx, args, func() ([]types.PresentationUpdate, error) {
+		return baml_client.FitPresentationToDuration(ctx, deck, timing, target, withProvider(opts)...)
+	}))
+}
+
+func generateSection(ctx context.Context, deck, request string, opts ...baml_client.CallOptionFunc) ([]types.Slide, error) {
+	args := replay.Args{"current_presentation": deck, "request": request}
+	return replay.Call("GenerateSection", args, limited(ctx, args, func() ([]types.Slide, error) {
+		return baml_client.GenerateSection(ctx, deck, request, withProvider(opts)...)
+	}))
+}
+
+func regenerateSection(ctx context.Context, deck, section, outline, latest string, opts ...baml_client.CallOptionFunc) ([]types.Slide, error) {
+	args := replay.Args{"current_presentation": deck, "section_title": section, "outline": outline, "latest_context": latest}
+	return replay.Call("RegenerateSection", args, limited(ctx, args, func() ([]types.Slide, error) {
+		return baml_client.RegenerateSection(ctx, deck, section, outline, latest, withProvider(opts)...)
+	}))
+}
+
+func writeSpeakerNotes(ctx context.Context, deck string, slide int64, opts ...baml_client.CallOptionFunc) (string, error) {
+	args := replay.Args{"current_presentation": deck, "slide_index": slide}
+	return replay.Call("WriteSpeakerNotes", args, limited(ctx, args, func() (string, error) {
+		return baml_client.WriteSpeakerNotes(ctx, deck, slide, withProvider(opts)...)
+	}))
+}
+
+func convertToAssertionEvidence(ctx context.Context, deck string, slide int64, opts ...baml_client.CallOptionFunc) (types.Slide, error) {
+	args := replay.Args{"current_presentation": deck, "slide_index": slide}
+	return replay.Call("ConvertToAssertionEvidence", args, limited(ctx, args, func() (types.Slide, error) {
+		return baml_client.ConvertToAssertionEvidence(ctx, deck, slide, withProvider(opts)...)
+	}))
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/geoffjay/pres/internal/notify"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	announcePath    string
+	announceChannel string
+	announceURL     string
+	announceWebhook string
+	announceSlides  []int
+	announceDryRun  bool
+)
+
+var announceCmd = &cobra.Command{
+	Use:   "announce",
+	Short: "Post a presentation summary to Slack",
+	Long: `Post a formatted summary of a presentation to Slack through an incoming
+webhook: the title, subtitle and author, a few key takeaway slides, and a link
+to the published deck.
+
+Takeaway slides are those whose titles read like a summary ("Key takeaways",
+"Recap", "Conclusion", ...), or else the first content slides. Choose them
+explicitly with --slides.
+
+The webhook URL is read from SLACK_WEBHOOK_URL unless --webhook is given.
+
+Examples:
+  pres announce --path presentations/my-talk.json --channel "#eng" --url https://decks.example.com/my-talk.html
+  pres announce --path presentations/my-talk.json --slides 4,9 --url https://decks.example.com/my-talk.html
+  pres announce --path presentations/my-talk.json --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runAnnounce,
+}
+
+func init() {
+	rootCmd.AddCommand(announceCmd)
+
+	announceCmd.Flags().StringVarP(&announcePath, "path", "p", "", "Path to presentation JSON file (required)")
+	announceCmd.Flags().StringVarP(&announceChannel, "channel", "c", "", "Channel to post to (default: the webhook's channel)")
+	announceCmd.Flags().StringVarP(&announceURL, "url", "u", "", "Link to the published deck")
+	announceCmd.Flags().StringVar(&announceWebhook, "webhook", os.Getenv("SLACK_WEBHOOK_URL"), "Slack incoming webhook URL (default: $SLACK_WEBHOOK_URL)")
+	announceCmd.Flags().IntSliceVar(&announceSlides, "slides", nil, "Slide numbers to highlight, starting at 1")
+	announceCmd.Flags().BoolVar(&announceDryRun, "dry-run", false, "Print the message payload instead of posting it")
+	announceCmd.MarkFlagRequired("path")
+}
+
+func runAnnounce(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(announcePath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	data, _, err = presentation.NewGenerator().Prepare(data, announcePath)
+	if err != nil {
+		return err
+	}
+
+	takeaways := notify.KeyTakeaways(data)
+	if len(announceSlides) > 0 {
+		takeaways = nil
+		for _, number := range announceSlides {
+			if number < 1 || number > len(data.Slides) {
+				return fmt.Errorf("slide %d does not exist (presentation has %d slides)", number, len(data.Slides))
+			}
+			takeaways = append(takeaways, data.Slides[number-1])
+		}
+	}
+
+	msg := notify.SlackSummary(data, takeaways, announceURL, announceChannel)
+
+	if announceDryRun {
+		payload, err := json.MarshalIndent(msg, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(payload))
+		return nil
+	}
+
+	if announceWebhook == "" {
+		return fmt.Errorf("a Slack webhook URL is required (set --webhook or SLACK_WEBHOOK_URL)")
+	}
+	if announceURL == "" {
+		fmt.Println("⚠ No --url given, the announcement will not link to the deck")
+	}
+
+	if err := notify.PostSlack(context.Background(), announceWebhook, msg); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Announced %s", data.Metadata.Title)
+	if announceChannel != "" {
+		fmt.Printf(" in %s", announceChannel)
+	}
+	fmt.Println()
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// answersFile holds answers to pres create's questions written ahead of
+// time, for creating presentations from scripts and CI pipelines:
+//
+//	description: Introduction to Go concurrency
+//	answers:
+//	  - question: Who is the audience?
+//	    answer: Backend engineers new to Go
+//	  - question: How long is the talk?
+//	    answer: 30 minutes, with time for questions
+//	  - answer: Include a live demo of a worker pool
+//
+// An answer without a question is passed to the model as context.
+type answersFile struct {
+	Description string   `yaml:"description"`
+	Answers     []answer `yaml:"answers"`
+}
+
+// answer is an answer in an answers file
+type answer struct {
+	Question string `yaml:"question"`
+	Answer   string `yaml:"answer"`
+}
+
+// loadAnswers reads an answers file. Unknown keys are rejected, so a
+// misspelt key fails the pipeline rather than being left out of the deck.
+func loadAnswers(path string) (*answersFile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read answers: %w", err)
+	}
+
+	var file answersFile
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid answers file %s: %w", path, err)
+	}
+	for i, a := range file.Answers {
+		if strings.TrimSpace(a.Answer) == "" {
+			return nil, fmt.Errorf("invalid answers file %s: answer %d is empty", path, i+1)
+		}
+	}
+	return &file, nil
+}
+
+// responses returns the answers as Q&A context for generation
+func (f *answersFile) responses() []string {
+	responses := make([]string, 0, len(f.Answers))
+	for _, a := range f.Answers {
+		answer := strings.TrimSpace(a.Answer)
+		if question := strings.TrimSpace(a.Question); question != "" {
+			responses = append(responses, fmt.Sprintf("Q: %s\nA: %s", question, answer))
+		} else {
+			responses = append(responses, answer)
+		}
+	}
+	return responses
+}
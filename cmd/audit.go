@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	auditPath string
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Show the update history of a presentation",
+	Long: `Show every update operation applied to a presentation, oldest first.
+
+Applied operations are appended to an audit log next to the deck, e.g.
+presentations/.pres/my-talk.audit.log for presentations/my-talk.json. Each
+line is a JSON entry with the timestamp, the command that applied it, the
+actor, and the operation itself. The actor is the login name, or the value of
+PRES_ACTOR when set.
+
+Examples:
+  pres audit --path presentations/my-talk.json
+  PRES_ACTOR=ci-bot pres update --path presentations/my-talk.json "Fix typos"`,
+	Args: cobra.NoArgs,
+	RunE: runAudit,
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+
+	auditCmd.Flags().StringVarP(&auditPath, "path", "p", "", "Path to presentation JSON file (required)")
+	auditCmd.MarkFlagRequired("path")
+}
+
+func runAudit(cmd *cobra.Command, args []string) error {
+	entries, err := presentation.ReadAuditLog(auditPath)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No recorded updates.")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, entry := range entries {
+		op := entry.Operation
+		target := ""
+		if op.Operation == "add_slide" || op.Operation == "modify_slide" || op.Operation == "delete_slide" {
+			target = fmt.Sprintf(" slide %d", op.Slide_index+1)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s%s\t%s\n",
+			entry.Timestamp.Format("2006-01-02 15:04:05"),
+			entry.Actor,
+			entry.Source,
+			op.Operation,
+			target,
+			op.Rationale,
+		)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+// deckBuild is the outcome of generating one presentation of a batch
+type deckBuild struct {
+	Path     string
+	Output   string
+	Slides   int
+	Warnings []string
+	Elapsed  time.Duration
+	Err      error
+}
+
+// runGenerateBatch generates every presentation matching --match, up to
+// --concurrency at a time, and reports each as it finishes
+func runGenerateBatch(cmd *cobra.Command) error {
+	if generateParallel < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
+	}
+	if generateOpen {
+		return fmt.Errorf("--open cannot be combined with --match")
+	}
+	if generateImages {
+		return fmt.Errorf("--images cannot be combined with --match; generate images for each presentation first")
+	}
+
+	paths, err := presentation.MatchPresentations(generateMatch)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no files match %s", strings.Join(generateMatch, ", "))
+	}
+	outputs, err := batchOutputs(paths)
+	if err != nil {
+		return err
+	}
+
+	workers := min(generateParallel, len(paths))
+	fmt.Printf("📄 Generating HTML for %d presentations, %d at a time\n", len(paths), workers)
+	if err := applyGenerateProfile(cmd); err != nil {
+		return err
+	}
+	// A bad template or tag filter fails every deck, so report it once
+	if _, err := newDeckGenerator(); err != nil {
+		return err
+	}
+	fmt.Println()
+
+	// Each worker holds one deck at a time, so memory stays bounded however
+	// many decks are matched
+	jobs := make(chan int)
+	builds := make(chan deckBuild)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			writer := newWriter()
+			for i := range jobs {
+				builds <- buildDeck(writer, paths[i], outputs[i])
+			}
+		}()
+	}
+	go func() {
+		for i := range paths {
+			jobs <- i
+		}
+		close(jobs)
+		wg.Wait()
+		close(builds)
+	}()
+
+	start := time.Now()
+	var failed int
+	var slowest deckBuild
+	for build := range builds {
+		if build.Err != nil {
+			fmt.Printf("✗ %s: %v (%s)\n", build.Path, build.Err, formatElapsed(build.Elapsed))
+			failed++
+			continue
+		}
+		fmt.Printf("✓ %s → %s (%d slides, %s)\n", build.Path, build.Output, build.Slides, formatElapsed(build.Elapsed))
+		for _, warning := range build.Warnings {
+			fmt.Printf("    ⚠ %s\n", warning)
+		}
+		if build.Elapsed > slowest.Elapsed {
+			slowest = build
+		}
+	}
+
+	fmt.Printf("\nGenerated %d of %d presentations in %s\n", len(paths)-failed, len(paths), formatElapsed(time.Since(start)))
+	if slowest.Path != "" {
+		fmt.Printf("  Slowest: %s (%s)\n", slowest.Path, formatElapsed(slowest.Elapsed))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d presentations could not be generated", failed)
+	}
+	return nil
+}
+
+// batchOutputs returns the HTML path of each presentation: next to its JSON
+// file, or in the --output directory. Two presentations may not share one.
+func batchOutputs(paths []string) ([]string, error) {
+	outputs := make([]string, len(paths))
+	sources := make(map[string]string, len(paths))
+	for i, path := range paths {
+		dir := filepath.Dir(path)
+		if generateOutput != "" {
+			dir = generateOutput
+		}
+		base := filepath.Base(path)
+		outputs[i] = filepath.Join(dir, strings.TrimSuffix(base, filepath.Ext(base))+".html")
+
+		if other, ok := sources[outputs[i]]; ok {
+			return nil, fmt.Errorf("%s and %s would both be generated to %s", other, path, outputs[i])
+		}
+		sources[outputs[i]] = path
+	}
+	return outputs, nil
+}
+
+// buildDeck generates one presentation of a batch with the generate flags
+func buildDeck(writer *presentation.Writer, path, output string) deckBuild {
+	start := time.Now()
+	build := deckBuild{Path: path, Output: output}
+	build.Err = func() error {
+		data, err := writer.LoadPresentation(path)
+		if err != nil {
+			return fmt.Errorf("failed to load presentation: %w", err)
+		}
+		data.Metadata.SetVariables(generateSet)
+		if generateTheme != "" {
+			data.Metadata.Theme = generateTheme
+		}
+
+		generator, err := newDeckGenerator()
+		if err != nil {
+			return err
+		}
+		data, build.Warnings, err = generator.Prepare(data, path)
+		if err != nil {
+			return err
+		}
+		if err := generator.GenerateHTML(data, output); err != nil {
+			return fmt.Errorf("failed to generate HTML: %w", err)
+		}
+		build.Slides = len(data.Slides)
+		return nil
+	}()
+	build.Elapsed = time.Since(start)
+	return build
+}
+
+// formatElapsed renders a duration to a tenth of a millisecond, or to the
+// hundredth of a second from a second up
+func formatElapsed(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(100 * time.Microsecond).String()
+	}
+	return d.Round(10 * time.Millisecond).String()
+}
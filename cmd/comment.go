@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	commentPath   string
+	commentSlide  int
+	commentAuthor string
+	commentAll    bool
+)
+
+var commentCmd = &cobra.Command{
+	Use:   "comment",
+	Short: "Manage reviewer comments on slides",
+	Long: `Add, list, and resolve reviewer comments attached to slides.
+
+Unresolved comments can be addressed automatically with:
+  pres update --path deck.json --from-comments
+
+Examples:
+  pres comment add --path presentations/my-talk.json --slide 3 "Too many bullets here"
+  pres comment list --path presentations/my-talk.json
+  pres comment resolve --path presentations/my-talk.json 1a2b3c4d`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var commentAddCmd = &cobra.Command{
+	Use:   "add [text]",
+	Short: "Add a comment to a slide",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCommentAdd,
+}
+
+var commentListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List comments on a presentation",
+	Args:  cobra.NoArgs,
+	RunE:  runCommentList,
+}
+
+var commentResolveCmd = &cobra.Command{
+	Use:   "resolve [id]",
+	Short: "Mark a comment as resolved",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCommentResolve,
+}
+
+func init() {
+	rootCmd.AddCommand(commentCmd)
+	commentCmd.AddCommand(commentAddCmd)
+	commentCmd.AddCommand(commentListCmd)
+	commentCmd.AddCommand(commentResolveCmd)
+
+	for _, c := range []*cobra.Command{commentAddCmd, commentListCmd, commentResolveCmd} {
+		c.Flags().StringVarP(&commentPath, "path", "p", "", "Path to presentation JSON file (required)")
+		c.MarkFlagRequired("path")
+	}
+
+	commentAddCmd.Flags().IntVarP(&commentSlide, "slide", "s", 0, "Slide number to comment on, starting at 1 (required)")
+	commentAddCmd.Flags().StringVar(&commentAuthor, "author", "", "Comment author")
+	commentAddCmd.MarkFlagRequired("slide")
+
+	commentListCmd.Flags().BoolVarP(&commentAll, "all", "a", false, "Include resolved comments")
+}
+
+func runCommentAdd(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	comment, err := writer.AddComment(commentPath, commentSlide-1, commentAuthor, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	fmt.Printf("✓ Added comment %s to slide %d\n", comment.ID, commentSlide)
+
+	return nil
+}
+
+func runCommentList(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(commentPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	comments := data.GetComments(commentAll)
+	if len(comments) == 0 {
+		fmt.Println("No comments.")
+		return nil
+	}
+
+	for _, c := range comments {
+		state := " "
+		if c.Comment.Resolved {
+			state = "✓"
+		}
+		author := ""
+		if c.Comment.Author != "" {
+			author = " (" + c.Comment.Author + ")"
+		}
+		fmt.Printf("[%s] %s  slide %d: %s%s\n", state, c.Comment.ID, c.SlideIndex+1, c.Comment.Text, author)
+	}
+
+	return nil
+}
+
+func runCommentResolve(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	if err := writer.ResolveComment(commentPath, args[0]); err != nil {
+		return fmt.Errorf("failed to resolve comment: %w", err)
+	}
+
+	fmt.Printf("✓ Resolved comment %s\n", args[0])
+
+	return nil
+}
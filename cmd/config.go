@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/platform"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/workspace"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	configFile string
+
+	// settings holds the defaults from the config files and PRES_*
+	// environment variables
+	settings = viper.New()
+
+	// configSources lists the config files that were read, lowest precedence
+	// first
+	configSources []string
+)
+
+// configKeys are the settings that can be configured, with what each sets
+var configKeys = []struct {
+	Key         string
+	Description string
+}{
+	{"author", "Author of new presentations (pres create --author)"},
+	{"output_dir", "Directory new presentations are saved to and listed from, outside a project"},
+	{"theme", "reveal.js theme of new presentations, instead of the one the model picks"},
+	{"provider", "LLM service or BAML client AI calls use: " + strings.Join(providerNames(), ", ")},
+	{"model", "Model the provider is asked for, or the Azure OpenAI deployment (pres --model)"},
+	{"base_url", "Endpoint of the provider, for openai-generic, a proxy, or a remote Ollama"},
+	{"api_key", "API key of the provider, used when its environment variable, e.g. $ANTHROPIC_API_KEY, is not set"},
+	{"max_iterations", "Most rounds of questions pres create and pres update ask"},
+	{"git_commit", "Commit each presentation pres creates or updates to its git repository (pres --git-commit)"},
+}
+
+// aiClients are the clients defined in baml_src/clients.baml
+var aiClients = []string{"AnthropicFallback", "CustomSonnet4", "CustomOpus4", "CustomHaiku", "CustomFast", "CustomOllama"}
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Show the configured defaults",
+	Long: `Show the defaults pres is configured with, and where they come from.
+
+Defaults are read from ~/.config/pres/config.yaml (or
+$XDG_CONFIG_HOME/pres/config.yaml, %AppData%\pres\config.yaml on Windows, or
+the file given with --config). Inside a
+project, the same keys in its pres.yaml are shared with the team and take
+precedence, and PRES_<KEY> environment variables override both, e.g.
+PRES_THEME=night. Flags given on the command line always win.
+
+Keys:
+  author          Author of new presentations
+  output_dir      Directory new presentations are saved to and listed from,
+                  outside a project (default: presentations)
+  theme           reveal.js theme of new presentations
+  provider        LLM service AI calls use: anthropic, openai, azure-openai,
+                  ollama, openai-generic, or a client in
+                  baml_src/clients.baml (default: AnthropicFallback)
+  model           Model the provider is asked for
+  base_url        Endpoint of the provider
+  api_key         API key of the provider, when its environment variable,
+                  e.g. $ANTHROPIC_API_KEY, is not set
+  max_iterations  Most rounds of questions pres create and pres update ask
+                  (default: 3)
+  git_commit      Commit each presentation pres creates or updates to the
+                  git repository it is in (default: false)
+  profiles        Named output profiles for pres generate --profile
+
+Example config.yaml:
+  author: Jane Doe
+  theme: night
+  provider: openai
+  model: gpt-4.1
+  max_iterations: 2
+
+Examples:
+  pres config
+  pres --config team.yaml config
+  pres --provider ollama --model llama3.1 config`,
+	Args: cobra.NoArgs,
+	RunE: runConfig,
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+}
+
+// userConfigDir returns the directory of the user's config file, pres in
+// $XDG_CONFIG_HOME, ~/.config, or %AppData% on Windows
+func userConfigDir() (string, error) {
+	dir, err := platform.ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "pres"), nil
+}
+
+// defaultConfigFile returns the user's config file, config.yaml in the
+// user's config directory
+func defaultConfigFile() string {
+	dir, err := userConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "config.yaml")
+}
+
+// loadConfig reads the user's config file and then the defaults shared in
+// the project's pres.yaml, which take precedence. A missing config file is
+// only an error when it was given with --config.
+func loadConfig() error {
+	settings.SetDefault("output_dir", "presentations")
+	settings.SetDefault("max_iterations", 3)
+	settings.SetEnvPrefix("PRES")
+	settings.AutomaticEnv()
+	settings.SetConfigType("yaml")
+
+	path := platform.ExpandHome(configFile)
+	if path == "" {
+		path = defaultConfigFile()
+	}
+	if path != "" {
+		settings.SetConfigFile(path)
+		err := settings.ReadInConfig()
+		switch {
+		case err == nil:
+			configSources = append(configSources, path)
+		case configFile != "" || !errors.Is(err, fs.ErrNotExist):
+			return fmt.Errorf("failed to read config %s: %w", path, err)
+		}
+	}
+
+	if project != nil {
+		projectFile := filepath.Join(project.Root, workspace.ConfigFile)
+		raw, err := os.ReadFile(projectFile)
+		if err != nil {
+			return err
+		}
+		if err := settings.MergeConfig(bytes.NewReader(raw)); err != nil {
+			return fmt.Errorf("failed to read config %s: %w", projectFile, err)
+		}
+		configSources = append(configSources, projectFile)
+	}
+
+	if settings.GetInt("max_iterations") < 1 {
+		return fmt.Errorf("invalid config: max_iterations must be at least 1")
+	}
+	if err := checkProvider(); err != nil {
+		return err
+	}
+	exportAPIKey()
+	if theme := settings.GetString("theme"); theme != "" && !slices.Contains(presentation.GetRevealJSThemes(), theme) {
+		return fmt.Errorf("invalid config: unknown theme %q (see pres themes)", theme)
+	}
+	return nil
+}
+
+func runConfig(cmd *cobra.Command, args []string) error {
+	fmt.Printf("⚙️  Configuration\n\n")
+	if len(configSources) == 0 {
+		fmt.Printf("No config files found; run pres setup or create %s to set defaults\n\n", defaultConfigFile())
+	} else {
+		fmt.Println("Read from (later files take precedence):")
+		for _, source := range configSources {
+			fmt.Printf("  %s\n", source)
+		}
+		fmt.Println()
+	}
+
+	for _, key := range configKeys {
+		value := settings.GetString(key.Key)
+		switch {
+		case value == "":
+			value = "(not set)"
+		case key.Key == "api_key":
+			value = "(set)"
+		}
+		fmt.Printf("  %-15s %s\n", key.Key, value)
+		fmt.Printf("  %-15s %s\n", "", key.Description)
+	}
+
+	profiles := "(none)"
+	if names := profileNames(); len(names) > 0 {
+		profiles = strings.Join(names, ", ")
+	}
+	fmt.Printf("  %-15s %s\n", "profiles", profiles)
+	fmt.Printf("  %-15s %s\n", "", "Output profiles for pres generate --profile")
+	return nil
+}
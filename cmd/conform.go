@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	conformPath     string
+	conformTemplate string
+	conformFix      bool
+)
+
+var conformCmd = &cobra.Command{
+	Use:   "conform",
+	Short: "Check a presentation against an organization's standard",
+	Long: `Check a presentation against a standard, such as a corporate template,
+and report the gaps: missing required slides (title, agenda, disclaimer,
+closing), required slides out of place, unset metadata fields, and themes
+that are not approved.
+
+A standard is a JSON file:
+
+  {
+    "name": "Corporate standard",
+    "slides": [
+      {"name": "title", "layout": "title", "position": "first"},
+      {"name": "agenda"},
+      {"name": "disclaimer", "match": "(?i)disclaimer|safe harbor",
+       "slide": {"title": "Disclaimer", "layout": "content", "content": "..."}},
+      {"name": "closing", "match": "(?i)thank|questions", "position": "last"}
+    ],
+    "metadata": ["author", "date", "custom.department"],
+    "themes": ["white", "simple"]
+  }
+
+Required slides are expected in the order listed. A slide matches when its
+title matches the "match" regular expression (or contains the name) and it
+has the given layout. With --fix, missing slides that have "slide"
+boilerplate are inserted where they belong. Slides shared from other decks
+count towards the standard.
+
+The command fails when gaps remain, so it can gate a review or CI job.
+
+Examples:
+  pres conform --path presentations/my-talk.json --template corp-standard.json
+  pres conform --path presentations/my-talk.json --template corp-standard.json --fix`,
+	Args: cobra.NoArgs,
+	RunE: runConform,
+}
+
+func init() {
+	rootCmd.AddCommand(conformCmd)
+
+	conformCmd.Flags().StringVarP(&conformPath, "path", "p", "", "Path to presentation JSON file (required)")
+	conformCmd.Flags().StringVarP(&conformTemplate, "template", "t", "", "Path to the standard JSON file (required)")
+	conformCmd.Flags().BoolVar(&conformFix, "fix", false, "Insert boilerplate for missing slides")
+	conformCmd.MarkFlagRequired("path")
+	conformCmd.MarkFlagRequired("template")
+}
+
+func runConform(cmd *cobra.Command, args []string) error {
+	standard, err := presentation.LoadStandard(conformTemplate)
+	if err != nil {
+		return err
+	}
+	name := standard.Name
+	if name == "" {
+		name = conformTemplate
+	}
+
+	fmt.Printf("📋 Checking %s against %s\n\n", conformPath, name)
+
+	writer := newWriter()
+	var issues []presentation.ConformanceIssue
+	if conformFix {
+		inserted, remaining, err := writer.Conform(conformPath, standard)
+		if err != nil {
+			return fmt.Errorf("failed to conform presentation: %w", err)
+		}
+		for _, slide := range inserted {
+			fmt.Printf("✓ Inserted %s slide\n", slide)
+		}
+		issues = remaining
+	} else {
+		data, err := writer.LoadPresentation(conformPath)
+		if err != nil {
+			return fmt.Errorf("failed to load presentation: %w", err)
+		}
+		resolved, err := presentation.NewGenerator().ResolveReferences(data, conformPath)
+		if err != nil {
+			return err
+		}
+		issues = standard.Check(resolved)
+	}
+
+	fixable := 0
+	for _, issue := range issues {
+		fmt.Printf("✗ %s\n", issue.Message)
+		if issue.Fixable {
+			fixable++
+		}
+	}
+
+	if len(issues) == 0 {
+		fmt.Printf("✓ %s conforms to %s\n", conformPath, name)
+		return nil
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	if fixable > 0 {
+		fmt.Printf("  • Insert boilerplate for %d missing slides: pres conform --path %s --template %s --fix\n", fixable, conformPath, conformTemplate)
+	}
+	fmt.Printf("  • Review the slides: pres info --path %s --slides\n", conformPath)
+
+	return fmt.Errorf("%s has %d gaps against %s", conformPath, len(issues), name)
+}
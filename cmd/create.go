@@ -2,20 +2,38 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/geoffjay/agar/tui"
-	"github.com/geoffjay/pres/baml_client"
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/calendar"
 	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/geoffjay/pres/internal/tui"
 	"github.com/spf13/cobra"
 )
 
 var (
-	createOutput string
-	createAuthor string
+	createOutput     string
+	createAuthor     string
+	createMeta       map[string]string
+	createEventDate  string
+	createVenue      string
+	createFromICal   string
+	createTranscript string
+	createOutline    string
+	createAppend     bool
+	createPath       string
+	createImages     bool
+	createNoStream   bool
+	createAnswers    string
+	createNoInput    bool
 )
 
 var createCmd = &cobra.Command{
@@ -28,11 +46,61 @@ The command will:
 2. Generate presentation slides based on your responses
 3. Save the presentation to a JSON file
 
+With --from-ical, the title, date, venue, audience, and duration of the first
+event in an iCalendar (.ics) file are used as context, and the description
+defaults to the event's title.
+
+With --outline, the Q&A is skipped and the deck follows a hand-written
+Markdown outline exactly: every heading is a section title slide, every
+top-level list item is a slide with that title, and the items nested under it
+are the points it makes. AI only expands each slide's points into content and
+speaker notes. Front matter may set the title, subtitle, author, date, and
+theme, and the description defaults to the outline's title.
+
+With --append, a new section is generated from the description and appended
+to the presentation given with --path, following on from its last slide. The
+section starts with a title slide, is previewed, and is saved as added slides
+in the audit log; the rest of the deck is left as it is.
+
+With --images, an image is generated for every slide the model gave an
+image prompt, through the OpenAI Images API ($OPENAI_API_KEY), and stored in
+the deck's assets directory. Title and blank slides show their image as the
+background; other slides show it below their content.
+
+The slides are listed as the model writes them, so you can press Ctrl+C to
+stop early if the deck is heading the wrong way; nothing is saved. With
+--no-stream, pres waits for the whole presentation instead, for providers
+that don't support streaming.
+
+With --answers, the answers in a YAML file are given to the model before
+any questions are asked, and its description is used when none is given.
+With --no-input, no questions are asked at all: the presentation is
+generated from the description, answers file, and calendar event alone, for
+scripts and CI pipelines. The answers file looks like:
+
+  description: Introduction to Go concurrency
+  answers:
+    - question: Who is the audience?
+      answer: Backend engineers new to Go
+    - answer: Include a live demo of a worker pool
+
+With --save-transcript, the Q&A and the prompt and response of every AI call
+are written to a file (Markdown, or JSON for a .json path), to audit why the
+model produced the deck or to reuse a good prompt.
+
 Examples:
   pres create "Introduction to Go concurrency patterns"
   pres create "Q4 Business Review" --author "Jane Doe"
-  pres create "Product Launch" --output presentations/launch.json`,
-	Args: cobra.ExactArgs(1),
+  pres create "Product Launch" --output presentations/launch.json
+  pres create "Q4 Business Review" --meta cost_center=ENG-42 --meta confidentiality=internal
+  pres create "Keynote" --event-date 2025-03-12 --venue "GopherCon EU"
+  pres create --from-ical ~/Downloads/quarterly-review.ics
+  pres create "Product Launch" --save-transcript transcripts/launch.md
+  pres create --outline talks/concurrency.md
+  pres create --append --path presentations/go.json "add a section about benchmarking"
+  pres create "Product Launch" --images
+  pres create --no-input --answers answers.yaml --output presentations/ci.json`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runCreate,
 }
 
@@ -40,17 +108,125 @@ func init() {
 	rootCmd.AddCommand(createCmd)
 
 	createCmd.Flags().StringVarP(&createOutput, "output", "o", "", "Output path for presentation (default: generated from title)")
-	createCmd.Flags().StringVar(&createAuthor, "author", "", "Author name (default: from environment or empty)")
+	createCmd.Flags().StringVar(&createAuthor, "author", "", "Author name (default: author from the config file)")
+	createCmd.Flags().StringToStringVar(&createMeta, "meta", nil, "Custom metadata field as key=value (can be repeated)")
+	createCmd.Flags().StringVar(&createEventDate, "event-date", "", "Date the presentation will be delivered (YYYY-MM-DD)")
+	createCmd.Flags().StringVar(&createVenue, "venue", "", "Where the presentation will be delivered")
+	createCmd.Flags().StringVar(&createFromICal, "from-ical", "", "Seed the presentation from an iCalendar (.ics) event")
+	createCmd.Flags().StringVar(&createTranscript, "save-transcript", "", "Write the Q&A, prompts, and model responses of the session to a file")
+	createCmd.Flags().StringVar(&createOutline, "outline", "", "Build the slides from a hand-written Markdown outline instead of a Q&A")
+	createCmd.Flags().BoolVar(&createAppend, "append", false, "Append a new section to the presentation given with --path")
+	createCmd.Flags().StringVarP(&createPath, "path", "p", "", "Path to the presentation to append to with --append")
+	createCmd.Flags().BoolVar(&createImages, "images", false, "Generate images for the slides with an image prompt")
+	createCmd.Flags().BoolVar(&createNoStream, "no-stream", false, "Wait for the whole presentation instead of listing slides as they are written")
+	createCmd.Flags().StringVar(&createAnswers, "answers", "", "YAML file of answers to give the model before any questions are asked")
+	createCmd.Flags().BoolVar(&createNoInput, "no-input", false, "Ask no questions; generate from the description and --answers alone")
+	addImageFlags(createCmd)
+	createCmd.MarkFlagsMutuallyExclusive("outline", "from-ical")
+	createCmd.MarkFlagsMutuallyExclusive("append", "outline")
+	createCmd.MarkFlagsMutuallyExclusive("append", "from-ical")
+	createCmd.MarkFlagsMutuallyExclusive("append", "output")
+	createCmd.MarkFlagsMutuallyExclusive("answers", "outline")
+	createCmd.MarkFlagsMutuallyExclusive("answers", "append")
 }
 
 func runCreate(cmd *cobra.Command, args []string) error {
-	description := args[0]
 	ctx := context.Background()
 
+	var description string
+	if len(args) > 0 {
+		description = args[0]
+	}
+
+	if createAppend {
+		return appendSection(ctx, description)
+	}
+	if createAuthor == "" {
+		createAuthor = settings.GetString("author")
+	}
+	if createPath != "" {
+		return fmt.Errorf("--path is only used with --append; set where a new presentation is saved with --output")
+	}
+
+	// Context gathered before the Q&A, e.g. from a calendar invite
+	var seedResponses []string
+	var event *calendar.Event
+	if createFromICal != "" {
+		var err error
+		event, err = loadICalEvent(createFromICal)
+		if err != nil {
+			return err
+		}
+		if description == "" {
+			description = event.Summary
+		}
+		if createEventDate == "" && !event.Start.IsZero() {
+			createEventDate = event.Start.Format(presentation.EventDateFormat)
+		}
+		if createVenue == "" {
+			createVenue = event.Location
+		}
+		seedResponses = icalResponses(event)
+	}
+
+	// Answers written ahead of time, e.g. by a CI pipeline
+	var answers *answersFile
+	if createAnswers != "" {
+		var err error
+		if answers, err = loadAnswers(createAnswers); err != nil {
+			return err
+		}
+		if description == "" {
+			description = answers.Description
+		}
+		seedResponses = append(seedResponses, answers.responses()...)
+	}
+
+	if description == "" && createOutline == "" {
+		return fmt.Errorf("a description is required (or use --from-ical, --outline, or a description in --answers)")
+	}
+
+	if createEventDate != "" {
+		if _, err := time.Parse(presentation.EventDateFormat, createEventDate); err != nil {
+			return fmt.Errorf("invalid --event-date %q: expected YYYY-MM-DD", createEventDate)
+		}
+	}
+
+	if createOutline != "" {
+		return createFromOutline(ctx, description)
+	}
+
 	fmt.Printf("📊 Creating presentation: %s\n\n", description)
 
-	const maxIterations = 3
-	var allQAResponses []string
+	if event != nil {
+		fmt.Printf("Using calendar event: %s\n", event.Summary)
+		for _, response := range seedResponses {
+			fmt.Printf("  %s\n", strings.ReplaceAll(response, "\n", " "))
+		}
+		fmt.Println()
+	}
+
+	if answers != nil {
+		fmt.Printf("Using %d answers from %s\n\n", len(answers.Answers), createAnswers)
+	}
+
+	maxIterations := settings.GetInt("max_iterations")
+	if createNoInput {
+		// Generate from the context given up front without asking anything
+		maxIterations = 0
+	}
+	allQAResponses := seedResponses
+
+	session, err := newAISession("pres create", description, createTranscript)
+	if err != nil {
+		return err
+	}
+	var savedPath string
+	defer func() {
+		if err := session.saveTranscript(savedPath, allQAResponses); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}()
 
 	// Iterative information gathering with confidence scoring
 	config := tui.IterationConfig{
@@ -62,10 +238,11 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	form := tui.NewIterativeForm("Presentation Creation", config)
 
 	for iteration := 0; iteration < maxIterations; iteration++ {
-		fmt.Printf("Preparing questions (iteration %d/%d)...\n", iteration+1, maxIterations)
-
 		// Prepare questions using BAML
-		preparation, err := baml_client.PrepareCreatePresentation(ctx, description, int64(iteration), allQAResponses)
+		message := fmt.Sprintf("Preparing questions (iteration %d/%d)...", iteration+1, maxIterations)
+		preparation, err := withSpinner(ctx, message, func(ctx context.Context, _ func(string)) (types.PresentationPreparation, error) {
+			return prepareCreatePresentation(ctx, description, int64(iteration), allQAResponses, session.option())
+		})
 		if err != nil {
 			return fmt.Errorf("failed to prepare questions: %w", err)
 		}
@@ -80,24 +257,17 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		// Convert BAML questions to TUI questions
 		var questions []tui.IterativeQuestion
 		for _, q := range preparation.Questions {
-			questions = append(questions, tui.IterativeQuestion{
-				Question:  q.Question,
-				HelpText:  q.Help_text,
-				Iteration: int(q.Iteration),
-			})
+			questions = append(questions, formQuestion(q))
 		}
 
 		form.AddQuestions(questions)
 
 		// Run interactive TUI
-		p := tea.NewProgram(form)
-		finalModel, err := p.Run()
+		form, err = runForm(form)
 		if err != nil {
 			return fmt.Errorf("error running interactive form: %w", err)
 		}
 
-		form = finalModel.(tui.IterativeFormModel)
-
 		if !form.IsDone() && !form.NeedsMoreInfo() {
 			return fmt.Errorf("presentation creation cancelled")
 		}
@@ -106,8 +276,7 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		iterationResponses := form.GetResponsesForIteration(iteration)
 		for i, q := range preparation.Questions {
 			if i < len(iterationResponses) {
-				qa := fmt.Sprintf("Q: %s\nA: %s", q.Question, iterationResponses[i])
-				allQAResponses = append(allQAResponses, qa)
+				allQAResponses = append(allQAResponses, formResponse(q.Question, iterationResponses[i]))
 			}
 		}
 
@@ -130,11 +299,17 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		form.NextIteration()
 	}
 
-	fmt.Println("\nGenerating presentation from your responses...")
-
 	// Generate presentation from all Q&A
 	today := time.Now().Format("2006-01-02")
-	result, err := baml_client.GeneratePresentation(ctx, description, allQAResponses, today)
+	var result types.Presentation
+	if createNoStream {
+		fmt.Println()
+		result, err = withSpinner(ctx, "Generating presentation from your responses...", func(ctx context.Context, _ func(string)) (types.Presentation, error) {
+			return generatePresentation(ctx, description, allQAResponses, today, session.option())
+		})
+	} else {
+		result, err = streamPresentation(ctx, description, allQAResponses, today, session)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to generate presentation: %w", err)
 	}
@@ -144,6 +319,229 @@ func runCreate(cmd *cobra.Command, args []string) error {
 		result.Author = createAuthor
 	}
 
+	// A configured theme replaces the one the model picked
+	if theme := settings.GetString("theme"); theme != "" {
+		result.Theme = theme
+	}
+
+	// The deck is dated for the event rather than the day it was created
+	if event != nil && createEventDate != "" {
+		result.Date = createEventDate
+	}
+
+	savedPath, err = saveNewPresentation(&result, session)
+	return err
+}
+
+// streamPresentation generates a presentation, listing its slides as the
+// model writes them. Ctrl+C stops the model rather than exiting, so the
+// session's transcript is still saved.
+func streamPresentation(ctx context.Context, description string, responses []string, today string, session *aiSession) (types.Presentation, error) {
+	fmt.Println()
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	message := "Generating presentation from your responses (Ctrl+C to stop)..."
+	result, err := withSpinner(ctx, message, func(ctx context.Context, println func(string)) (types.Presentation, error) {
+		progress := slideProgress{println: println}
+		result, err := streamGeneratePresentation(ctx, description, responses, today, progress.update, session.option())
+		if err == nil {
+			progress.finish(result)
+		}
+		return result, err
+	})
+	if err != nil {
+		if ctx.Err() != nil || errors.Is(err, errStopped) {
+			return result, fmt.Errorf("stopped before the presentation was complete; nothing was saved")
+		}
+		return result, err
+	}
+	return result, nil
+}
+
+// createFromOutline creates a presentation with exactly the slides of a
+// hand-written outline, having AI write only their content and notes. A slide
+// that can't be written keeps its points from the outline as its content.
+func createFromOutline(ctx context.Context, description string) error {
+	text, err := os.ReadFile(createOutline)
+	if err != nil {
+		return fmt.Errorf("failed to read outline: %w", err)
+	}
+	outline, err := presentation.ParseOutline(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid outline %s: %w", createOutline, err)
+	}
+	if description == "" {
+		description = outline.Title
+	}
+
+	result := outline.Skeleton()
+	if createEventDate != "" {
+		result.Date = createEventDate
+	} else if result.Date == "" {
+		result.Date = time.Now().Format("2006-01-02")
+	}
+	// The outline's own theme wins over the configured one
+	defaultTheme := settings.GetString("theme")
+	if defaultTheme == "" {
+		defaultTheme = "black"
+	}
+	if result.Theme == "" {
+		result.Theme = defaultTheme
+	} else if !slices.Contains(presentation.GetRevealJSThemes(), result.Theme) {
+		fmt.Printf("⚠ Unknown theme %q in the outline; using %s\n", result.Theme, defaultTheme)
+		result.Theme = defaultTheme
+	}
+	if createAuthor != "" {
+		result.Author = createAuthor
+	}
+
+	fmt.Printf("📊 Creating presentation from outline: %s (%d slides)\n\n", outline.Title, len(result.Slides))
+
+	session, err := newAISession("pres create", description, createTranscript)
+	if err != nil {
+		return err
+	}
+	var savedPath string
+	defer func() {
+		if err := session.saveTranscript(savedPath, nil); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}()
+
+	structure := outline.String()
+	progress := newPassProgress(len(result.Slides), 0)
+	failed := 0
+	for i, item := range outline.Slides {
+		label := fmt.Sprintf("Slide %d: %s", i+1, item.Title)
+		progress.start(label)
+		slide, err := expandOutlineSlide(ctx, description, structure, int64(i), session.option())
+		if err != nil {
+			progress.finish("✗", label, err.Error()+" (kept the outline's points)")
+			failed++
+			continue
+		}
+
+		// The outline, not the model, decides the structure
+		slide.Title = item.Title
+		switch {
+		case item.Section:
+			slide.Layout = "title"
+		case slide.Layout == "" || slide.Layout == "title":
+			slide.Layout = "content"
+		}
+		result.Slides[i] = slide
+		progress.finish("✓", label, "")
+	}
+	progress.clear()
+
+	if failed > 0 {
+		fmt.Printf("\n⚠ %d of %d slides could not be written and hold their outline points\n", failed, len(result.Slides))
+	}
+
+	savedPath, err = saveNewPresentation(&result, session)
+	return err
+}
+
+// appendSection generates a new section from a request and appends it to the
+// presentation at --path, after its last slide
+func appendSection(ctx context.Context, request string) error {
+	if createPath == "" {
+		return fmt.Errorf("--append needs the --path of the presentation to add to")
+	}
+	if request == "" {
+		return fmt.Errorf("describe the section to add, e.g. pres create --append --path %s \"a section about benchmarking\"", createPath)
+	}
+
+	writer := newWriter()
+	writer.SetAudit("pres create", auditActor())
+	data, err := writer.LoadPresentation(createPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	fmt.Printf("📊 Adding a section to: %s\n", createPath)
+	fmt.Printf("Request: %s\n\n", request)
+	fmt.Printf("Loaded: %s (%d slides)\n\n", data.Metadata.Title, len(data.Slides))
+
+	session, err := newAISession("pres create", request, createTranscript)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := session.saveTranscript(createPath, nil); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}()
+
+	// The end of the deck, which the section follows on from, is kept in full
+	var focus []int
+	for i := max(len(data.Slides)-3, 0); i < len(data.Slides); i++ {
+		focus = append(focus, i)
+	}
+	deck := data.BuildContext(focus, presentation.EstimateTokens(request), presentation.DefaultContextBudget)
+	printContextBudget(deck)
+
+	fmt.Println("Generating the section...")
+	slides, err := generateSection(ctx, deck.Text, request, session.option())
+	if err != nil {
+		return fmt.Errorf("failed to generate section: %w", err)
+	}
+	if len(slides) == 0 {
+		return fmt.Errorf("the model returned no slides for the section")
+	}
+	if slides[0].Layout != "title" {
+		return fmt.Errorf("the generated section does not start with a title slide; nothing was changed")
+	}
+
+	fmt.Printf("\nNew section (%d slides):\n", len(slides))
+	opts := render.Options{Width: previewWidth, MaxLines: 8}
+	updates := make([]types.PresentationUpdate, 0, len(slides))
+	for i, slide := range slides {
+		index := len(data.Slides) + i
+		fmt.Println(render.Card(presentation.Slide{Slide: slide}, index+1, opts))
+		updates = append(updates, types.PresentationUpdate{
+			Operation:   "add_slide",
+			Slide_index: int64(index),
+			New_slide:   slide,
+			Rationale:   "Appended section: " + request,
+		})
+	}
+
+	writer.AddProvenance(session.collect()...)
+	results, err := writer.UpdatePresentation(createPath, updates, false)
+	if errors.Is(err, presentation.ErrUpdateRejected) {
+		printOperationResults(results)
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	if createImages {
+		if err := generateSlideImages(ctx, writer, createPath); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\n✓ Section added successfully!\n")
+	fmt.Printf("  Location: %s\n", createPath)
+	fmt.Printf("  Section: %s\n", slides[0].Title)
+	fmt.Printf("  Slides: %d (was %d)\n", len(data.Slides)+len(slides), len(data.Slides))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the storyline: pres flow --path %s\n", createPath)
+	fmt.Printf("  • Regenerate the section: pres regen --path %s --section %q\n", createPath, slides[0].Title)
+	fmt.Printf("  • Generate HTML: pres generate --path %s\n", createPath)
+
+	return nil
+}
+
+// saveNewPresentation saves a generated presentation with the details given
+// on the command line, named after its title unless --output is set, and
+// prints a summary
+func saveNewPresentation(result *types.Presentation, session *aiSession) (string, error) {
 	// Determine output path
 	outputPath := createOutput
 	if outputPath == "" {
@@ -163,14 +561,33 @@ func runCreate(cmd *cobra.Command, args []string) error {
 			filename = strings.ReplaceAll(filename, "--", "-")
 		}
 		filename = strings.Trim(filename, "-")
-		outputPath = "presentations/" + filename + ".json"
+		outputPath = filepath.Join(presentationsDir(), filename+".json")
 	}
 
 	// Save presentation
-	writer := presentation.NewWriter(".")
-	savedPath, err := writer.SavePresentation(&result, outputPath)
+	writer := newWriter()
+	writer.AddProvenance(session.collect()...)
+	savedPath, err := writer.SavePresentation(result, outputPath)
 	if err != nil {
-		return fmt.Errorf("failed to save presentation: %w", err)
+		return "", fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	if len(createMeta) > 0 {
+		if _, err := writer.SetCustomFields(savedPath, createMeta); err != nil {
+			return "", fmt.Errorf("failed to save custom metadata: %w", err)
+		}
+	}
+
+	if createEventDate != "" || createVenue != "" {
+		if _, err := writer.SetEvent(savedPath, createEventDate, createVenue); err != nil {
+			return "", fmt.Errorf("failed to save event details: %w", err)
+		}
+	}
+
+	if createImages {
+		if err := generateSlideImages(context.Background(), writer, savedPath); err != nil {
+			return "", err
+		}
 	}
 
 	// Display summary
@@ -183,14 +600,99 @@ func runCreate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Author: %s\n", result.Author)
 	fmt.Printf("  Theme: %s\n", result.Theme)
 	fmt.Printf("  Slides: %d\n", len(result.Slides))
+	if createEventDate != "" {
+		fmt.Printf("  Event: %s %s\n", createEventDate, createVenue)
+	}
 	if len(result.Tags) > 0 {
 		fmt.Printf("  Tags: %s\n", strings.Join(result.Tags, ", "))
 	}
+	for key, value := range createMeta {
+		fmt.Printf("  %s: %s\n", key, value)
+	}
 
 	fmt.Printf("\nNext steps:\n")
 	fmt.Printf("  • Review the presentation: cat %s\n", savedPath)
 	fmt.Printf("  • Generate HTML: pres generate --path %s\n", savedPath)
 	fmt.Printf("  • Update content: pres update --path %s \"your update request\"\n", savedPath)
 
-	return nil
+	return savedPath, nil
+}
+
+// loadICalEvent reads the first event from an iCalendar file
+func loadICalEvent(path string) (*calendar.Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open calendar file: %w", err)
+	}
+	defer f.Close()
+
+	event, err := calendar.ParseICS(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if event.Summary == "" {
+		return nil, fmt.Errorf("the event in %s has no title", path)
+	}
+	return event, nil
+}
+
+// icalResponses turns a calendar event into Q&A context for generation
+func icalResponses(event *calendar.Event) []string {
+	const maxAttendees = 10
+
+	var responses []string
+	add := func(question, answer string) {
+		responses = append(responses, fmt.Sprintf("Q: %s\nA: %s", question, answer))
+	}
+
+	add("What is the title of the meeting this presentation is for?", event.Summary)
+
+	if !event.Start.IsZero() {
+		when := event.Start.Format("Monday, January 2, 2006")
+		if !event.AllDay {
+			when = event.Start.Format("Monday, January 2, 2006 at 15:04 MST")
+		}
+		add("When will it be presented?", when)
+	}
+
+	if d := event.Duration(); d > 0 && !event.AllDay {
+		add("How long is the time slot?", fmt.Sprintf("%s, so the presentation must fit within it", formatDuration(d)))
+	}
+
+	if event.Location != "" {
+		add("Where will it be presented?", event.Location)
+	}
+
+	if len(event.Attendees) > 0 {
+		names := event.Attendees
+		if len(names) > maxAttendees {
+			names = names[:maxAttendees]
+		}
+		audience := fmt.Sprintf("%d invited attendees, including %s", len(event.Attendees), strings.Join(names, ", "))
+		if event.Organizer != "" {
+			audience += fmt.Sprintf("; organized by %s", event.Organizer)
+		}
+		add("Who is the audience?", audience)
+	}
+
+	if description := strings.TrimSpace(event.Description); description != "" {
+		add("What does the meeting invite say?", description)
+	}
+
+	return responses
+}
+
+// formatDuration renders a duration as hours and minutes, e.g. "1h 30m"
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%d minutes", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
 }
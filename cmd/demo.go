@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	demoOutput string
+	demoTheme  string
+	demoForce  bool
+)
+
+var demoCmd = &cobra.Command{
+	Use:   "demo",
+	Short: "Create a fully populated sample presentation without AI",
+	Long: `Create a sample presentation with a slide in every layout, speaker notes on
+every slide, a table, a chart and a picture, a footnote, variables, slide
+tags, and a hidden backup slide. No AI is used, so it's quick and free: use it
+to try out themes, templates, and exporters, or for screenshots.
+
+The images are drawn locally and stored in the deck's assets directory.
+
+Examples:
+  pres demo
+  pres demo --theme night --output presentations/demo-night.json
+  pres demo --force && pres generate --path presentations/demo.json --open`,
+	Args: cobra.NoArgs,
+	RunE: runDemo,
+}
+
+func init() {
+	rootCmd.AddCommand(demoCmd)
+
+	demoCmd.Flags().StringVarP(&demoOutput, "output", "o", "", "Output path for presentation (default: demo.json in the presentations directory)")
+	demoCmd.Flags().StringVar(&demoTheme, "theme", "", "reveal.js theme of the sample deck (default: the configured theme, or black)")
+	demoCmd.Flags().BoolVarP(&demoForce, "force", "f", false, "Overwrite the output file if it exists")
+}
+
+func runDemo(cmd *cobra.Command, args []string) error {
+	theme := demoTheme
+	if theme == "" {
+		theme = settings.GetString("theme")
+	}
+	if theme == "" {
+		theme = "black"
+	}
+	if !slices.Contains(presentation.GetRevealJSThemes(), theme) {
+		return fmt.Errorf("unknown theme %q (expected one of: %s)", theme, strings.Join(presentation.GetRevealJSThemes(), ", "))
+	}
+
+	outputPath := demoOutput
+	if outputPath == "" {
+		outputPath = filepath.Join(presentationsDir(), "demo.json")
+	}
+	if filepath.Ext(outputPath) != ".json" {
+		outputPath += ".json"
+	}
+	if _, err := os.Stat(outputPath); err == nil && !demoForce {
+		return fmt.Errorf("%s already exists (use --force to overwrite)", outputPath)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	images, err := presentation.DemoImages(outputPath)
+	if err != nil {
+		return err
+	}
+	for file, image := range images {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return fmt.Errorf("failed to create assets directory: %w", err)
+		}
+		if err := os.WriteFile(file, image, 0644); err != nil {
+			return fmt.Errorf("failed to write image: %w", err)
+		}
+	}
+
+	data := presentation.DemoDeck(outputPath, theme)
+	savedPath, err := newWriter().SaveData(data, outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	fmt.Printf("✓ Sample presentation created!\n")
+	fmt.Printf("  Location: %s\n", savedPath)
+	fmt.Printf("  Theme: %s\n", theme)
+	fmt.Printf("  Slides: %d\n", len(data.Slides))
+	fmt.Printf("  Images: %d in %s\n", len(images), filepath.Join(filepath.Dir(savedPath), presentation.AssetsDir))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Generate HTML: pres generate --path %s\n", savedPath)
+	fmt.Printf("  • Try another theme: pres generate --path %s --theme night\n", savedPath)
+	fmt.Printf("  • Export it: pres export pdf --path %s\n", savedPath)
+
+	return nil
+}
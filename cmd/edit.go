@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var editPath string
+
+var editCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit a deck's slides in the terminal",
+	Long: `Edit a deck's slides by hand in a full-screen terminal editor, for small
+tweaks that don't need AI or hand-editing the JSON. The slides are listed on
+the left, and the title, content, and speaker notes of the selected slide
+are edited on the right.
+
+Keys in the slide list:
+  ↑/↓, k/j             select a slide
+  enter, tab, e        edit the selected slide
+  a                    add a slide after the selected one
+  d                    delete the selected slide
+  shift+↑/↓, K/J       move the selected slide up or down
+  q, esc               save and quit
+
+Keys while editing:
+  tab, shift+tab       next or previous field
+  enter                new line (in the title, go to the content)
+  esc                  back to the slide list
+
+Ctrl+C quits without saving, asking first if anything changed.
+
+Locked slides and slides shared from other decks can be moved but not
+edited or deleted. Changes are saved like those of pres update: each
+changed slide keeps the version it replaced, the changes are recorded in
+the audit log, and pres undo restores the deck as it was.
+
+Examples:
+  pres edit --path presentations/my-talk.json`,
+	Args: cobra.NoArgs,
+	RunE: runEdit,
+}
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+
+	editCmd.Flags().StringVarP(&editPath, "path", "p", "", "Path to presentation JSON file (required)")
+	editCmd.MarkFlagRequired("path")
+}
+
+func runEdit(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	writer.SetAudit("pres edit", auditActor())
+
+	// Slides are matched to their edits by ID
+	data, err := writer.EnsureSlideIDs(editPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	final, err := tea.NewProgram(render.NewSlideEditor(data), tea.WithAltScreen()).Run()
+	if err != nil {
+		return fmt.Errorf("error running editor: %w", err)
+	}
+	editor := final.(render.SlideEditor)
+	if !editor.Save() {
+		fmt.Println("✗ Nothing was saved")
+		return nil
+	}
+
+	updates := data.EditOperations(editor.Slides())
+	if len(updates) == 0 {
+		fmt.Println("✓ No changes to save")
+		return nil
+	}
+	if _, err := writer.UpdatePresentation(editPath, updates, false); err != nil {
+		return fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	counts := map[string]int{}
+	for _, update := range updates {
+		counts[update.Operation]++
+	}
+	fmt.Printf("✓ Saved %s\n", editPath)
+	if n := counts["modify_slide"]; n > 0 {
+		fmt.Printf("  Changed: %d slide(s)\n", n)
+	}
+	if n := counts["add_slide"]; n > 0 {
+		fmt.Printf("  Added: %d slide(s)\n", n)
+	}
+	if n := counts["delete_slide"]; n > 0 {
+		fmt.Printf("  Deleted: %d slide(s)\n", n)
+	}
+	if counts["reorder_slides"] > 0 {
+		fmt.Printf("  Slides reordered\n")
+	}
+	fmt.Printf("\nGenerate HTML: pres generate --path %s\n", editPath)
+	return nil
+}
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	baml "github.com/boundaryml/baml/engine/language_client_go/pkg"
+	"github.com/geoffjay/pres/baml_client"
+	"github.com/geoffjay/pres/internal/eval"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/text"
+	"github.com/spf13/cobra"
+)
+
+var (
+	evalSuite     string
+	evalModels    []string
+	evalJudge     string
+	evalCases     []string
+	evalOutput    string
+	evalBaseline  string
+	evalThreshold float64
+	evalParallel  int
+)
+
+var evalCmd = &cobra.Command{
+	Use:   "eval",
+	Short: "Evaluate generation quality on a suite of fixtures",
+	Long: `Evaluate how well pres generates presentations, for checking the effect
+of prompt changes or a switch of model.
+
+A suite is a JSON file of fixture descriptions and an optional rubric:
+
+  {
+    "name": "smoke",
+    "rubric": ["Coverage: the slides cover what the description asks for"],
+    "cases": [
+      {"name": "go-intro", "description": "Introduction to Go concurrency", "answers": ["Q: Audience?\nA: Backend engineers"]}
+    ]
+  }
+
+Each case is generated, then scored from 1 to 5 on every rubric criterion by
+a judging model. Suites without a rubric use a default one covering
+coverage, structure, density, accuracy, and speaker notes.
+
+Examples:
+  pres eval run --suite evals/smoke.json
+  pres eval run --suite evals/smoke.json --model CustomSonnet4 --model CustomOpus4
+  pres eval run --suite evals/smoke.json --baseline evals/baseline.json
+  pres eval compare evals/baseline.json evals/reports/latest.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var evalRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Generate and judge every case of a suite",
+	Long: `Generate a presentation for every case of a suite and score it against the
+rubric. With --model, every case is generated with each of the named BAML
+clients (see baml_src/clients.baml) so models can be compared; otherwise the
+default client is used.
+
+The report is saved as JSON (--output) for use as a future baseline. With
+--baseline, scores that dropped by more than --threshold are reported as
+regressions and the command fails, so it can gate prompt changes in CI.
+
+With --concurrency, several cases are evaluated at once. Set PRES_AI_RPM and
+PRES_AI_TPM to keep the calls within the provider's rate limits.`,
+	Args: cobra.NoArgs,
+	RunE: runEvalRun,
+}
+
+var evalCompareCmd = &cobra.Command{
+	Use:   "compare [baseline] [report]",
+	Short: "Compare an evaluation report against a baseline",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runEvalCompare,
+}
+
+func init() {
+	rootCmd.AddCommand(evalCmd)
+	evalCmd.AddCommand(evalRunCmd)
+	evalCmd.AddCommand(evalCompareCmd)
+
+	evalRunCmd.Flags().StringVarP(&evalSuite, "suite", "s", "", "Path to the suite JSON file (required)")
+	evalRunCmd.Flags().StringSliceVarP(&evalModels, "model", "m", nil, "BAML client to generate with (can be repeated; default: the client in baml_src)")
+	evalRunCmd.Flags().StringVar(&evalJudge, "judge", "", "BAML client to judge with (default: the client in baml_src)")
+	evalRunCmd.Flags().StringSliceVar(&evalCases, "case", nil, "Only run the named case (can be repeated)")
+	evalRunCmd.Flags().StringVarP(&evalOutput, "output", "o", "", "Path for the JSON report (default: evals/reports/<suite>-<time>.json)")
+	evalRunCmd.Flags().StringVar(&evalBaseline, "baseline", "", "Report to compare the scores against")
+	evalRunCmd.Flags().IntVarP(&evalParallel, "concurrency", "j", 1, "Cases to evaluate at once")
+	evalRunCmd.MarkFlagRequired("suite")
+
+	for _, c := range []*cobra.Command{evalRunCmd, evalCompareCmd} {
+		c.Flags().Float64Var(&evalThreshold, "threshold", eval.DefaultThreshold, "Score drop that counts as a regression")
+	}
+}
+
+func runEvalRun(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	suite, err := eval.LoadSuite(evalSuite)
+	if err != nil {
+		return err
+	}
+	cases, err := suite.Filter(evalCases)
+	if err != nil {
+		return err
+	}
+
+	var baseline *eval.Report
+	if evalBaseline != "" {
+		if baseline, err = eval.LoadReport(evalBaseline); err != nil {
+			return err
+		}
+	}
+
+	if evalParallel < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
+	}
+
+	models := evalModels
+	if len(models) == 0 {
+		models = []string{""}
+	}
+
+	report := &eval.Report{Suite: suite.Name, Started: time.Now(), Judge: evalJudge, Rubric: suite.Rubric}
+	fmt.Printf("🧪 Evaluating %s: %d case(s), %d model(s)\n", suite.Name, len(cases), len(models))
+
+	today := time.Now().Format("2006-01-02")
+	for _, model := range models {
+		fmt.Printf("\nModel: %s\n", modelName(model))
+		for _, result := range evaluateCases(ctx, cases, model, suite.Rubric, today) {
+			report.Results = append(report.Results, result)
+			if result.Error != "" {
+				fmt.Printf("  ✗ %s %s\n", text.PadRight(result.Case, 24), result.Error)
+				continue
+			}
+			fmt.Printf("  ✓ %s %.2f (%d slides)\n", text.PadRight(result.Case, 24), result.Overall, result.Slides)
+		}
+	}
+
+	fmt.Printf("\nMean scores:\n")
+	for _, model := range models {
+		fmt.Printf("  %-24s %.2f\n", modelName(model), report.Mean(model))
+	}
+
+	output := evalOutput
+	if output == "" {
+		output = fmt.Sprintf("evals/reports/%s-%s.json", presentation.Slugify(suite.Name), report.Started.Format("20060102-150405"))
+	}
+	if err := report.Save(output); err != nil {
+		return err
+	}
+	fmt.Printf("\n✓ Report saved to %s\n", output)
+
+	if baseline != nil {
+		return printRegressions(eval.Compare(baseline, report, evalThreshold))
+	}
+	return nil
+}
+
+func runEvalCompare(cmd *cobra.Command, args []string) error {
+	baseline, err := eval.LoadReport(args[0])
+	if err != nil {
+		return err
+	}
+	report, err := eval.LoadReport(args[1])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Mean scores:\n")
+	for _, model := range report.Models() {
+		fmt.Printf("  %-24s %.2f → %.2f\n", modelName(model), baseline.Mean(model), report.Mean(model))
+	}
+
+	return printRegressions(eval.Compare(baseline, report, evalThreshold))
+}
+
+// evaluateCases evaluates the cases with the model, up to --concurrency at a
+// time, and returns the results in the order of the cases
+func evaluateCases(ctx context.Context, cases []eval.Case, model string, rubric []string, today string) []eval.Result {
+	results := make([]eval.Result, len(cases))
+	slots := make(chan struct{}, evalParallel)
+
+	var wg sync.WaitGroup
+	for i, c := range cases {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			results[i] = evaluateCase(ctx, c, model, rubric, today)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// evaluateCase generates the case's deck with the model and judges it
+func evaluateCase(ctx context.Context, c eval.Case, model string, rubric []string, today string) eval.Result {
+	generated, err := generatePresentation(ctx, c.Description, c.Answers, today, clientOptions(model)...)
+	if err != nil {
+		return eval.Result{Case: c.Name, Model: model, Error: fmt.Sprintf("generation failed: %v", err)}
+	}
+
+	data := presentation.NewPresentationData(&generated)
+	judgement, err := judgePresentation(ctx, c.Description, rubric, data.GetFactCheckSummary(), clientOptions(evalJudge)...)
+	if err != nil {
+		return eval.Result{Case: c.Name, Model: model, Slides: len(data.Slides), Error: fmt.Sprintf("judging failed: %v", err)}
+	}
+
+	scores := make([]eval.Score, 0, len(judgement.Scores))
+	for _, score := range judgement.Scores {
+		scores = append(scores, eval.Score{Criterion: score.Criterion, Score: int(score.Score), Reason: score.Reason})
+	}
+	return eval.NewResult(c.Name, model, len(data.Slides), scores, judgement.Summary)
+}
+
+// clientOptions selects the named BAML client for a call, or the client
+// configured in baml_src when the name is empty
+func clientOptions(client string) []baml_client.CallOptionFunc {
+	if client == "" {
+		return nil
+	}
+	registry := baml.NewClientRegistry()
+	registry.SetPrimaryClient(client)
+	return []baml_client.CallOptionFunc{baml_client.WithClientRegistry(registry)}
+}
+
+// modelName labels the default client in output
+func modelName(model string) string {
+	if model == "" {
+		return "(default)"
+	}
+	return model
+}
+
+// printRegressions lists the regressions and fails when there are any
+func printRegressions(regressions []eval.Regression) error {
+	if len(regressions) == 0 {
+		fmt.Println("\n✓ No regressions against the baseline")
+		return nil
+	}
+
+	fmt.Printf("\nRegressions:\n")
+	for _, r := range regressions {
+		if r.Error != "" {
+			fmt.Printf("  ✗ %s [%s]: failed (%s)\n", r.Case, modelName(r.Model), r.Error)
+			continue
+		}
+		fmt.Printf("  ✗ %s [%s] %s: %.2f → %.2f\n", r.Case, modelName(r.Model), r.Criterion, r.Before, r.After)
+	}
+	return fmt.Errorf("%d regression(s) against the baseline", len(regressions))
+}
@@ -0,0 +1,491 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/export"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportPath string
+
+	confluenceURL    string
+	confluenceSpace  string
+	confluenceParent string
+	confluenceTree   bool
+	confluenceDryRun bool
+	confluenceSet    map[string]string
+
+	cueCardsOutput   string
+	cueCardsFormat   string
+	cueCardsDuration time.Duration
+	cueCardsSet      map[string]string
+
+	gifOutput string
+	gifSlides string
+	gifDelay  time.Duration
+	gifWidth  int
+	gifSet    map[string]string
+
+	pdfOutput      string
+	pdfChrome      string
+	pdfTimeout     time.Duration
+	pdfSet         map[string]string
+	pdfIncludeTags []string
+	pdfExcludeTags []string
+
+	markdownOutput string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a presentation to another format or service",
+	Long: `Export a presentation to another format or service.
+
+Examples:
+  pres export confluence --path presentations/my-talk.json --space ENG
+  pres export cue-cards --path presentations/my-talk.json --duration 20m
+  pres export gif --path presentations/my-talk.json --slides 1-5
+  pres export pdf --path presentations/my-talk.json
+  pres export markdown --path presentations/my-talk.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var exportConfluenceCmd = &cobra.Command{
+	Use:   "confluence",
+	Short: "Publish a presentation as a Confluence page",
+	Long: `Publish a presentation as a Confluence page through the REST API.
+
+The deck's custom metadata fields are listed in a table at the top. Each
+slide becomes a heading followed by a panel with its content, and speaker
+notes are added as info panels. With --tree, every title slide starts a child
+page holding its section. Pages are matched by title within the space, so
+exporting the same deck again updates its pages in place.
+
+Connection settings are read from the environment:
+  CONFLUENCE_URL    Site URL including the context path, e.g. https://example.atlassian.net/wiki
+  CONFLUENCE_USER   Account email (Confluence Cloud); leave unset to use a personal access token
+  CONFLUENCE_TOKEN  API token (Cloud) or personal access token (Data Center)
+
+Examples:
+  pres export confluence --path presentations/my-talk.json --space ENG
+  pres export confluence --path presentations/my-talk.json --space ENG --parent 123456 --tree
+  pres export confluence --path presentations/my-talk.json --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runExportConfluence,
+}
+
+var exportCueCardsCmd = &cobra.Command{
+	Use:   "cue-cards",
+	Short: "Export speaker cue cards for rehearsal",
+	Long: `Export one cue card per slide for rehearsing away from a screen.
+
+Each card shows the slide title, up to three key points taken from its
+content (or speaker notes), and a timing budget. Budgets are estimated from
+the length of each slide's notes at a typical speaking rate; with --duration
+they are scaled to fill the time slot. Backup slides get cards without a
+budget.
+
+Cards are written as plain text, or as a PDF with one A6 card per page when
+--format is pdf or the output file ends in .pdf. Text is printed to stdout
+unless --output is set.
+
+Examples:
+  pres export cue-cards --path presentations/my-talk.json
+  pres export cue-cards --path presentations/my-talk.json --duration 20m --output cards.pdf
+  pres export cue-cards --path presentations/my-talk.json --format pdf`,
+	Args: cobra.NoArgs,
+	RunE: runExportCueCards,
+}
+
+var exportGIFCmd = &cobra.Command{
+	Use:   "gif",
+	Short: "Export slides as an animated GIF",
+	Long: `Export slides as an animated GIF that cycles through them, for README
+previews and social posts about a talk.
+
+Slides are drawn in the colors of the deck's theme with their title and the
+text of their content; images, diagrams, and custom styling are not rendered.
+Slide numbers are those of the generated deck. Without --slides, every slide
+of the main flow is included.
+
+Examples:
+  pres export gif --path presentations/my-talk.json
+  pres export gif --path presentations/my-talk.json --slides 1-5 --delay 3s
+  pres export gif --path presentations/my-talk.json --slides 1,4,7-9 --width 640 --output preview.gif`,
+	Args: cobra.NoArgs,
+	RunE: runExportGIF,
+}
+
+var exportPDFCmd = &cobra.Command{
+	Use:   "pdf",
+	Short: "Export a presentation as a PDF with headless Chrome",
+	Long: `Export a presentation as a paginated PDF, one slide per page, for sharing
+with people who won't open it in a browser.
+
+The deck is generated as for pres generate and printed by a headless Chrome
+or Chromium with reveal.js's print layout (?print-pdf), so the PDF looks like
+the deck and honors the slides' page-break hints (see pres slide
+page-break). Chrome is looked up on the PATH and in the usual install
+locations; set PRES_CHROME or --chrome to use another executable.
+
+Examples:
+  pres export pdf --path presentations/my-talk.json
+  pres export pdf --path presentations/my-talk.json --output handouts/my-talk.pdf
+  pres export pdf --path presentations/master.json --set region=EU --exclude-tags internal`,
+	Args: cobra.NoArgs,
+	RunE: runExportPDF,
+}
+
+var exportMarkdownCmd = &cobra.Command{
+	Use:   "markdown",
+	Short: "Export a presentation as Marp-compatible Markdown",
+	Long: `Export a presentation as a single Markdown file, for editing in any editor
+and reviewing in pull requests.
+
+The file has the deck's metadata in its front matter, with the reveal.js
+theme under "pres-theme" and custom fields under "custom", and one section
+per slide, separated by "---", which Marp and reveal-md can present
+directly. Speaker notes are kept in HTML comments. Variables are left as
+{{name}} placeholders. Slide IDs, tags, comments, and other fields pres
+manages itself are not exported. pres import reads the file back.
+
+Examples:
+  pres export markdown --path presentations/my-talk.json
+  pres export markdown --path presentations/my-talk.json --output -`,
+	Args: cobra.NoArgs,
+	RunE: runExportMarkdown,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.AddCommand(exportConfluenceCmd)
+	exportCmd.AddCommand(exportCueCardsCmd)
+	exportCmd.AddCommand(exportGIFCmd)
+	exportCmd.AddCommand(exportPDFCmd)
+	exportCmd.AddCommand(exportMarkdownCmd)
+
+	exportConfluenceCmd.Flags().StringVarP(&exportPath, "path", "p", "", "Path to presentation JSON file (required)")
+	exportConfluenceCmd.Flags().StringVar(&confluenceURL, "url", os.Getenv("CONFLUENCE_URL"), "Confluence site URL (default: $CONFLUENCE_URL)")
+	exportConfluenceCmd.Flags().StringVar(&confluenceSpace, "space", "", "Key of the space to publish into")
+	exportConfluenceCmd.Flags().StringVar(&confluenceParent, "parent", "", "ID of the page to publish under")
+	exportConfluenceCmd.Flags().BoolVar(&confluenceTree, "tree", false, "Publish each section as a child page")
+	exportConfluenceCmd.Flags().BoolVar(&confluenceDryRun, "dry-run", false, "Print the pages in storage format instead of publishing")
+	exportConfluenceCmd.Flags().StringToStringVar(&confluenceSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	exportConfluenceCmd.MarkFlagRequired("path")
+
+	exportCueCardsCmd.Flags().StringVarP(&exportPath, "path", "p", "", "Path to presentation JSON file (required)")
+	exportCueCardsCmd.Flags().StringVarP(&cueCardsOutput, "output", "o", "", "Output file (default: stdout for text, <name>-cue-cards.pdf for pdf)")
+	exportCueCardsCmd.Flags().StringVar(&cueCardsFormat, "format", "", "Output format: text or pdf (default: from the output extension, else text)")
+	exportCueCardsCmd.Flags().DurationVar(&cueCardsDuration, "duration", 0, "Length of the time slot to budget, e.g. 20m")
+	exportCueCardsCmd.Flags().StringToStringVar(&cueCardsSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	exportCueCardsCmd.MarkFlagRequired("path")
+
+	exportGIFCmd.Flags().StringVarP(&exportPath, "path", "p", "", "Path to presentation JSON file (required)")
+	exportGIFCmd.Flags().StringVarP(&gifOutput, "output", "o", "", "Output file (default: same name as JSON with .gif extension)")
+	exportGIFCmd.Flags().StringVar(&gifSlides, "slides", "", "Slides to include, e.g. 1-5 or 1,3,5-7 (default: the main flow)")
+	exportGIFCmd.Flags().DurationVar(&gifDelay, "delay", 3*time.Second, "How long each slide is shown")
+	exportGIFCmd.Flags().IntVar(&gifWidth, "width", presentation.DeckWidth, "Width of the GIF in pixels")
+	exportGIFCmd.Flags().StringToStringVar(&gifSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	exportGIFCmd.MarkFlagRequired("path")
+
+	exportPDFCmd.Flags().StringVarP(&exportPath, "path", "p", "", "Path to presentation JSON file (required)")
+	exportPDFCmd.Flags().StringVarP(&pdfOutput, "output", "o", "", "Output file (default: same name as JSON with .pdf extension)")
+	exportPDFCmd.Flags().StringVar(&pdfChrome, "chrome", "", "Chrome or Chromium executable (default: $PRES_CHROME, else found automatically)")
+	exportPDFCmd.Flags().DurationVar(&pdfTimeout, "timeout", time.Minute, "How long printing may take")
+	exportPDFCmd.Flags().StringToStringVar(&pdfSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	exportPDFCmd.Flags().StringSliceVar(&pdfIncludeTags, "include-tags", nil, "Only include tagged slides with one of these tags")
+	exportPDFCmd.Flags().StringSliceVar(&pdfExcludeTags, "exclude-tags", nil, "Leave out slides with any of these tags")
+	exportPDFCmd.MarkFlagRequired("path")
+
+	exportMarkdownCmd.Flags().StringVarP(&exportPath, "path", "p", "", "Path to presentation JSON file (required)")
+	exportMarkdownCmd.Flags().StringVarP(&markdownOutput, "output", "o", "", "Output file, or - for stdout (default: same name as JSON with .md extension)")
+	exportMarkdownCmd.MarkFlagRequired("path")
+}
+
+func runExportConfluence(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(exportPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	data.Metadata.SetVariables(confluenceSet)
+
+	data, warnings, err := presentation.NewGenerator().Prepare(data, exportPath)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	page, err := export.ConfluencePages(data, confluenceTree)
+	if err != nil {
+		return fmt.Errorf("failed to convert presentation: %w", err)
+	}
+
+	if confluenceDryRun {
+		printConfluencePage(page)
+		return nil
+	}
+
+	config := export.ConfluenceConfig{
+		BaseURL:  confluenceURL,
+		Username: os.Getenv("CONFLUENCE_USER"),
+		Token:    os.Getenv("CONFLUENCE_TOKEN"),
+		Space:    confluenceSpace,
+		ParentID: confluenceParent,
+	}
+	if config.BaseURL == "" {
+		return fmt.Errorf("a Confluence URL is required (set --url or CONFLUENCE_URL)")
+	}
+	if config.Space == "" {
+		return fmt.Errorf("a space key is required (set --space)")
+	}
+	if config.Token == "" {
+		return fmt.Errorf("CONFLUENCE_TOKEN is not set")
+	}
+
+	fmt.Printf("📤 Publishing to Confluence: %s\n", data.Metadata.Title)
+
+	client := export.NewConfluenceClient(config)
+	pageURL, err := client.Publish(context.Background(), page)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✓ Published successfully!\n")
+	fmt.Printf("  Page: %s\n", pageURL)
+	fmt.Printf("  Space: %s\n", config.Space)
+	if len(page.Children) > 0 {
+		fmt.Printf("  Sections: %d\n", len(page.Children))
+	}
+
+	return nil
+}
+
+// printConfluencePage prints a page and its children in storage format
+func printConfluencePage(page export.ConfluencePage) {
+	fmt.Printf("=== %s ===\n%s\n\n", page.Title, page.Body)
+	for _, child := range page.Children {
+		printConfluencePage(child)
+	}
+}
+
+func runExportCueCards(cmd *cobra.Command, args []string) error {
+	format := strings.ToLower(cueCardsFormat)
+	if format == "" {
+		format = "text"
+		if strings.EqualFold(filepath.Ext(cueCardsOutput), ".pdf") {
+			format = "pdf"
+		}
+	}
+	if format != "text" && format != "pdf" {
+		return fmt.Errorf("unknown format %q (expected text or pdf)", cueCardsFormat)
+	}
+
+	writer := newWriter()
+	data, err := writer.LoadPresentation(exportPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	data.Metadata.SetVariables(cueCardsSet)
+
+	data, warnings, err := presentation.NewGenerator().Prepare(data, exportPath)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	cards := export.CueCards(data, cueCardsDuration)
+
+	outputPath := cueCardsOutput
+	if outputPath == "" && format == "pdf" {
+		base := filepath.Base(exportPath)
+		name := strings.TrimSuffix(base, filepath.Ext(base))
+		outputPath = filepath.Join(filepath.Dir(exportPath), name+"-cue-cards.pdf")
+	}
+
+	if outputPath == "" {
+		return export.WriteCueCardsText(os.Stdout, data.Metadata.Title, cards)
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	if format == "pdf" {
+		err = export.WriteCueCardsPDF(file, data.Metadata.Title, cards)
+	} else {
+		err = export.WriteCueCardsText(file, data.Metadata.Title, cards)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write cue cards: %w", err)
+	}
+
+	fmt.Printf("✓ Cue cards exported successfully!\n")
+	fmt.Printf("  Location: %s\n", outputPath)
+	fmt.Printf("  Cards: %d\n", len(cards))
+
+	return nil
+}
+
+func runExportGIF(cmd *cobra.Command, args []string) error {
+	if gifWidth < 160 || gifWidth > 3840 {
+		return fmt.Errorf("width must be between 160 and 3840 pixels")
+	}
+	if gifDelay <= 0 {
+		return fmt.Errorf("delay must be positive")
+	}
+
+	writer := newWriter()
+	data, err := writer.LoadPresentation(exportPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	data.Metadata.SetVariables(gifSet)
+
+	data, warnings, err := presentation.NewGenerator().Prepare(data, exportPath)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	var indexes []int
+	if gifSlides == "" {
+		for i, slide := range data.Slides {
+			if !slide.Hidden {
+				indexes = append(indexes, i)
+			}
+		}
+	} else {
+		if indexes, err = parseSlideRange(gifSlides); err != nil {
+			return err
+		}
+		for _, index := range indexes {
+			if index >= len(data.Slides) {
+				return fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+			}
+		}
+	}
+
+	outputPath := gifOutput
+	if outputPath == "" {
+		base := filepath.Base(exportPath)
+		name := strings.TrimSuffix(base, filepath.Ext(base))
+		outputPath = filepath.Join(filepath.Dir(exportPath), name+".gif")
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	if err := export.WriteGIF(file, data, indexes, export.GIFOptions{Width: gifWidth, Delay: gifDelay}); err != nil {
+		return fmt.Errorf("failed to write GIF: %w", err)
+	}
+
+	fmt.Printf("✓ GIF exported successfully!\n")
+	fmt.Printf("  Location: %s\n", outputPath)
+	fmt.Printf("  Slides: %d\n", len(indexes))
+
+	return nil
+}
+
+func runExportPDF(cmd *cobra.Command, args []string) error {
+	chrome := pdfChrome
+	if chrome == "" {
+		var err error
+		if chrome, err = export.FindChrome(); err != nil {
+			return err
+		}
+	}
+
+	writer := newWriter()
+	data, err := writer.LoadPresentation(exportPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	data.Metadata.SetVariables(pdfSet)
+
+	generator := presentation.NewGenerator()
+	if err := applyTagFilters(generator, pdfIncludeTags, pdfExcludeTags); err != nil {
+		return err
+	}
+	data, warnings, err := generator.Prepare(data, exportPath)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	outputPath := pdfOutput
+	if outputPath == "" {
+		base := filepath.Base(exportPath)
+		name := strings.TrimSuffix(base, filepath.Ext(base))
+		outputPath = filepath.Join(filepath.Dir(exportPath), name+".pdf")
+	}
+
+	// The deck is written next to the JSON so relative images resolve
+	html, err := os.CreateTemp(filepath.Dir(exportPath), ".pres-print-*.html")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	html.Close()
+	defer os.Remove(html.Name())
+
+	if err := generator.GenerateHTML(data, html.Name()); err != nil {
+		return fmt.Errorf("failed to generate HTML: %w", err)
+	}
+
+	fmt.Printf("🖨  Printing %s with %s...\n", data.Metadata.Title, filepath.Base(chrome))
+	if err := export.PrintPDF(context.Background(), chrome, html.Name(), outputPath, pdfTimeout); err != nil {
+		return fmt.Errorf("failed to print PDF: %w", err)
+	}
+
+	fmt.Printf("✓ PDF exported successfully!\n")
+	fmt.Printf("  Location: %s\n", outputPath)
+	fmt.Printf("  Slides: %d\n", len(data.Slides))
+
+	return nil
+}
+
+func runExportMarkdown(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(exportPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	markdown := data.Markdown()
+	if markdownOutput == "-" {
+		fmt.Print(markdown)
+		return nil
+	}
+
+	outputPath := markdownOutput
+	if outputPath == "" {
+		base := filepath.Base(exportPath)
+		name := strings.TrimSuffix(base, filepath.Ext(base))
+		outputPath = filepath.Join(filepath.Dir(exportPath), name+".md")
+	}
+
+	if err := os.WriteFile(outputPath, []byte(markdown), 0644); err != nil {
+		return fmt.Errorf("failed to write Markdown: %w", err)
+	}
+
+	fmt.Printf("✓ Markdown exported successfully!\n")
+	fmt.Printf("  Location: %s\n", outputPath)
+	fmt.Printf("  Slides: %d\n", len(data.Slides))
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/spf13/cobra"
+)
+
+var (
+	factcheckPath   string
+	factcheckDryRun bool
+)
+
+var factcheckCmd = &cobra.Command{
+	Use:   "factcheck",
+	Short: "Flag suspect factual claims with AI",
+	Long: `Extract the factual claims made on a presentation's slides and flag the
+ones that are unverifiable or likely wrong, such as statistics without a
+source, outdated version numbers, or dates that contradict each other.
+
+Nothing on the slides is changed. Each flagged claim becomes a review
+comment on its slide, authored by "pres factcheck", so generated content is
+checked by a person before it is presented. Claims that already have an
+unresolved comment are not flagged again.
+
+Slides shared from other decks are checked in the deck that owns them.
+
+Examples:
+  pres factcheck --path presentations/my-talk.json
+  pres factcheck --path presentations/my-talk.json --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runFactcheck,
+}
+
+func init() {
+	rootCmd.AddCommand(factcheckCmd)
+
+	factcheckCmd.Flags().StringVarP(&factcheckPath, "path", "p", "", "Path to presentation JSON file (required)")
+	factcheckCmd.Flags().BoolVar(&factcheckDryRun, "dry-run", false, "Show flagged claims without adding comments")
+	factcheckCmd.MarkFlagRequired("path")
+}
+
+func runFactcheck(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	writer := newWriter()
+	data, err := writer.LoadPresentation(factcheckPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	fmt.Printf("🔎 Fact-checking: %s (%d slides)\n\n", data.Metadata.Title, len(data.Slides))
+
+	claims, err := factCheckPresentation(ctx, data.GetFactCheckSummary())
+	if err != nil {
+		return fmt.Errorf("failed to check claims: %w", err)
+	}
+
+	var flagged []types.FlaggedClaim
+	for _, claim := range claims {
+		if err := data.CheckClaim(claim); err != nil {
+			fmt.Printf("⚠ Ignoring flagged claim: %v\n", err)
+			continue
+		}
+		flagged = append(flagged, claim)
+	}
+
+	if len(flagged) == 0 {
+		fmt.Println("✓ No suspect claims found")
+		return nil
+	}
+
+	for _, claim := range flagged {
+		fmt.Printf("✗ Slide %d (%s): %q\n", claim.Slide_index+1, claim.Verdict, claim.Claim)
+		if claim.Reason != "" {
+			fmt.Printf("    %s\n", claim.Reason)
+		}
+	}
+
+	if factcheckDryRun {
+		fmt.Printf("\n%d claims flagged (dry run, no comments added)\n", len(flagged))
+		return nil
+	}
+
+	added, err := writer.AnnotateClaims(factcheckPath, flagged)
+	if err != nil {
+		return fmt.Errorf("failed to add comments: %w", err)
+	}
+
+	fmt.Printf("\n✓ Added %d review comments", len(added))
+	if skipped := len(flagged) - len(added); skipped > 0 {
+		fmt.Printf(" (%d already commented)", skipped)
+	}
+	fmt.Println()
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the comments: pres comment list --path %s\n", factcheckPath)
+	fmt.Printf("  • Resolve checked claims: pres comment resolve --path %s <id>\n", factcheckPath)
+	fmt.Printf("  • Fix the slides with AI: pres update --path %s --from-comments\n", factcheckPath)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var feedbackPath string
+
+var feedbackCmd = &cobra.Command{
+	Use:   "feedback",
+	Short: "Review audience feedback on a presentation",
+	Long: `Review the ratings and comments the audience left at the end of the deck
+while it was served with pres serve --feedback.
+
+Examples:
+  pres feedback report --path presentations/my-talk.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var feedbackReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Summarize the feedback on a presentation",
+	Args:  cobra.NoArgs,
+	RunE:  runFeedbackReport,
+}
+
+func init() {
+	rootCmd.AddCommand(feedbackCmd)
+	feedbackCmd.AddCommand(feedbackReportCmd)
+
+	feedbackReportCmd.Flags().StringVarP(&feedbackPath, "path", "p", "", "Path to presentation JSON file (required)")
+	feedbackReportCmd.MarkFlagRequired("path")
+}
+
+func runFeedbackReport(cmd *cobra.Command, args []string) error {
+	responses, err := presentation.ReadFeedback(feedbackPath)
+	if err != nil {
+		return err
+	}
+
+	summary := presentation.SummarizeFeedback(responses)
+	if summary.Responses == 0 {
+		fmt.Println("No feedback yet.")
+		fmt.Printf("\nCollect feedback with: pres serve --path %s --feedback\n", feedbackPath)
+		return nil
+	}
+
+	fmt.Printf("📊 Feedback Report\n\n")
+	fmt.Printf("Responses: %d\n", summary.Responses)
+	fmt.Printf("Average:   %.1f / %d\n", summary.Average, presentation.MaxRating)
+	if summary.First.Format("2006-01-02") == summary.Last.Format("2006-01-02") {
+		fmt.Printf("Collected: %s\n", summary.First.Format("2006-01-02"))
+	} else {
+		fmt.Printf("Collected: %s to %s\n", summary.First.Format("2006-01-02"), summary.Last.Format("2006-01-02"))
+	}
+
+	fmt.Printf("\nRatings:\n")
+	const barWidth = 30
+	for rating := presentation.MaxRating; rating >= 1; rating-- {
+		count := summary.Ratings[rating-1]
+		bar := count * barWidth / summary.Responses
+		fmt.Printf("  %d ★  %-*s %d\n", rating, barWidth, strings.Repeat("█", bar), count)
+	}
+
+	if len(summary.Comments) > 0 {
+		fmt.Printf("\nComments (%d):\n", len(summary.Comments))
+		for _, feedback := range summary.Comments {
+			comment := strings.Join(strings.Fields(feedback.Comment), " ")
+			fmt.Printf("  [%d ★] %s\n", feedback.Rating, comment)
+		}
+	}
+
+	return nil
+}
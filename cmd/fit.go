@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fitPath     string
+	fitDuration time.Duration
+	fitRounds   int
+	fitYes      bool
+)
+
+var fitCmd = &cobra.Command{
+	Use:   "fit",
+	Short: "Trim a presentation to fit a time slot with AI",
+	Long: `Trim a presentation until its estimated delivery time fits a time slot.
+
+The time of each slide is estimated as for pres info: from its speaker notes
+at a typical speaking rate, or its time budget when one is set. AI plans the
+cuts: condensing long notes and content, merging overlapping slides, and
+cutting the least essential ones. The cuts are made in rounds, each planned
+from the deck as the previous round left it, until the deck fits or
+--rounds is reached.
+
+Nothing is saved until you have seen exactly what was cut: every slide that
+was cut, condensed, or added by a merge, with the time it saved. The changes
+are then saved as one update, recorded in the audit log. Locked slides and
+hidden backup slides are left alone.
+
+Examples:
+  pres fit --path presentations/my-talk.json --duration 15m
+  pres fit --path presentations/keynote.json --duration 20m --rounds 5
+  pres fit --path presentations/my-talk.json --duration 10m --yes`,
+	Args: cobra.NoArgs,
+	RunE: runFit,
+}
+
+func init() {
+	rootCmd.AddCommand(fitCmd)
+
+	fitCmd.Flags().StringVarP(&fitPath, "path", "p", "", "Path to presentation JSON file (required)")
+	fitCmd.Flags().DurationVarP(&fitDuration, "duration", "d", 0, "Length of the time slot, e.g. 15m (required)")
+	fitCmd.Flags().IntVar(&fitRounds, "rounds", 3, "Most rounds of cuts to plan")
+	fitCmd.Flags().BoolVarP(&fitYes, "yes", "y", false, "Save the fitted presentation without asking for approval")
+	fitCmd.MarkFlagRequired("path")
+	fitCmd.MarkFlagRequired("duration")
+}
+
+func runFit(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if fitDuration < time.Minute {
+		return fmt.Errorf("invalid --duration %s: the time slot must be at least a minute", fitDuration)
+	}
+	if fitRounds < 1 {
+		return fmt.Errorf("--rounds must be at least 1")
+	}
+
+	fmt.Printf("⏱ Fitting presentation to %s: %s\n", presentation.FormatClock(fitDuration), fitPath)
+
+	writer := newWriter()
+	writer.SetAudit("pres fit", auditActor())
+	// Slides are matched by ID to report what was cut
+	data, err := writer.EnsureSlideIDs(fitPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	writer.SetPolicy(&presentation.UpdatePolicy{MaxDeletes: -1})
+
+	total := data.TotalTimeBudget()
+	fmt.Printf("Loaded: %s (%d slides, %s)\n", data.Metadata.Title, len(data.Slides), presentation.FormatClock(total))
+	if total <= fitDuration {
+		fmt.Printf("\n✓ The presentation already fits: %s of %s\n", presentation.FormatClock(total), presentation.FormatClock(fitDuration))
+		return nil
+	}
+
+	session, err := newAISession("pres fit", fmt.Sprintf("Fit the presentation to %s", presentation.FormatClock(fitDuration)), "")
+	if err != nil {
+		return err
+	}
+
+	fitted := data.Clone()
+	var updates []types.PresentationUpdate
+	for round := 1; round <= fitRounds; round++ {
+		before := fitted.TotalTimeBudget()
+		if before <= fitDuration {
+			break
+		}
+		fmt.Printf("\nRound %d: %s over\n", round, presentation.FormatClock(before-fitDuration))
+
+		timing := fitted.TimingReport()
+		deck := fitted.BuildContext(nil, presentation.EstimateTokens(timing), presentation.DefaultContextBudget)
+		planned, err := fitPresentationToDuration(ctx, deck.Text, timing, int64(fitDuration.Seconds()), session.option())
+		if err != nil {
+			return fmt.Errorf("failed to plan cuts: %w", err)
+		}
+
+		results, err := writer.ApplyUpdates(fitted, planned)
+		if err != nil {
+			return fmt.Errorf("failed to apply cuts: %w", err)
+		}
+		for i, result := range results {
+			if result.Applied {
+				// Only the operations that applied are saved, so replaying
+				// them on the file gives the same deck
+				updates = append(updates, planned[i])
+				fmt.Printf("  ✓ %s: %s\n", planned[i].Operation, planned[i].Rationale)
+			} else {
+				fmt.Printf("  ✗ %s skipped: %s\n", planned[i].Operation, result.Reason)
+			}
+		}
+
+		after := fitted.TotalTimeBudget()
+		fmt.Printf("  %s → %s\n", presentation.FormatClock(before), presentation.FormatClock(after))
+		if after >= before {
+			fmt.Println("  ⚠ This round saved no time; stopping")
+			break
+		}
+	}
+
+	changes := presentation.FitChanges(data, fitted)
+	if len(changes) == 0 {
+		fmt.Println("\n⚠ No cuts could be made; nothing was changed")
+		return nil
+	}
+
+	printFitChanges(changes)
+	fitTotal := fitted.TotalTimeBudget()
+	fmt.Printf("\nEstimated time: %s → %s (slot %s)\n", presentation.FormatClock(total), presentation.FormatClock(fitTotal), presentation.FormatClock(fitDuration))
+	if fitTotal > fitDuration {
+		fmt.Printf("⚠ Still %s over after %d rounds; run pres fit again or cut more by hand\n", presentation.FormatClock(fitTotal-fitDuration), fitRounds)
+	}
+
+	if !fitYes {
+		ok, err := confirmBatch("Save the fitted presentation?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("✗ Cuts discarded; nothing was changed")
+			return nil
+		}
+	}
+
+	writer.AddProvenance(session.collect()...)
+	results, err := writer.UpdatePresentation(fitPath, updates, false)
+	if errors.Is(err, presentation.ErrUpdateRejected) {
+		printOperationResults(results)
+		return fmt.Errorf("the presentation changed while it was being fitted: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	fmt.Printf("\n✓ Presentation fitted successfully!\n")
+	fmt.Printf("  Location: %s\n", fitPath)
+	fmt.Printf("  Slides: %d (was %d)\n", len(fitted.Slides), len(data.Slides))
+	fmt.Printf("  Estimated time: %s\n", presentation.FormatClock(fitTotal))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the changes: pres audit --path %s\n", fitPath)
+	fmt.Printf("  • Rehearse with the clock: pres present --path %s\n", fitPath)
+
+	return nil
+}
+
+// printFitChanges lists the slides fitting cut, condensed, or added, with
+// the time each saved
+func printFitChanges(changes []presentation.FitChange) {
+	fmt.Printf("\nWhat was cut:\n")
+	for _, change := range changes {
+		switch change.Kind {
+		case presentation.FitCut:
+			fmt.Printf("  - slide %d: cut %q (-%s)\n", change.Index+1, change.Title, presentation.FormatClock(change.Saved()))
+		case presentation.FitCondensed:
+			fmt.Printf("  ~ slide %d: condensed %q, %s → %s\n", change.Index+1, change.Title, presentation.FormatClock(change.Before), presentation.FormatClock(change.After))
+		case presentation.FitAdded:
+			fmt.Printf("  + slide %d: added %q (+%s)\n", change.Index+1, change.Title, presentation.FormatClock(change.After))
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/export"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flowPath   string
+	flowFormat string
+	flowOutput string
+)
+
+var flowCmd = &cobra.Command{
+	Use:   "flow",
+	Short: "Show a presentation's storyline",
+	Long: `Show the narrative structure of a presentation: its sections, the titles of
+their slides, and how long each takes, to spot structural problems before
+polishing content.
+
+Every title slide starts a section; slides before the first title slide form
+the opening, and hidden slides are listed as backup. Durations are the time
+budgets set with pres slide budget, or estimates from the speaker notes.
+
+Formats:
+  tree     a tree in the terminal, followed by the problems found: sections
+           with no slides or too many, sections that take more than twice the
+           average, untitled slides, and neighbouring slides sharing a title
+  mermaid  a Mermaid flowchart with a subgraph per section
+  dot      a Graphviz graph with a cluster per section
+
+The format is taken from the output extension (.mmd, .dot, .gv) unless
+--format is given.
+
+Examples:
+  pres flow --path presentations/my-talk.json
+  pres flow --path presentations/my-talk.json --format mermaid
+  pres flow --path presentations/my-talk.json --output my-talk.dot`,
+	Args: cobra.NoArgs,
+	RunE: runFlow,
+}
+
+func init() {
+	rootCmd.AddCommand(flowCmd)
+
+	flowCmd.Flags().StringVarP(&flowPath, "path", "p", "", "Path to presentation JSON file (required)")
+	flowCmd.Flags().StringVar(&flowFormat, "format", "", "Output format: tree, mermaid, or dot (default: from the output extension, else tree)")
+	flowCmd.Flags().StringVarP(&flowOutput, "output", "o", "", "Output file (default: stdout)")
+	flowCmd.MarkFlagRequired("path")
+}
+
+func runFlow(cmd *cobra.Command, args []string) error {
+	format := strings.ToLower(flowFormat)
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(flowOutput)) {
+		case ".mmd", ".mermaid":
+			format = "mermaid"
+		case ".dot", ".gv":
+			format = "dot"
+		default:
+			format = "tree"
+		}
+	}
+
+	var write func(io.Writer, export.Flow) error
+	switch format {
+	case "tree":
+		write = export.WriteFlowTree
+	case "mermaid":
+		write = export.WriteFlowMermaid
+	case "dot", "graphviz":
+		write = export.WriteFlowDOT
+	default:
+		return fmt.Errorf("unknown format %q (expected tree, mermaid, or dot)", flowFormat)
+	}
+
+	writer := newWriter()
+	data, err := writer.LoadPresentation(flowPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	resolved, err := presentation.NewGenerator().ResolveReferences(data, flowPath)
+	if err != nil {
+		return err
+	}
+
+	flow := export.StoryFlow(resolved.ExpandVariables())
+
+	if flowOutput == "" {
+		if format == "tree" {
+			fmt.Printf("🧭 Storyline: ")
+		}
+		if err := write(os.Stdout, flow); err != nil {
+			return err
+		}
+		if format == "tree" {
+			printFlowProblems(flow)
+		}
+		return nil
+	}
+
+	file, err := os.Create(flowOutput)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	if err := write(file, flow); err != nil {
+		return fmt.Errorf("failed to write storyline: %w", err)
+	}
+
+	fmt.Printf("✓ Storyline exported successfully!\n")
+	fmt.Printf("  Location: %s\n", flowOutput)
+	fmt.Printf("  Sections: %d\n", len(flow.Sections))
+	printFlowProblems(flow)
+
+	return nil
+}
+
+// printFlowProblems lists the structural problems found in the storyline
+func printFlowProblems(flow export.Flow) {
+	problems := flow.Problems()
+	if len(problems) == 0 {
+		fmt.Println("\n✓ No structural problems found")
+		return
+	}
+	fmt.Printf("\n⚠️  Structural problems:\n")
+	for _, problem := range problems {
+		fmt.Printf("  • %s\n", problem)
+	}
+}
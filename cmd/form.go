@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/tui"
+)
+
+// runForm runs the Q&A form until its questions are answered or it is
+// cancelled, returning its final state
+func runForm(form tui.IterativeFormModel) (tui.IterativeFormModel, error) {
+	final, err := tea.NewProgram(form).Run()
+	if err != nil {
+		return form, err
+	}
+	return final.(tui.IterativeFormModel), nil
+}
+
+// formQuestion converts a question of the AI to one of the Q&A form
+func formQuestion(q types.PresentationQuestion) tui.IterativeQuestion {
+	kinds := map[string]tui.QuestionKind{
+		"choice":       tui.ChoiceQuestion,
+		"multi_select": tui.MultiSelectQuestion,
+		"yes_no":       tui.YesNoQuestion,
+	}
+	return tui.IterativeQuestion{
+		Question:  q.Question,
+		HelpText:  q.Help_text,
+		Iteration: int(q.Iteration),
+		Optional:  q.Optional,
+		Kind:      kinds[strings.ToLower(strings.TrimSpace(q.Kind))],
+		Options:   q.Options,
+	}
+}
+
+// formResponse formats a Q&A form answer as context for the AI, marking a
+// skipped optional question so it isn't asked again
+func formResponse(question, answer string) string {
+	if answer == "" {
+		answer = "(skipped)"
+	}
+	return fmt.Sprintf("Q: %s\nA: %s", question, answer)
+}
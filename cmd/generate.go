@@ -1,17 +1,37 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 
+	"github.com/geoffjay/pres/internal/platform"
 	"github.com/geoffjay/pres/internal/presentation"
 	"github.com/spf13/cobra"
 )
 
 var (
-	generatePath   string
-	generateOutput string
+	generatePath        string
+	generateOutput      string
+	generateSet         map[string]string
+	generateWebcam      bool
+	generateOffline     bool
+	generateCSP         bool
+	generateAllow       []string
+	generateIncludeTags []string
+	generateExcludeTags []string
+	generateTemplate    string
+	generateImages      bool
+	generateTheme       string
+	generateAspect      string
+	generateFooter      string
+	generateProfile     string
+	generateOpen        bool
+	generateMatch       []string
+	generateParallel    int
 )
 
 var generateCmd = &cobra.Command{
@@ -26,31 +46,145 @@ The command will:
 
 The generated HTML file can be opened directly in a browser.
 
+With --webcam, the deck shows your webcam in a small bubble in the corner
+(drag to move, double-click to resize, C to toggle), for recording
+walkthrough videos with any screen recorder.
+
+With --offline, a web app manifest and service worker are written next to
+the HTML, so the deck can be installed on a tablet or laptop and keeps
+working without a network once it has been opened. Service workers only run
+on pages served over https:// or from localhost.
+
+With --csp, a Content-Security-Policy meta tag is added for locked-down
+hosting, and generation fails if the slides load resources that are not
+local, from the reveal.js CDN, or from an origin given with --allow.
+
+With --include-tags, only tagged slides with one of the given tags are
+included, to assemble a deck by topic; slides without tags, such as the title
+slide, are always included. --exclude-tags leaves out slides with any of the
+given tags. Tag slides with pres slide tag.
+
+With --template, the page is built from your own html/template file instead
+of the built-in one, for custom head tags, a company wrapper, or analytics.
+The template must place {{.Head}} in the head, {{.Slides}} inside
+<div class="reveal"><div class="slides">, and {{.Scripts}} at the end of the
+body, and may use the deck's {{.Title}}, {{.Subtitle}}, {{.Author}},
+{{.Date}}, {{.Theme}}, and custom fields ({{.Custom.team}}).
+
+With --images, images are first generated for the slides with an image
+prompt and no image for it yet, through the OpenAI Images API
+($OPENAI_API_KEY), and saved to the deck. Title and blank slides show their
+image as the background; other slides show it below their content. Images
+are only regenerated when a slide's prompt changes.
+
+Variables given with --set override the deck's own for this build, which
+also decides which slides with a when condition are included.
+
+--theme builds the deck with another reveal.js theme without changing the
+file, --aspect lays the slides out at an aspect ratio such as 16:9, and
+--footer shows a line of text at the bottom of every slide.
+
+With --match, every presentation matching the glob patterns is generated,
+several at a time (--concurrency, one per CPU by default), each next to its
+JSON file or into the directory given with --output. Each deck is reported
+with how long it took as it finishes, and only the decks being generated are
+held in memory.
+
+With --profile, the flags are taken from a named profile configured under
+profiles in the config file or the project's pres.yaml (see pres config), so
+a deck can be built for a conference or an internal audience without
+repeating them. Flags given on the command line override the profile's, and
+--set variables are added to its own:
+
+  profiles:
+    conference:
+      theme: night
+      aspect: "16:9"
+      offline: true
+    internal:
+      theme: white
+      footer: Acme Corp - Internal use only
+      exclude_tags: [customer]
+
 Examples:
   pres generate --path presentations/my-talk.json
-  pres generate --path presentations/review.json --output output/review.html`,
+  pres generate --path presentations/review.json --output output/review.html
+  pres generate --path presentations/master.json --set region=EU --output output/master-eu.html
+  pres generate --path presentations/my-talk.json --webcam
+  pres generate --path presentations/my-talk.json --offline
+  pres generate --path presentations/my-talk.json --csp --allow https://images.example.com
+  pres generate --path presentations/platform.json --include-tags demo,metrics --output output/platform-demo.html
+  pres generate --path presentations/my-talk.json --template templates/acme.html
+  pres generate --path presentations/my-talk.json --images
+  pres generate --path presentations/my-talk.json --theme white --aspect 16:9 --footer "Acme Corp - Internal"
+  pres generate --path presentations/my-talk.json --profile conference
+  pres generate --path presentations/my-talk.json --open
+  pres generate --match "presentations/*.json" --output site/decks --concurrency 8`,
 	RunE: runGenerate,
 }
 
 func init() {
 	rootCmd.AddCommand(generateCmd)
 
-	generateCmd.Flags().StringVarP(&generatePath, "path", "p", "", "Path to presentation JSON file (required)")
-	generateCmd.Flags().StringVarP(&generateOutput, "output", "o", "", "Output path for HTML file (default: same name as JSON with .html extension)")
-	generateCmd.MarkFlagRequired("path")
+	generateCmd.Flags().StringVarP(&generatePath, "path", "p", "", "Path to presentation JSON file")
+	generateCmd.Flags().StringSliceVarP(&generateMatch, "match", "m", nil, "Glob pattern selecting presentation JSON files to generate (can be repeated)")
+	generateCmd.Flags().IntVarP(&generateParallel, "concurrency", "j", runtime.NumCPU(), "Presentations to generate at once with --match")
+	generateCmd.Flags().StringVarP(&generateOutput, "output", "o", "", "Output path for HTML file, or directory with --match (default: same name as JSON with .html extension)")
+	generateCmd.Flags().StringToStringVar(&generateSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	generateCmd.Flags().BoolVar(&generateWebcam, "webcam", false, "Include a self-view webcam bubble for recording walkthroughs")
+	generateCmd.Flags().BoolVar(&generateOffline, "offline", false, "Make the deck installable and viewable offline")
+	generateCmd.Flags().BoolVar(&generateCSP, "csp", false, "Add a Content-Security-Policy and check that all resources are local or allowlisted")
+	generateCmd.Flags().StringSliceVar(&generateAllow, "allow", nil, "Origin the deck may load resources from with --csp (can be repeated)")
+	generateCmd.Flags().StringSliceVar(&generateIncludeTags, "include-tags", nil, "Only include tagged slides with one of these tags")
+	generateCmd.Flags().StringSliceVar(&generateExcludeTags, "exclude-tags", nil, "Leave out slides with any of these tags")
+	generateCmd.Flags().StringVar(&generateTemplate, "template", "", "HTML document template to build the page from (default: built-in)")
+	generateCmd.Flags().BoolVar(&generateImages, "images", false, "Generate images for slides with an image prompt before building the page")
+	generateCmd.Flags().StringVar(&generateTheme, "theme", "", "reveal.js theme to build with instead of the deck's own")
+	generateCmd.Flags().StringVar(&generateAspect, "aspect", "", "Aspect ratio to lay the slides out at, e.g. 16:9 (default: reveal.js's 960x700)")
+	generateCmd.Flags().StringVar(&generateFooter, "footer", "", "Text to show at the bottom of every slide")
+	generateCmd.Flags().BoolVar(&generateOpen, "open", false, "Open the generated deck in the default browser")
+	generateCmd.Flags().StringVar(&generateProfile, "profile", "", "Named output profile to take flags from (see pres generate --help)")
+	addImageFlags(generateCmd)
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
+	if len(generateMatch) > 0 {
+		if generatePath != "" {
+			return fmt.Errorf("--path cannot be combined with --match")
+		}
+		return runGenerateBatch(cmd)
+	}
+	if generatePath == "" {
+		return fmt.Errorf("a presentation is required (set --path, or --match to generate several)")
+	}
+
 	fmt.Printf("📄 Generating HTML from: %s\n", generatePath)
+	if err := applyGenerateProfile(cmd); err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	if generateImages {
+		if err := generateSlideImages(context.Background(), writer, generatePath); err != nil {
+			return err
+		}
+	}
 
 	// Load presentation
-	writer := presentation.NewWriter(".")
 	data, err := writer.LoadPresentation(generatePath)
 	if err != nil {
 		return fmt.Errorf("failed to load presentation: %w", err)
 	}
 
 	fmt.Printf("Loaded: %s (%d slides)\n", data.Metadata.Title, len(data.Slides))
+	if pending := data.PendingImages(generatePath); len(pending) > 0 && !generateImages {
+		fmt.Printf("⚠ %d slides have an image prompt but no image for it; generate them with --images\n", len(pending))
+	}
+
+	data.Metadata.SetVariables(generateSet)
+	if generateTheme != "" {
+		data.Metadata.Theme = generateTheme
+	}
 
 	// Determine output path
 	outputPath := generateOutput
@@ -64,7 +198,18 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 
 	// Generate HTML
 	fmt.Println("\nGenerating reveal.js HTML...")
-	generator := presentation.NewGenerator()
+	generator, err := newDeckGenerator()
+	if err != nil {
+		return err
+	}
+
+	// Pull in shared slides, fill in variables, and number figures
+	data, warnings, err := generator.Prepare(data, generatePath)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
 	if err := generator.GenerateHTML(data, outputPath); err != nil {
 		return fmt.Errorf("failed to generate HTML: %w", err)
 	}
@@ -74,11 +219,77 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("  Title: %s\n", data.Metadata.Title)
 	fmt.Printf("  Theme: %s\n", data.Metadata.Theme)
 	fmt.Printf("  Slides: %d\n", len(data.Slides))
+	if generateOffline {
+		fmt.Printf("  Offline: web app manifest and service worker written next to the HTML\n")
+	}
+
+	if generateOpen {
+		return openInBrowser(outputPath)
+	}
 
 	fmt.Printf("\nNext steps:\n")
-	fmt.Printf("  • Open in browser: open %s\n", outputPath)
+	fmt.Printf("  • Open in browser: %s\n", platform.OpenHint(outputPath))
 	fmt.Printf("  • Or start a local server: python3 -m http.server 8000\n")
-	fmt.Printf("    Then visit: http://localhost:8000/%s\n", outputPath)
+	fmt.Printf("    Then visit: http://localhost:8000/%s\n", filepath.ToSlash(outputPath))
+
+	return nil
+}
+
+// applyGenerateProfile applies the --profile flags and checks the theme the
+// deck is built with
+func applyGenerateProfile(cmd *cobra.Command) error {
+	if generateProfile != "" {
+		if err := applyProfile(cmd, generateProfile); err != nil {
+			return err
+		}
+		fmt.Printf("Profile: %s\n", generateProfile)
+	}
+	if generateTheme != "" && !slices.Contains(presentation.GetRevealJSThemes(), generateTheme) {
+		return fmt.Errorf("unknown theme %q (expected one of: %s)", generateTheme, strings.Join(presentation.GetRevealJSThemes(), ", "))
+	}
+	return nil
+}
+
+// newDeckGenerator returns a generator configured from the generate flags
+func newDeckGenerator() (*presentation.Generator, error) {
+	generator := presentation.NewGenerator()
+	generator.Webcam = generateWebcam
+	generator.Offline = generateOffline
+	generator.CSP = generateCSP
+	generator.Allowlist = generateAllow
+	generator.Footer = generateFooter
+	if generateAspect != "" {
+		var err error
+		if generator.Width, generator.Height, err = presentation.AspectSize(generateAspect); err != nil {
+			return nil, err
+		}
+	}
+	if err := applyTagFilters(generator, generateIncludeTags, generateExcludeTags); err != nil {
+		return nil, err
+	}
+	if generateTemplate != "" {
+		if err := generator.LoadTemplate(resolveTemplate(generateTemplate)); err != nil {
+			return nil, err
+		}
+	}
+	return generator, nil
+}
 
+// openInBrowser opens a generated deck or page in the default browser
+func openInBrowser(target string) error {
+	if err := platform.Open(target); err != nil {
+		return fmt.Errorf("failed to open %s: %w", target, err)
+	}
+	fmt.Printf("\n🌐 Opened %s in the browser\n", target)
 	return nil
 }
+
+// applyTagFilters sets the slide tag filters of a generator from flag values
+func applyTagFilters(generator *presentation.Generator, include, exclude []string) error {
+	var err error
+	if generator.IncludeTags, err = presentation.ParseTags(include...); err != nil {
+		return err
+	}
+	generator.ExcludeTags, err = presentation.ParseTags(exclude...)
+	return err
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	gradePath     string
+	gradeRules    string
+	gradeWorst    int
+	gradeMinGrade string
+)
+
+var gradeCmd = &cobra.Command{
+	Use:   "grade",
+	Short: "Grade a presentation's slides against best practices",
+	Long: `Score the slides of a presentation against common rules of thumb for slide
+design and give the deck a letter grade, listing the slides that lost the
+most points:
+
+  6x6              at most six bullets per slide, of at most six words each
+  one-idea         one idea per slide: at most 40 words and one subheading
+  assertion-title  titles that state the slide's takeaway as a sentence of
+                   at least four words, rather than name a topic
+
+Each content slide starts at 100 and loses points for every rule it breaks;
+the deck's score is the mean over its slides (A: 90+, B: 80+, C: 70+, D: 60+).
+Title, blank, and hidden slides are not graded. Slides shared from other decks
+are graded with their shared content.
+
+The thresholds can be changed, and rules skipped, with a JSON file:
+
+  {"max_bullets": 5, "max_bullet_words": 8, "max_words": 50, "min_title_words": 3, "skip": ["assertion-title"]}
+
+With --min-grade, the command fails when the deck grades lower, so it can
+gate a review or CI job.
+
+Examples:
+  pres grade --path presentations/my-talk.json
+  pres grade --path presentations/my-talk.json --rules grading.json --worst 10
+  pres grade --path presentations/my-talk.json --min-grade B`,
+	Args: cobra.NoArgs,
+	RunE: runGrade,
+}
+
+func init() {
+	rootCmd.AddCommand(gradeCmd)
+
+	gradeCmd.Flags().StringVarP(&gradePath, "path", "p", "", "Path to presentation JSON file (required)")
+	gradeCmd.Flags().StringVar(&gradeRules, "rules", "", "JSON file of grading thresholds (default: built-in rules of thumb)")
+	gradeCmd.Flags().IntVarP(&gradeWorst, "worst", "n", 5, "Number of worst slides to list")
+	gradeCmd.Flags().StringVar(&gradeMinGrade, "min-grade", "", "Fail when the deck grades lower than this letter (A-D)")
+	gradeCmd.MarkFlagRequired("path")
+}
+
+func runGrade(cmd *cobra.Command, args []string) error {
+	minGrade := strings.ToUpper(gradeMinGrade)
+	if minGrade != "" && (len(minGrade) != 1 || !strings.Contains("ABCD", minGrade)) {
+		return fmt.Errorf("invalid --min-grade %q (expected A, B, C, or D)", gradeMinGrade)
+	}
+
+	rules := presentation.DefaultGradeRules()
+	if gradeRules != "" {
+		var err error
+		if rules, err = presentation.LoadGradeRules(gradeRules); err != nil {
+			return err
+		}
+	}
+
+	writer := newWriter()
+	data, err := writer.LoadPresentation(gradePath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	resolved, err := presentation.NewGenerator().ResolveReferences(data, gradePath)
+	if err != nil {
+		return err
+	}
+
+	grade := resolved.ExpandVariables().Grade(rules)
+
+	fmt.Printf("📏 Grading: %s\n\n", data.Metadata.Title)
+	fmt.Printf("Grade: %s (%.0f/100 over %d slides)\n", grade.Letter, grade.Score, len(grade.Slides))
+
+	if worst := grade.Worst(gradeWorst); len(worst) > 0 {
+		fmt.Printf("\nWorst slides:\n")
+		for _, slide := range worst {
+			title := slide.Title
+			if title == "" {
+				title = "(untitled)"
+			}
+			fmt.Printf("  %3d  Slide %d: %s\n", slide.Score, slide.Index+1, title)
+			for _, issue := range slide.Issues {
+				fmt.Printf("         ✗ [%s] %s (-%d)\n", issue.Rule, issue.Message, issue.Penalty)
+			}
+		}
+	} else if len(grade.Slides) > 0 {
+		fmt.Println("\n✓ Every slide follows the rules")
+	}
+
+	if minGrade != "" && grade.Letter > minGrade {
+		return fmt.Errorf("%s grades %s, below the minimum of %s", gradePath, grade.Letter, minGrade)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/geoffjay/pres/internal/imagegen"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	imageModel string
+	imageSize  string
+)
+
+// addImageFlags adds the flags choosing how slide images are generated
+func addImageFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&imageModel, "image-model", "gpt-image-1", "Image model used with --images")
+	cmd.Flags().StringVar(&imageSize, "image-size", "1536x1024", "Size of the images generated with --images")
+}
+
+// generateSlideImages generates the images of the slides of the deck at path
+// whose image prompt has none yet, through the OpenAI Images API (or the
+// compatible service at $OPENAI_BASE_URL). Each image is saved to the deck as
+// it is made, so an interrupted run keeps what it generated. A slide whose
+// image fails is reported and left for the next run.
+func generateSlideImages(ctx context.Context, writer *presentation.Writer, path string) error {
+	// Images are stored by slide ID
+	data, err := writer.EnsureSlideIDs(path)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	pending := data.PendingImages(path)
+	if len(pending) == 0 {
+		fmt.Println("\n🖼 All slide images are up to date")
+		return nil
+	}
+
+	apiKey := os.Getenv("OPENAI_API_KEY")
+	if apiKey == "" {
+		return fmt.Errorf("generating images needs an API key in $OPENAI_API_KEY")
+	}
+	client := imagegen.NewClient(imagegen.Config{
+		BaseURL: os.Getenv("OPENAI_BASE_URL"),
+		APIKey:  apiKey,
+		Model:   imageModel,
+		Size:    imageSize,
+	})
+
+	fmt.Printf("\n🖼 Generating %d slide images with %s...\n", len(pending), imageModel)
+	failed := 0
+	for _, index := range pending {
+		slide := data.Slides[index]
+		_, file := presentation.ImagePath(path, slide.ID, slide.Image_prompt)
+
+		// An image made for the same prompt before is reused
+		var image []byte
+		if _, err := os.Stat(file); err != nil {
+			image, err = client.Generate(ctx, slide.Image_prompt)
+			if err != nil {
+				fmt.Printf("  ✗ Slide %d (%s): %v\n", index+1, slide.Title, err)
+				failed++
+				continue
+			}
+		}
+
+		if _, err := writer.SaveSlideImage(path, slide.ID, slide.Image_prompt, image); err != nil {
+			return fmt.Errorf("failed to save image: %w", err)
+		}
+		if image == nil {
+			fmt.Printf("  ✓ Slide %d (%s): reused the image for its prompt\n", index+1, slide.Title)
+		} else {
+			fmt.Printf("  ✓ Slide %d (%s)\n", index+1, slide.Title)
+		}
+	}
+
+	if failed > 0 {
+		fmt.Printf("⚠ %d of %d images could not be generated; run again with --images to retry\n", failed, len(pending))
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/article"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importFormat string
+	importURL    string
+	importOutput string
+	importForce  bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import an existing deck into the JSON format",
+	Long: `Import a deck written in another format as a new draft presentation, so it
+can be generated, reviewed, and updated with AI like any deck pres created.
+
+Formats:
+  markdown  Marp or reveal-md Markdown: front matter for the metadata
+            (with custom fields under "custom"), "---" between slides,
+            the first heading of a slide as its title (a level one
+            heading or Marp's lead class makes a title slide), "|||"
+            between columns, and speaker notes in HTML comments or
+            after a "Note:" line
+  pptx      PowerPoint: the title placeholder of each slide as its title, the
+            text of its other shapes as content (body text as bullets, tables
+            as Markdown tables), and its notes page as speaker notes; a
+            centered title or subtitle makes a title slide, two body
+            placeholders a two-column slide. Pictures, charts, and diagrams
+            are not imported.
+
+The format is taken from the file extension unless --format is given.
+
+With --url, the main content of a web article or a Google Doc shared with
+anyone who has the link is drafted into a deck instead: headings start
+slides, paragraphs become a bullet of their first sentence with the whole
+paragraph in the speaker notes, and lists, quotes, code, and tables are
+kept. The draft is meant to be refined with pres update.
+
+Examples:
+  pres import talks/my-talk.md
+  pres import talks/my-talk.md --output presentations/my-talk.json
+  pres import legacy/quarterly-review.pptx --output presentations/quarterly-review.json
+  pres import slides.txt --format markdown
+  pres import --url https://go.dev/blog/errors-are-values
+  pres import --url https://docs.google.com/document/d/<id>/edit`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runImport,
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().StringVar(&importFormat, "format", "", "Format of the file: markdown or pptx (default: from the extension)")
+	importCmd.Flags().StringVar(&importURL, "url", "", "Draft a deck from a web article or Google Doc instead of a file")
+	importCmd.Flags().StringVarP(&importOutput, "output", "o", "", "Output path for presentation (default: same name as the file with .json extension, or the title in the presentations directory for --url)")
+	importCmd.Flags().BoolVarP(&importForce, "force", "f", false, "Overwrite the output file if it exists")
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	if importURL != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("give either a file or --url, not both")
+		}
+		return runImportURL()
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("give a file to import, or --url")
+	}
+	input := args[0]
+
+	format := strings.ToLower(importFormat)
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(input)) {
+		case ".md", ".markdown":
+			format = "markdown"
+		case ".pptx":
+			format = "pptx"
+		default:
+			return fmt.Errorf("cannot tell the format of %s; set --format", input)
+		}
+	}
+
+	content, err := os.ReadFile(input)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", input, err)
+	}
+
+	var data *presentation.PresentationData
+	var warnings []string
+	switch format {
+	case "markdown", "md":
+		data, warnings, err = presentation.ParseMarkdown(string(content))
+	case "pptx":
+		data, warnings, err = presentation.ParsePPTX(content)
+	default:
+		return fmt.Errorf("unknown format %q (expected markdown or pptx)", importFormat)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to import %s: %w", input, err)
+	}
+	printWarnings(warnings)
+
+	outputPath := importOutput
+	if outputPath == "" {
+		outputPath = strings.TrimSuffix(input, filepath.Ext(input)) + ".json"
+	}
+	return saveImport(data, outputPath, "Update with AI: pres update --path %s \"your changes\"")
+}
+
+// runImportURL drafts a deck from the article at --url
+func runImportURL() error {
+	page, err := withSpinner(context.Background(), "🌐 Fetching "+importURL+"...", func(ctx context.Context, _ func(string)) (*article.Article, error) {
+		return article.Fetch(ctx, importURL)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch article: %w", err)
+	}
+
+	data, warnings, err := presentation.ParseArticle(page.Title, page.Byline, page.Excerpt, page.Content)
+	if err != nil {
+		return fmt.Errorf("failed to import %s: %w", importURL, err)
+	}
+	printWarnings(warnings)
+
+	outputPath := importOutput
+	if outputPath == "" {
+		outputPath = filepath.Join(presentationsDir(), presentation.Slugify(data.Metadata.Title)+".json")
+	}
+	return saveImport(data, outputPath, "Refine with AI: pres update --path %s \"tighten the bullets and add a summary slide\"")
+}
+
+// saveImport saves an imported deck to outputPath, unless it exists and
+// --force is not set, and prints a summary with the next steps, ending with
+// the given AI step
+func saveImport(data *presentation.PresentationData, outputPath, aiStep string) error {
+	if _, err := os.Stat(outputPath); err == nil && !importForce {
+		return fmt.Errorf("%s already exists (use --force to overwrite)", outputPath)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	savedPath, err := newWriter().SaveData(data, outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	fmt.Printf("✓ Presentation imported successfully!\n")
+	fmt.Printf("  Location: %s\n", savedPath)
+	fmt.Printf("  Title: %s\n", data.Metadata.Title)
+	fmt.Printf("  Slides: %d\n", len(data.Slides))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Generate HTML: pres generate --path %s\n", savedPath)
+	fmt.Printf("  • "+aiStep+"\n", savedPath)
+
+	return nil
+}
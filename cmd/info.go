@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	infoPath       string
+	infoSlides     bool
+	infoProvenance bool
+)
+
+var infoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show presentation metadata",
+	Long: `Show the metadata of a presentation, including any custom fields.
+
+Use --slides to also list each slide with its ID, which is what other decks
+use to reference a slide ("ref": "my-talk.json#<slide-id>").
+
+Use --provenance to list the AI calls that produced and edited the deck, with
+the model, prompt version, and tokens used by each.
+
+Examples:
+  pres info --path presentations/my-talk.json
+  pres info --path presentations/my-talk.json --slides
+  pres info --path presentations/my-talk.json --provenance`,
+	Args: cobra.NoArgs,
+	RunE: runInfo,
+}
+
+func init() {
+	rootCmd.AddCommand(infoCmd)
+
+	infoCmd.Flags().StringVarP(&infoPath, "path", "p", "", "Path to presentation JSON file (required)")
+	infoCmd.Flags().BoolVar(&infoSlides, "slides", false, "List slides with their IDs")
+	infoCmd.Flags().BoolVar(&infoProvenance, "provenance", false, "List the AI calls that produced and edited the deck")
+	infoCmd.MarkFlagRequired("path")
+}
+
+func runInfo(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(infoPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	meta := data.Metadata
+
+	fmt.Printf("📊 %s\n", meta.Title)
+	if meta.Subtitle != "" {
+		fmt.Printf("  Subtitle: %s\n", meta.Subtitle)
+	}
+	fmt.Printf("  Author: %s\n", meta.Author)
+	fmt.Printf("  Date: %s\n", meta.Date)
+	fmt.Printf("  Theme: %s\n", meta.Theme)
+	fmt.Printf("  Status: %s\n", meta.GetStatus())
+	if meta.EventDate != "" {
+		fmt.Printf("  Event date: %s\n", meta.EventDate)
+	}
+	if meta.Venue != "" {
+		fmt.Printf("  Venue: %s\n", meta.Venue)
+	}
+	fmt.Printf("  Slides: %d\n", len(data.Slides))
+	fmt.Printf("  Time budget: %s\n", presentation.FormatClock(data.TotalTimeBudget()))
+	if len(meta.Tags) > 0 {
+		fmt.Printf("  Tags: %s\n", strings.Join(meta.Tags, ", "))
+	}
+	fmt.Printf("  Created: %s\n", meta.Created.Format("2006-01-02 15:04:05"))
+	fmt.Printf("  Modified: %s\n", meta.Modified.Format("2006-01-02 15:04:05"))
+
+	if len(meta.Custom) > 0 {
+		fmt.Printf("\nCustom fields:\n")
+		for _, key := range meta.GetCustomKeys() {
+			fmt.Printf("  %s: %s\n", key, meta.Custom[key])
+		}
+	}
+
+	if infoSlides {
+		fmt.Printf("\nSlides:\n")
+		for i, slide := range data.Slides {
+			title := slide.Title
+			if slide.Ref != "" {
+				title = "→ " + slide.Ref
+			}
+			budget := "-"
+			if len(slide.Tags) > 0 {
+				title += " [" + strings.Join(slide.Tags, ", ") + "]"
+			}
+			if slide.Locked {
+				title += " (locked)"
+			}
+			if slide.Hidden {
+				title += " (hidden)"
+			} else {
+				budget = presentation.FormatClock(slide.GetTimeBudget())
+			}
+			fmt.Printf("  %2d. %-36s %6s  %s\n", i+1, slide.ID, budget, title)
+		}
+	}
+
+	if infoProvenance {
+		printProvenance(meta)
+	}
+
+	return nil
+}
+
+// printProvenance lists the AI calls recorded for the deck, oldest first
+func printProvenance(meta presentation.Metadata) {
+	fmt.Printf("\nProvenance:\n")
+	if len(meta.Provenance) == 0 {
+		fmt.Printf("  No AI calls recorded\n")
+		return
+	}
+
+	for _, record := range meta.Provenance {
+		model := record.Model
+		if model == "" {
+			model = "unknown model"
+		}
+		if record.Client != "" {
+			model += " via " + record.Client
+		}
+		fmt.Printf("  %s  %-28s %s\n", record.Timestamp.Format("2006-01-02 15:04:05"), record.Source, record.Function)
+		fmt.Printf("    Model: %s, prompts v%s, tokens: %d in / %d out\n", model, record.PromptVersion, record.InputTokens, record.OutputTokens)
+	}
+
+	input, output := meta.TotalTokens()
+	fmt.Printf("  Total: %d calls, %d input / %d output tokens\n", len(meta.Provenance), input, output)
+}
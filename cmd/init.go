@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/geoffjay/pres/internal/workspace"
+	"github.com/spf13/cobra"
+)
+
+var (
+	initName string
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init [directory]",
+	Short: "Create a pres project",
+	Long: `Create a pres project in a directory (the current one by default): a
+pres.yaml configuration file and folders for presentations, HTML templates,
+and assets shared by the decks.
+
+Inside a project, pres finds the project root from any subdirectory, the way
+git finds a repository:
+  - Presentations are created in, and listed from, the project's
+    presentations folder rather than ./presentations
+  - A --path that doesn't exist relative to the working directory is looked
+    up relative to the project root
+  - A --template is also looked up in the project's templates folder
+
+The folders can be renamed in pres.yaml.
+
+Examples:
+  pres init
+  pres init talks --name "Conference talks"`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runInit,
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+
+	initCmd.Flags().StringVar(&initName, "name", "", "Name of the project (default: the directory's name)")
+}
+
+func runInit(cmd *cobra.Command, args []string) error {
+	dir := "."
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	ws, err := workspace.Init(dir, initName)
+	if err != nil {
+		return fmt.Errorf("failed to create project: %w", err)
+	}
+
+	fmt.Printf("✓ Created pres project %q in %s\n", ws.Config.Name, ws.Root)
+	fmt.Printf("  %s\n", workspace.ConfigFile)
+	for _, folder := range ws.Folders() {
+		rel, _ := filepath.Rel(ws.Root, folder)
+		fmt.Printf("  %s/\n", rel)
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	if dir != "." {
+		fmt.Printf("  • Enter the project: cd %s\n", dir)
+	}
+	fmt.Printf("  • Create a presentation: pres create \"your presentation topic\"\n")
+	fmt.Printf("  • List its presentations: pres list\n")
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/platform"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	layoutsTheme    string
+	layoutsPath     string
+	layoutsOutput   string
+	layoutsTemplate string
+	layoutsOpen     bool
+)
+
+var layoutsCmd = &cobra.Command{
+	Use:   "layouts",
+	Short: "List the slide layouts",
+	Long: `List the slide layouts a presentation can use. See what each looks like
+with pres layouts preview.
+
+Examples:
+  pres layouts`,
+	Args: cobra.NoArgs,
+	RunE: runLayouts,
+}
+
+var layoutsPreviewCmd = &cobra.Command{
+	Use:   "preview",
+	Short: "Generate a sample deck showing every slide layout",
+	Long: `Generate a reveal.js HTML deck with a sample slide in every layout, to see
+what each layout looks like before choosing one. Each slide's speaker notes
+name its layout.
+
+The deck uses the theme given with --theme, or the theme of the presentation
+given with --path, or the configured theme (see pres config), or black.
+With --template, the samples are shown in your own HTML template, as
+pres generate would build them.
+
+Examples:
+  pres layouts preview
+  pres layouts preview --theme night --open
+  pres layouts preview --path presentations/my-talk.json --output output/layouts.html`,
+	Args: cobra.NoArgs,
+	RunE: runLayoutsPreview,
+}
+
+func init() {
+	rootCmd.AddCommand(layoutsCmd)
+	layoutsCmd.AddCommand(layoutsPreviewCmd)
+
+	layoutsPreviewCmd.Flags().StringVar(&layoutsTheme, "theme", "", "reveal.js theme to show the layouts in")
+	layoutsPreviewCmd.Flags().StringVarP(&layoutsPath, "path", "p", "", "Path to presentation JSON file whose theme to use")
+	layoutsPreviewCmd.Flags().StringVarP(&layoutsOutput, "output", "o", "", "Output path for HTML file (default: layouts.html in the presentations directory)")
+	layoutsPreviewCmd.Flags().StringVar(&layoutsTemplate, "template", "", "HTML document template to build the page from (default: built-in)")
+	layoutsPreviewCmd.Flags().BoolVar(&layoutsOpen, "open", false, "Open the gallery in the default browser")
+}
+
+func runLayouts(cmd *cobra.Command, args []string) error {
+	fmt.Printf("📐 Slide layouts\n\n")
+	for _, layout := range presentation.Layouts() {
+		fmt.Printf("  %-19s %s\n", layout.Name, layout.Description)
+	}
+	fmt.Printf("\nSee them in a theme: pres layouts preview --theme <theme>\n")
+	return nil
+}
+
+func runLayoutsPreview(cmd *cobra.Command, args []string) error {
+	theme := layoutsTheme
+	if theme == "" && layoutsPath != "" {
+		data, err := newWriter().LoadPresentation(layoutsPath)
+		if err != nil {
+			return fmt.Errorf("failed to load presentation: %w", err)
+		}
+		theme = data.Metadata.Theme
+	}
+	if theme == "" {
+		theme = settings.GetString("theme")
+	}
+	if theme == "" {
+		theme = "black"
+	}
+	if !slices.Contains(presentation.GetRevealJSThemes(), theme) {
+		return fmt.Errorf("unknown theme %q (expected one of: %s)", theme, strings.Join(presentation.GetRevealJSThemes(), ", "))
+	}
+
+	outputPath := layoutsOutput
+	if outputPath == "" {
+		outputPath = filepath.Join(presentationsDir(), "layouts.html")
+	}
+
+	generator := presentation.NewGenerator()
+	if layoutsTemplate != "" {
+		if err := generator.LoadTemplate(resolveTemplate(layoutsTemplate)); err != nil {
+			return err
+		}
+	}
+	data := presentation.LayoutGallery(theme)
+	if err := generator.GenerateHTML(data, outputPath); err != nil {
+		return fmt.Errorf("failed to generate HTML: %w", err)
+	}
+
+	fmt.Printf("📐 Layout gallery generated in the %s theme\n\n", theme)
+	for i, slide := range data.Slides[1:] {
+		fmt.Printf("  Slide %d: %s\n", i+2, slide.Layout)
+	}
+	fmt.Printf("\n✓ Location: %s\n", outputPath)
+	if layoutsOpen {
+		return openInBrowser(outputPath)
+	}
+	fmt.Printf("  Open in browser: %s\n", platform.OpenHint(outputPath))
+	return nil
+}
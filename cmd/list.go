@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"fmt"
+	"image/png"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/geoffjay/pres/internal/export"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/geoffjay/pres/internal/text"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listDir     string
+	listPreview bool
+	listSort    string
+	listTags    []string
+	listCustom  []string
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the presentations in a directory",
+	Long: `List the presentations found by scanning the presentations directory as a
+table of their title, author, slide count, last modification date, and tags.
+
+The most recently modified presentations come first; --sort orders them by
+created, title, author, slides, or path instead. --tag lists only the
+presentations with one of the given tags. --custom adds a column for a
+custom metadata field (see pres meta set --custom).
+
+With --preview, a thumbnail of each deck's title slide is shown inline in
+terminals that support images: iTerm2, WezTerm, and VS Code through the
+iTerm2 protocol, and foot and mlterm through sixels. Set PRES_IMAGE_PROTOCOL
+to iterm or sixel for other terminals that support either. Thumbnails are
+cached next to each deck in .pres/ and rendered again when the deck changes.
+
+Examples:
+  pres list
+  pres list --dir talks
+  pres list --sort title
+  pres list --tag conference --tag internal
+  pres list --custom team --custom event
+  pres list --preview`,
+	Args: cobra.NoArgs,
+	RunE: runList,
+}
+
+func init() {
+	rootCmd.AddCommand(listCmd)
+
+	listCmd.Flags().StringVarP(&listDir, "dir", "d", "", "Directory to scan for presentations (default: the project's presentations directory)")
+	listCmd.Flags().BoolVar(&listPreview, "preview", false, "Show a thumbnail of each deck's title slide")
+	listCmd.Flags().StringVarP(&listSort, "sort", "s", "modified", "Order to list in: "+strings.Join(presentation.CatalogSorts, ", "))
+	listCmd.Flags().StringSliceVarP(&listTags, "tag", "t", nil, "Only list presentations with this tag (can be repeated)")
+	listCmd.Flags().StringSliceVar(&listCustom, "custom", nil, "Add a column for this custom metadata field (can be repeated)")
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	if listDir == "" {
+		listDir = presentationsDir()
+	}
+	writer := newWriter()
+	entries, err := writer.ScanPresentations(listDir)
+	if err != nil {
+		return fmt.Errorf("failed to scan presentations: %w", err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No presentations found in %s\n", listDir)
+		return nil
+	}
+	if err := presentation.SortCatalog(entries, listSort); err != nil {
+		return err
+	}
+	if entries = presentation.FilterCatalog(entries, listTags); len(entries) == 0 {
+		fmt.Printf("No presentations in %s are tagged %s\n", listDir, strings.Join(listTags, " or "))
+		return nil
+	}
+
+	if listPreview {
+		return printPreviews(entries)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := "TITLE\tAUTHOR\tSLIDES\tMODIFIED\tTAGS\t"
+	for _, key := range listCustom {
+		header += strings.ToUpper(key) + "\t"
+	}
+	fmt.Fprintln(tw, header+"PATH")
+	for _, entry := range entries {
+		meta := entry.Data.Metadata
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t",
+			text.Truncate(meta.Title, 40),
+			orDash(meta.Author),
+			len(entry.Data.Slides),
+			meta.Modified.Format("2006-01-02"),
+			orDash(strings.Join(meta.Tags, ", ")),
+		)
+		for _, key := range listCustom {
+			fmt.Fprintf(tw, "%s\t", orDash(text.Truncate(meta.Custom[key], 30)))
+		}
+		fmt.Fprintln(tw, entry.Path)
+	}
+	return tw.Flush()
+}
+
+// printPreviews lists each deck below a thumbnail of its title slide
+func printPreviews(entries []presentation.CatalogEntry) error {
+	protocol := render.DetectImageProtocol()
+	if protocol == render.ImageProtocolNone {
+		fmt.Fprintf(os.Stderr, "⚠️  This terminal does not support inline images; set PRES_IMAGE_PROTOCOL to iterm or sixel if it does\n\n")
+	}
+
+	for _, entry := range entries {
+		meta := entry.Data.Metadata
+		if protocol != render.ImageProtocolNone {
+			if err := printThumbnail(entry.Path, entry.Data, protocol); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  %s: %v\n", entry.Path, err)
+			}
+		}
+		fmt.Printf("%s\n", meta.Title)
+		fmt.Printf("  %s · %d slides · %s · modified %s\n", entry.Path, len(entry.Data.Slides), meta.GetStatus(), meta.Modified.Format("2006-01-02"))
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// orDash stands in for an empty table cell
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// printThumbnail shows the catalog thumbnail of a deck inline
+func printThumbnail(path string, data *presentation.PresentationData, protocol render.ImageProtocol) error {
+	thumb, err := export.Thumbnail(path, data)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(thumb)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		return fmt.Errorf("invalid thumbnail: %w", err)
+	}
+
+	seq, err := render.InlineImage(img, protocol)
+	if err != nil {
+		return err
+	}
+	fmt.Print(seq)
+	return nil
+}
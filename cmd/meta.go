@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	metaMatch     []string
+	metaAuthor    string
+	metaSubtitle  string
+	metaDate      string
+	metaTheme     string
+	metaEventDate string
+	metaVenue     string
+	metaTags      []string
+	metaUntags    []string
+	metaCustom    map[string]string
+	metaDryRun    bool
+)
+
+var metaCmd = &cobra.Command{
+	Use:   "meta",
+	Short: "Edit presentation metadata across the library",
+	Long: `Edit the metadata of many presentations at once.
+
+Decks are selected with --match glob patterns, quoted so that pres expands
+them rather than the shell. Each deck is updated through the same
+update_metadata operation as pres update, so changes are recorded in the
+deck's audit log. Decks that already have the requested values are left
+untouched.
+
+Examples:
+  pres meta set --match "presentations/q3/*.json" --tag q3-2025 --author "Platform Team"
+  pres meta set --match "presentations/*.json" --untag draft --theme white
+  pres meta set --match "presentations/q3/*.json" --custom team=platform --dry-run`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var metaSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set metadata on every presentation matching a pattern",
+	Args:  cobra.NoArgs,
+	RunE:  runMetaSet,
+}
+
+func init() {
+	rootCmd.AddCommand(metaCmd)
+	metaCmd.AddCommand(metaSetCmd)
+
+	metaSetCmd.Flags().StringSliceVarP(&metaMatch, "match", "m", nil, "Glob pattern selecting presentation JSON files (required, can be repeated)")
+	metaSetCmd.Flags().StringVar(&metaAuthor, "author", "", "Set the author")
+	metaSetCmd.Flags().StringVar(&metaSubtitle, "subtitle", "", "Set the subtitle")
+	metaSetCmd.Flags().StringVar(&metaDate, "date", "", "Set the date")
+	metaSetCmd.Flags().StringVar(&metaTheme, "theme", "", "Set the reveal.js theme")
+	metaSetCmd.Flags().StringVar(&metaEventDate, "event-date", "", "Set the event date (YYYY-MM-DD)")
+	metaSetCmd.Flags().StringVar(&metaVenue, "venue", "", "Set the venue")
+	metaSetCmd.Flags().StringSliceVar(&metaTags, "tag", nil, "Add a tag (can be repeated)")
+	metaSetCmd.Flags().StringSliceVar(&metaUntags, "untag", nil, "Remove a tag (can be repeated)")
+	metaSetCmd.Flags().StringToStringVar(&metaCustom, "custom", nil, "Set a custom field, e.g. team=platform (an empty value removes it)")
+	metaSetCmd.Flags().BoolVar(&metaDryRun, "dry-run", false, "Show the changes without saving them")
+	metaSetCmd.MarkFlagRequired("match")
+}
+
+// metadataEdit builds the edit requested by the set flags. Only flags given
+// on the command line are applied, so a field can be cleared with "".
+func metadataEdit(cmd *cobra.Command) (presentation.MetadataEdit, error) {
+	edit := presentation.MetadataEdit{Fields: make(map[string]string)}
+
+	for flag, key := range map[string]string{
+		"author":     "author",
+		"subtitle":   "subtitle",
+		"date":       "date",
+		"theme":      "theme",
+		"event-date": "event_date",
+		"venue":      "venue",
+	} {
+		if cmd.Flags().Changed(flag) {
+			value, _ := cmd.Flags().GetString(flag)
+			edit.Fields[key] = strings.TrimSpace(value)
+		}
+	}
+	for key, value := range metaCustom {
+		edit.Fields["custom."+key] = strings.TrimSpace(value)
+	}
+
+	if theme, ok := edit.Fields["theme"]; ok && !slices.Contains(presentation.GetRevealJSThemes(), theme) {
+		return edit, fmt.Errorf("unknown theme %q (expected one of: %s)", theme, strings.Join(presentation.GetRevealJSThemes(), ", "))
+	}
+	if date := edit.Fields["event_date"]; date != "" {
+		if _, err := time.Parse(presentation.EventDateFormat, date); err != nil {
+			return edit, fmt.Errorf("invalid event date %q (expected YYYY-MM-DD)", date)
+		}
+	}
+
+	for _, tag := range metaTags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			edit.AddTags = append(edit.AddTags, tag)
+		}
+	}
+	for _, tag := range metaUntags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			edit.RemoveTags = append(edit.RemoveTags, tag)
+		}
+	}
+
+	if edit.IsEmpty() {
+		return edit, fmt.Errorf("nothing to set; give at least one of --author, --subtitle, --date, --theme, --event-date, --venue, --tag, --untag, or --custom")
+	}
+	return edit, edit.Validate()
+}
+
+func runMetaSet(cmd *cobra.Command, args []string) error {
+	edit, err := metadataEdit(cmd)
+	if err != nil {
+		return err
+	}
+
+	paths, err := presentation.MatchPresentations(metaMatch)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no files match %s", strings.Join(metaMatch, ", "))
+	}
+
+	writer := newWriter()
+	writer.SetAudit("pres meta set", auditActor())
+
+	if metaDryRun {
+		fmt.Printf("🔍 Dry run: no files will be changed\n\n")
+	}
+
+	updated, unchanged, failed := 0, 0, 0
+	for _, path := range paths {
+		data, err := writer.LoadPresentation(path)
+		if err != nil {
+			fmt.Printf("✗ %s: %v\n", path, err)
+			failed++
+			continue
+		}
+
+		changes := edit.Changes(data.Metadata)
+		if len(changes) == 0 {
+			unchanged++
+			continue
+		}
+
+		if !metaDryRun {
+			update := types.PresentationUpdate{
+				Operation:        "update_metadata",
+				Metadata_updates: changes,
+				Rationale:        "Bulk metadata edit",
+			}
+			if _, err := writer.UpdatePresentation(path, []types.PresentationUpdate{update}, false); err != nil {
+				fmt.Printf("✗ %s: %v\n", path, err)
+				failed++
+				continue
+			}
+		}
+
+		fmt.Printf("✓ %s\n", path)
+		keys := make([]string, 0, len(changes))
+		for key := range changes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("    %s: %q → %q\n", key, presentation.MetadataValue(data.Metadata, key), changes[key])
+		}
+		updated++
+	}
+
+	verb := "Updated"
+	if metaDryRun {
+		verb = "Would update"
+	}
+	fmt.Printf("\n%s %d of %d presentations (%d already up to date)\n", verb, updated, len(paths), unchanged)
+
+	if failed > 0 {
+		return fmt.Errorf("%d presentations could not be updated", failed)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	mvDir string
+)
+
+var mvCmd = &cobra.Command{
+	Use:   "mv [source] [destination]",
+	Short: "Move or rename a presentation without breaking references",
+	Long: `Move or rename a presentation JSON file, keeping everything that refers to
+it consistent. A plain mv leaves these behind:
+
+  - The deck's audit log, questions, feedback, catalog thumbnail, and revision
+    history in .pres/
+  - Its media in assets/<name>/, such as recorded narration
+  - Paths in the deck relative to its directory: narration, images, data
+    sources, and references to slides in other decks
+  - References to its slides from other decks
+
+All of these move with the deck or are rewritten. Decks referring to it are
+found by scanning --dir. When the destination is a directory, the deck keeps
+its file name.
+
+Examples:
+  pres mv presentations/my-talk.json presentations/kubecon-2025.json
+  pres mv presentations/my-talk.json presentations/archive/
+  pres mv talks/intro.json talks/2025/intro.json --dir talks`,
+	Args: cobra.ExactArgs(2),
+	RunE: runMv,
+}
+
+func init() {
+	rootCmd.AddCommand(mvCmd)
+
+	mvCmd.Flags().StringVarP(&mvDir, "dir", "d", "", "Directory to scan for decks referring to the moved deck (default: the project's presentations directory)")
+}
+
+func runMv(cmd *cobra.Command, args []string) error {
+	if mvDir == "" {
+		mvDir = presentationsDir()
+	}
+	source, destination := args[0], args[1]
+	if project != nil {
+		source = project.Resolve(source)
+	}
+
+	root := mvDir
+	if _, err := os.Stat(root); err != nil {
+		fmt.Printf("⚠ %s does not exist; references from other decks will not be updated\n", root)
+		root = ""
+	}
+
+	writer := newWriter()
+	result, err := writer.MovePresentation(source, destination, root)
+	if err != nil {
+		if result != nil {
+			fmt.Printf("⚠ %s was moved to %s, but not everything was updated\n", source, result.Path)
+		}
+		return fmt.Errorf("failed to move presentation: %w", err)
+	}
+
+	fmt.Printf("✓ Moved %s → %s\n", source, result.Path)
+	for _, path := range result.Moved {
+		fmt.Printf("✓ Moved %s\n", path)
+	}
+	for _, path := range result.Rewritten {
+		fmt.Printf("✓ Updated references in %s\n", path)
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	if html := strings.TrimSuffix(source, filepath.Ext(source)) + ".html"; fileExists(html) {
+		fmt.Printf("  • Remove the HTML generated at the old path: %s\n", html)
+	}
+	fmt.Printf("  • Regenerate HTML: pres generate --path %s\n", result.Path)
+	for _, path := range result.Rewritten {
+		fmt.Printf("  • Regenerate HTML: pres generate --path %s\n", path)
+	}
+
+	return nil
+}
+
+// fileExists reports whether a file exists at path
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	notesPath      string
+	notesSlides    string
+	notesOverwrite bool
+	notesResume    bool
+	notesRetry     bool
+	notesRestart   bool
+)
+
+var notesCmd = &cobra.Command{
+	Use:   "notes",
+	Short: "Write speaker notes for each slide with AI",
+	Long: `Write the speaker notes of a presentation with AI, one slide at a time,
+with the rest of the deck as context.
+
+Slides that already have notes are left alone unless --overwrite is given.
+Locked slides and slides shared from other decks are skipped.
+
+Each slide is saved as soon as its notes are written, and the progress of the
+pass is kept in the deck's .pres directory, so an interrupted pass can be
+continued with --resume or discarded with --restart. A slide whose notes
+can't be written is left unchanged and marked as failed, and the pass moves
+on; --retry-failed tries the failed slides again.
+
+Examples:
+  pres notes --path presentations/my-talk.json
+  pres notes --path presentations/my-talk.json --slides 3-8 --overwrite
+  pres notes --path presentations/my-talk.json --resume
+  pres notes --path presentations/my-talk.json --retry-failed`,
+	Args: cobra.NoArgs,
+	RunE: runNotes,
+}
+
+func init() {
+	rootCmd.AddCommand(notesCmd)
+
+	notesCmd.Flags().StringVarP(&notesPath, "path", "p", "", "Path to presentation JSON file (required)")
+	notesCmd.Flags().StringVar(&notesSlides, "slides", "", "Slides to write notes for, e.g. 1-5 or 1,3,5-7 (default: every slide)")
+	notesCmd.Flags().BoolVar(&notesOverwrite, "overwrite", false, "Rewrite notes that slides already have")
+	notesCmd.Flags().BoolVar(&notesResume, "resume", false, "Continue an interrupted pass")
+	notesCmd.Flags().BoolVar(&notesRetry, "retry-failed", false, "Continue an interrupted pass and retry the slides it failed on")
+	notesCmd.Flags().BoolVar(&notesRestart, "restart", false, "Discard an interrupted pass and start over")
+	notesCmd.MarkFlagRequired("path")
+	notesCmd.MarkFlagsMutuallyExclusive("resume", "retry-failed", "restart")
+}
+
+func runNotes(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	writer := newWriter()
+	writer.SetAudit("pres notes", auditActor())
+
+	data, err := writer.LoadPresentation(notesPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	state, err := presentation.LoadPassState(notesPath, "notes")
+	if err != nil {
+		return err
+	}
+	if state != nil && notesRestart {
+		if err := state.Remove(notesPath); err != nil {
+			return err
+		}
+		state = nil
+	}
+
+	slides, err := notesSelection(data)
+	if err != nil {
+		return err
+	}
+	if state == nil && len(slides) == 0 && !notesResume && !notesRetry {
+		fmt.Println("✓ Every selected slide already has notes (use --overwrite to rewrite them)")
+		return nil
+	}
+
+	session, err := newAISession("pres notes", "", "")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("📝 Writing speaker notes: %s\n\n", data.Metadata.Title)
+
+	pass := slidePass{
+		name:        "notes",
+		path:        notesPath,
+		slides:      slides,
+		resume:      notesResume,
+		retryFailed: notesRetry,
+		step: func(ctx context.Context, data *presentation.PresentationData, index int) error {
+			deck := data.BuildContext([]int{index}, 0, presentation.DefaultContextBudget)
+			notes, err := writeSpeakerNotes(ctx, deck.Text, int64(index), session.option())
+			if err != nil {
+				return err
+			}
+			writer.AddProvenance(session.collect()...)
+			_, err = writer.SetSlideNotes(notesPath, data.Slides[index].ID, notes)
+			return err
+		},
+	}
+	if err := pass.run(ctx); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✓ Speaker notes written\n")
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Print cue cards: pres export cue-cards --path %s\n", notesPath)
+	fmt.Printf("  • Generate the deck: pres generate --path %s\n", notesPath)
+
+	return nil
+}
+
+// notesSelection returns the indexes of the slides to write notes for:
+// those chosen with --slides, or every slide, less the ones that can't be
+// changed and, without --overwrite, those that already have notes
+func notesSelection(data *presentation.PresentationData) ([]int, error) {
+	var candidates []int
+	if notesSlides != "" {
+		indexes, err := parseSlideRange(notesSlides)
+		if err != nil {
+			return nil, err
+		}
+		candidates = indexes
+	} else {
+		for i := range data.Slides {
+			candidates = append(candidates, i)
+		}
+	}
+
+	var slides []int
+	for _, index := range candidates {
+		if index >= len(data.Slides) {
+			return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+		slide := data.Slides[index]
+		if slide.Locked || slide.Ref != "" || (slide.Notes != "" && !notesOverwrite) {
+			continue
+		}
+		slides = append(slides, index)
+	}
+	return slides, nil
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// progressWidth is the width of the progress bar in characters
+const progressWidth = 24
+
+// slidePass is an AI pass that works through a deck one slide at a time,
+// such as writing speaker notes. Its progress is saved after every slide, so
+// an interrupted pass can be resumed. A slide that fails is left unchanged
+// and marked as failed in the progress, and the pass moves on to the next, so
+// the failures can be retried on their own.
+type slidePass struct {
+	// name identifies the pass in its progress file, e.g. "notes"
+	name string
+	path string
+	// slides are the indexes (0-based) to process when starting afresh
+	slides []int
+	resume bool
+	// retryFailed resumes the pass, retrying the slides it failed on
+	retryFailed bool
+	// step processes the slide at index of the deck as currently saved,
+	// saving the result itself
+	step func(ctx context.Context, data *presentation.PresentationData, index int) error
+}
+
+// run works through the slides of the pass, or the ones left by an earlier
+// run when resuming, showing a progress bar with an ETA. It fails when any
+// slide failed.
+func (p slidePass) run(ctx context.Context) error {
+	writer := newWriter()
+
+	state, err := presentation.LoadPassState(p.path, p.name)
+	if err != nil {
+		return err
+	}
+	resume := p.resume || p.retryFailed
+	switch {
+	case state != nil && !resume:
+		return fmt.Errorf("an unfinished %s pass over this deck has %d of %d slides done and %d failed; use --resume to continue it, --retry-failed to also retry the failures, or --restart to start over",
+			p.name, len(state.Done), len(state.Slides), len(state.Failed))
+	case state == nil && resume:
+		return fmt.Errorf("there is no unfinished %s pass over this deck to resume", p.name)
+	case state == nil:
+		if _, state, err = writer.StartPass(p.path, p.name, p.slides); err != nil {
+			return err
+		}
+	default:
+		if p.retryFailed {
+			fmt.Printf("↻ Retrying %d failed slide(s)\n", len(state.Failed))
+			state.RetryFailed()
+		}
+		fmt.Printf("↻ Resuming: %d of %d slides already done\n", len(state.Done), len(state.Slides))
+	}
+
+	remaining := state.Remaining()
+	progress := newPassProgress(len(state.Slides), len(state.Slides)-len(remaining))
+	for _, id := range remaining {
+		data, err := writer.LoadPresentation(p.path)
+		if err != nil {
+			return fmt.Errorf("failed to load presentation: %w", err)
+		}
+
+		index := data.GetSlideIndex(id)
+		if index < 0 {
+			// Deleted since the pass started
+			progress.finish("–", "slide "+id, "removed from the deck")
+		} else {
+			label := slideLabel(data, index)
+			progress.start(label)
+			if err := p.step(ctx, data, index); err != nil {
+				progress.finish("✗", label, err.Error())
+				if ctx.Err() != nil {
+					return fmt.Errorf("%s pass interrupted at slide %d: %w (run again with --resume to continue)", p.name, index+1, err)
+				}
+				state.MarkFailed(id, err)
+				if err := state.Save(p.path); err != nil {
+					return err
+				}
+				continue
+			}
+			progress.finish("✓", label, "")
+		}
+
+		state.MarkDone(id)
+		if err := state.Save(p.path); err != nil {
+			return err
+		}
+	}
+	progress.clear()
+
+	if len(state.Failed) > 0 {
+		return fmt.Errorf("%d of %d slides failed and were left unchanged; run again with --retry-failed to retry them",
+			len(state.Failed), len(state.Slides))
+	}
+	return state.Remove(p.path)
+}
+
+// slideLabel names a slide in progress output
+func slideLabel(data *presentation.PresentationData, index int) string {
+	if title := data.Slides[index].Title; title != "" {
+		return fmt.Sprintf("Slide %d: %s", index+1, title)
+	}
+	return fmt.Sprintf("Slide %d", index+1)
+}
+
+// passProgress shows the status of each slide of a pass and, on a terminal,
+// a progress bar with an estimate of the time left
+type passProgress struct {
+	total   int
+	done    int
+	tty     bool
+	began   time.Time
+	current time.Time
+	// worked counts the slides processed in this run, which the ETA is
+	// estimated from
+	worked int
+}
+
+func newPassProgress(total, done int) *passProgress {
+	info, err := os.Stdout.Stat()
+	return &passProgress{
+		total: total,
+		done:  done,
+		tty:   err == nil && info.Mode()&os.ModeCharDevice != 0,
+		began: time.Now(),
+	}
+}
+
+// start shows that the slide is being worked on
+func (p *passProgress) start(label string) {
+	p.current = time.Now()
+	if p.tty {
+		p.clear()
+		fmt.Printf("%s %s", p.bar(), label)
+	}
+}
+
+// finish reports the outcome of a slide and advances the bar
+func (p *passProgress) finish(mark, label, detail string) {
+	p.done++
+	line := fmt.Sprintf("  %s %s", mark, label)
+	if !p.current.IsZero() {
+		p.worked++
+		line += fmt.Sprintf(" (%s)", time.Since(p.current).Round(100*time.Millisecond))
+		p.current = time.Time{}
+	}
+	if detail != "" {
+		line += ": " + detail
+	}
+
+	p.clear()
+	fmt.Println(line)
+	if p.tty && p.done < p.total {
+		fmt.Print(p.bar())
+	}
+}
+
+// clear removes the progress bar from the terminal
+func (p *passProgress) clear() {
+	if p.tty {
+		fmt.Print("\r\033[K")
+	}
+}
+
+// bar renders the progress bar, the count of finished slides, and the ETA
+func (p *passProgress) bar() string {
+	filled := 0
+	if p.total > 0 {
+		filled = p.done * progressWidth / p.total
+	}
+	bar := fmt.Sprintf("[%s%s] %d/%d", strings.Repeat("█", filled), strings.Repeat("░", progressWidth-filled), p.done, p.total)
+	if p.worked > 0 {
+		perSlide := time.Since(p.began) / time.Duration(p.worked)
+		eta := perSlide * time.Duration(p.total-p.done)
+		bar += fmt.Sprintf(" · ETA %s", eta.Round(time.Second))
+	}
+	return bar + " · "
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	presentPath        string
+	presentStart       int
+	presentNotes       bool
+	presentSet         map[string]string
+	presentIncludeTags []string
+	presentExcludeTags []string
+)
+
+var presentCmd = &cobra.Command{
+	Use:   "present",
+	Short: "Present a deck in the terminal",
+	Long: `Present a deck full screen in the terminal, without opening a browser.
+
+Keys:
+  →, l, space, enter   next slide
+  ←, h, backspace      previous slide
+  g, G                 first and last slide
+  <number> enter       go to a slide
+  n                    show or hide the speaker notes
+  r                    restart the clock
+  q, esc               quit
+
+The status line shows the slide counter and the time since the start of the
+talk against the deck's time budget, in red when the talk is behind. Hidden
+slides follow the main flow as backup slides. The deck is prepared as for
+pres generate: references are resolved, variables expanded, and slides
+filtered by their when conditions and tags.
+
+Examples:
+  pres present --path presentations/my-talk.json
+  pres present --path presentations/my-talk.json --notes --start 5
+  pres present --path presentations/my-talk.json --exclude-tags internal`,
+	Args: cobra.NoArgs,
+	RunE: runPresent,
+}
+
+func init() {
+	rootCmd.AddCommand(presentCmd)
+
+	presentCmd.Flags().StringVarP(&presentPath, "path", "p", "", "Path to presentation JSON file (required)")
+	presentCmd.Flags().IntVar(&presentStart, "start", 1, "Slide to start at")
+	presentCmd.Flags().BoolVar(&presentNotes, "notes", false, "Show the speaker notes from the start")
+	presentCmd.Flags().StringToStringVar(&presentSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	presentCmd.Flags().StringSliceVar(&presentIncludeTags, "include-tags", nil, "Only include tagged slides with one of these tags")
+	presentCmd.Flags().StringSliceVar(&presentExcludeTags, "exclude-tags", nil, "Leave out slides with any of these tags")
+	presentCmd.MarkFlagRequired("path")
+}
+
+func runPresent(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(presentPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	data.Metadata.SetVariables(presentSet)
+
+	generator := presentation.NewGenerator()
+	if err := applyTagFilters(generator, presentIncludeTags, presentExcludeTags); err != nil {
+		return err
+	}
+	data, warnings, err := generator.Prepare(data, presentPath)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	if len(data.Slides) == 0 {
+		return fmt.Errorf("%s has no slides to present", presentPath)
+	}
+	if presentStart < 1 || presentStart > len(data.Slides) {
+		return fmt.Errorf("slide %d does not exist (presentation has %d slides)", presentStart, len(data.Slides))
+	}
+
+	presenter := render.NewPresenter(data, presentStart-1, presentNotes)
+	if _, err := tea.NewProgram(presenter, tea.WithAltScreen()).Run(); err != nil {
+		return fmt.Errorf("error running presenter: %w", err)
+	}
+	return nil
+}
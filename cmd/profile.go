@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+// outputProfile is a named set of generate flags, configured under profiles
+// in the config file or the project's pres.yaml
+type outputProfile struct {
+	Theme       string            `mapstructure:"theme"`
+	Aspect      string            `mapstructure:"aspect"`
+	Footer      string            `mapstructure:"footer"`
+	Template    string            `mapstructure:"template"`
+	Webcam      bool              `mapstructure:"webcam"`
+	Offline     bool              `mapstructure:"offline"`
+	CSP         bool              `mapstructure:"csp"`
+	Allow       []string          `mapstructure:"allow"`
+	IncludeTags []string          `mapstructure:"include_tags"`
+	ExcludeTags []string          `mapstructure:"exclude_tags"`
+	Set         map[string]string `mapstructure:"set"`
+}
+
+// profileNames returns the names of the configured output profiles
+func profileNames() []string {
+	return slices.Sorted(maps.Keys(settings.GetStringMap("profiles")))
+}
+
+// loadProfile returns the configured output profile with the given name
+func loadProfile(name string) (outputProfile, error) {
+	var profile outputProfile
+	key := "profiles." + strings.ToLower(name)
+	if !settings.IsSet(key) {
+		names := profileNames()
+		if len(names) == 0 {
+			return profile, fmt.Errorf("unknown profile %q: no profiles are configured (see pres generate --help)", name)
+		}
+		return profile, fmt.Errorf("unknown profile %q (one of %s)", name, strings.Join(names, ", "))
+	}
+	if err := settings.UnmarshalKey(key, &profile); err != nil {
+		return profile, fmt.Errorf("invalid profile %q: %w", name, err)
+	}
+
+	if profile.Theme != "" && !slices.Contains(presentation.GetRevealJSThemes(), profile.Theme) {
+		return profile, fmt.Errorf("invalid profile %q: unknown theme %q (see pres themes)", name, profile.Theme)
+	}
+	if profile.Aspect != "" {
+		if _, _, err := presentation.AspectSize(profile.Aspect); err != nil {
+			return profile, fmt.Errorf("invalid profile %q: %w", name, err)
+		}
+	}
+	return profile, nil
+}
+
+// applyProfile sets the generate flags the command line left unset from the
+// named output profile. Variables given with --set are added to the
+// profile's, replacing those with the same name.
+func applyProfile(cmd *cobra.Command, name string) error {
+	profile, err := loadProfile(name)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if !flags.Changed("theme") {
+		generateTheme = profile.Theme
+	}
+	if !flags.Changed("aspect") {
+		generateAspect = profile.Aspect
+	}
+	if !flags.Changed("footer") {
+		generateFooter = profile.Footer
+	}
+	if !flags.Changed("template") {
+		generateTemplate = profile.Template
+	}
+	if !flags.Changed("webcam") {
+		generateWebcam = profile.Webcam
+	}
+	if !flags.Changed("offline") {
+		generateOffline = profile.Offline
+	}
+	if !flags.Changed("csp") {
+		generateCSP = profile.CSP
+	}
+	if !flags.Changed("allow") {
+		generateAllow = profile.Allow
+	}
+	if !flags.Changed("include-tags") {
+		generateIncludeTags = profile.IncludeTags
+	}
+	if !flags.Changed("exclude-tags") {
+		generateExcludeTags = profile.ExcludeTags
+	}
+
+	set := maps.Clone(profile.Set)
+	if set == nil {
+		set = map[string]string{}
+	}
+	maps.Copy(set, generateSet)
+	generateSet = set
+	return nil
+}
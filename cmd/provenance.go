@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	baml "github.com/boundaryml/baml/engine/language_client_go/pkg"
+	"github.com/geoffjay/pres/baml_client"
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// promptVersion identifies the prompts in baml_src in the provenance of the
+// decks pres produces. Bump it whenever a prompt changes.
+const promptVersion = "1"
+
+// aiSession tracks the BAML calls of a command, so they can be recorded in
+// the deck's provenance and, with --save-transcript, written to a transcript
+type aiSession struct {
+	source         string
+	collector      baml_client.Collector
+	transcript     *presentation.Transcript
+	transcriptPath string
+}
+
+// newAISession starts tracking the BAML calls of the source command. When
+// transcriptPath is set, the prompts and responses are kept for the
+// transcript saved by saveTranscript.
+func newAISession(source, request, transcriptPath string) (*aiSession, error) {
+	collector, err := baml_client.NewCollector(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AI call collector: %w", err)
+	}
+
+	session := &aiSession{source: source, collector: collector, transcriptPath: transcriptPath}
+	if transcriptPath != "" {
+		session.transcript = &presentation.Transcript{
+			Command: source,
+			Request: request,
+			Started: time.Now(),
+		}
+	}
+	return session, nil
+}
+
+// option returns the call option that tracks a BAML call in the session
+func (s *aiSession) option() baml_client.CallOptionFunc {
+	return baml_client.WithCollector(s.collector)
+}
+
+// collect returns a provenance record for each BAML call made since the last
+// collect, adding the calls to the transcript
+func (s *aiSession) collect() []presentation.Provenance {
+	logs, err := s.collector.Logs()
+	if err != nil {
+		return nil
+	}
+
+	now := time.Now()
+	records := make([]presentation.Provenance, 0, len(logs))
+	for _, log := range logs {
+		record := presentation.Provenance{
+			Timestamp:     now,
+			Source:        s.source,
+			PromptVersion: promptVersion,
+		}
+		record.Function, _ = log.FunctionName()
+		if usage, err := log.Usage(); err == nil && usage != nil {
+			record.InputTokens, _ = usage.InputTokens()
+			record.OutputTokens, _ = usage.OutputTokens()
+		}
+		call, err := log.SelectedCall()
+		if err == nil && call != nil {
+			record.Client, _ = call.ClientName()
+			record.Model = requestModel(call)
+		}
+		records = append(records, record)
+
+		if s.transcript != nil {
+			entry := presentation.TranscriptCall{Provenance: record}
+			if call != nil {
+				entry.Prompt = requestBody(call)
+			}
+			entry.Response, _ = log.RawLLMResponse()
+			s.transcript.Calls = append(s.transcript.Calls, entry)
+		}
+	}
+
+	s.collector.Clear()
+	return records
+}
+
+// saveTranscript writes the session transcript, if one was requested, along
+// with the deck it produced and the Q&A answers
+func (s *aiSession) saveTranscript(deck string, answers []string) error {
+	if s.transcript == nil {
+		return nil
+	}
+
+	// Calls that were not collected for a save, e.g. when the session was
+	// cancelled, still belong in the transcript
+	s.collect()
+	s.transcript.Deck = deck
+	s.transcript.Answers = answers
+
+	if err := s.transcript.Save(s.transcriptPath); err != nil {
+		return err
+	}
+	fmt.Printf("📝 Transcript saved to %s\n", s.transcriptPath)
+	return nil
+}
+
+// requestModel returns the model named in the body of the call's request,
+// which is where the LLM providers pres uses expect it
+func requestModel(call baml.LLMCall) string {
+	body := requestBodyOf(call)
+	if body == nil {
+		return ""
+	}
+	fields, err := body.JSON()
+	if err != nil {
+		return ""
+	}
+	if fields, ok := fields.(map[string]any); ok {
+		model, _ := fields["model"].(string)
+		return model
+	}
+	return ""
+}
+
+// requestBody returns the body of the call's request, indented when it is
+// JSON, which holds the rendered prompt
+func requestBody(call baml.LLMCall) string {
+	body := requestBodyOf(call)
+	if body == nil {
+		return ""
+	}
+	if fields, err := body.JSON(); err == nil {
+		if indented, err := json.MarshalIndent(fields, "", "  "); err == nil {
+			return string(indented)
+		}
+	}
+	text, _ := body.Text()
+	return text
+}
+
+// requestBodyOf returns the HTTP body of the call's request, or nil
+func requestBodyOf(call baml.LLMCall) baml.HTTPBody {
+	request, err := call.HttpRequest()
+	if err != nil || request == nil {
+		return nil
+	}
+	body, err := request.Body()
+	if err != nil {
+		return nil
+	}
+	return body
+}
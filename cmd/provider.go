@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	baml "github.com/boundaryml/baml/engine/language_client_go/pkg"
+	"github.com/geoffjay/pres/baml_client"
+)
+
+// providerClient is the name the client built from --provider and --model is
+// registered under
+const providerClient = "PresProvider"
+
+// llmProvider is an LLM service AI calls can be sent to in place of the
+// clients defined in baml_src/clients.baml
+type llmProvider struct {
+	// baml is the BAML provider the client is built with
+	baml string
+	// model is used when none is configured; a provider without one needs
+	// a model
+	model string
+	// keyEnv is the environment variable holding the API key
+	keyEnv string
+	// baseURL is used when none is configured; openai-generic needs one
+	baseURL string
+}
+
+// llmProviders are the services --provider accepts besides the BAML clients
+var llmProviders = map[string]llmProvider{
+	"anthropic":      {baml: "anthropic", model: "claude-sonnet-4-20250514", keyEnv: "ANTHROPIC_API_KEY"},
+	"openai":         {baml: "openai", model: "gpt-4.1", keyEnv: "OPENAI_API_KEY"},
+	"azure-openai":   {baml: "azure-openai", keyEnv: "AZURE_OPENAI_API_KEY"},
+	"ollama":         {baml: "openai-generic", model: "llama3.1", baseURL: "http://localhost:11434/v1"},
+	"openai-generic": {baml: "openai-generic", keyEnv: "OPENAI_API_KEY"},
+}
+
+// defaultAzureAPIVersion is the Azure OpenAI API version used when
+// $AZURE_OPENAI_API_VERSION is not set
+const defaultAzureAPIVersion = "2024-10-21"
+
+// providerNames returns the services and BAML clients --provider accepts
+func providerNames() []string {
+	return append(slices.Sorted(maps.Keys(llmProviders)), aiClients...)
+}
+
+// checkProvider reports a provider setting that can't be used: an unknown
+// provider, a model given for a BAML client, which names its own, or a
+// missing model or endpoint
+func checkProvider() error {
+	provider, model := settings.GetString("provider"), settings.GetString("model")
+	if provider == "" || slices.Contains(aiClients, provider) {
+		if provider != "" && model != "" {
+			return fmt.Errorf("invalid config: model can't be set for the BAML client %s; use a provider such as anthropic or openai", provider)
+		}
+		return nil
+	}
+
+	spec, ok := llmProviders[provider]
+	if !ok {
+		return fmt.Errorf("invalid config: unknown provider %q (one of %s)", provider, strings.Join(providerNames(), ", "))
+	}
+	if model == "" && spec.model == "" {
+		if provider == "azure-openai" {
+			return fmt.Errorf("invalid config: provider azure-openai needs the deployment name as the model")
+		}
+		return fmt.Errorf("invalid config: provider %s needs a model", provider)
+	}
+	if provider == "openai-generic" && settings.GetString("base_url") == "" {
+		return fmt.Errorf("invalid config: provider openai-generic needs a base_url")
+	}
+	if provider == "azure-openai" && settings.GetString("base_url") == "" && os.Getenv("AZURE_OPENAI_RESOURCE") == "" {
+		return fmt.Errorf("invalid config: provider azure-openai needs a base_url or the resource name in $AZURE_OPENAI_RESOURCE")
+	}
+	return nil
+}
+
+// providerKeyEnv returns the environment variable a provider reads its API
+// key from, or "" for one that needs none. The clients in
+// baml_src/clients.baml use Anthropic's, except the local Ollama one.
+func providerKeyEnv(provider string) string {
+	if spec, ok := llmProviders[provider]; ok {
+		return spec.keyEnv
+	}
+	if provider == "CustomOllama" {
+		return ""
+	}
+	return "ANTHROPIC_API_KEY"
+}
+
+// exportAPIKey puts the configured api_key in the provider's environment
+// variable, which the BAML clients read on every call. A key already in the
+// environment is kept.
+func exportAPIKey() {
+	key, variable := settings.GetString("api_key"), providerKeyEnv(settings.GetString("provider"))
+	if key == "" || variable == "" || os.Getenv(variable) != "" {
+		return
+	}
+	os.Setenv(variable, key)
+}
+
+// providerOptions returns the BAML client options for a provider
+func providerOptions(provider string, spec llmProvider) map[string]any {
+	options := map[string]any{}
+
+	model := settings.GetString("model")
+	if model == "" {
+		model = spec.model
+	}
+	if provider == "azure-openai" {
+		options["deployment_id"] = model
+		version := os.Getenv("AZURE_OPENAI_API_VERSION")
+		if version == "" {
+			version = defaultAzureAPIVersion
+		}
+		options["api_version"] = version
+		if resource := os.Getenv("AZURE_OPENAI_RESOURCE"); resource != "" {
+			options["resource_name"] = resource
+		}
+	} else {
+		options["model"] = model
+	}
+
+	baseURL := settings.GetString("base_url")
+	if baseURL == "" {
+		baseURL = spec.baseURL
+	}
+	if baseURL != "" {
+		options["base_url"] = baseURL
+	}
+	if spec.keyEnv != "" {
+		if key := os.Getenv(spec.keyEnv); key != "" {
+			options["api_key"] = key
+		}
+	}
+	if spec.baml == "openai-generic" {
+		// Most local models prefer the user role
+		options["default_role"] = "user"
+	}
+	return options
+}
+
+// withProvider adds the configured provider's client to the options of a
+// BAML call, replacing the client the function names. The provider is one of
+// the clients in baml_src/clients.baml, or a service the client is built for
+// from the configured model and base URL. A model set without a provider
+// selects an Anthropic model. A client registry already in opts, such as the
+// one pres eval run --model picks, takes precedence.
+func withProvider(opts []baml_client.CallOptionFunc) []baml_client.CallOptionFunc {
+	provider := settings.GetString("provider")
+	if provider == "" && settings.GetString("model") != "" {
+		provider = "anthropic"
+	}
+	if provider == "" {
+		return opts
+	}
+
+	registry := baml.NewClientRegistry()
+	if spec, ok := llmProviders[provider]; ok {
+		registry.AddLlmClient(providerClient, spec.baml, providerOptions(provider, spec))
+		registry.SetPrimaryClient(providerClient)
+	} else {
+		registry.SetPrimaryClient(provider)
+	}
+	return append([]baml_client.CallOptionFunc{baml_client.WithClientRegistry(registry)}, opts...)
+}
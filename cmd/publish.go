@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	publishPath        string
+	publishDir         string
+	publishURL         string
+	publishSet         map[string]string
+	publishEmbed       bool
+	publishForce       bool
+	publishOffline     bool
+	publishCSP         bool
+	publishAllow       []string
+	publishIncludeTags []string
+	publishExcludeTags []string
+)
+
+var publishCmd = &cobra.Command{
+	Use:   "publish",
+	Short: "Publish an approved presentation for hosting",
+	Long: `Generate a presentation into a directory ready to be uploaded to a web
+host, together with its media (such as recorded narration).
+
+Only approved (or delivered) presentations can be published, so decks are
+signed off before they are shared externally; see pres status. Use --force
+to publish anyway.
+
+With --embed, an iframe snippet is printed for embedding the deck in blogs
+and internal portals. The snippet fills the width of the page and keeps the
+deck's aspect ratio. Give the address the directory is hosted at with --url
+so the snippet points at the published deck.
+
+With --offline, the deck is published as an installable web app that keeps
+working without a network once opened, e.g. on a tablet for a conference
+demo with flaky Wi-Fi. The host must serve it over https://.
+
+With --csp, the deck carries a Content-Security-Policy and is only published
+if everything it loads is local or from an origin given with --allow.
+
+Examples:
+  pres publish --path presentations/my-talk.json
+  pres publish --path presentations/my-talk.json --dir site/decks
+  pres publish --path presentations/my-talk.json --url https://decks.example.com --embed
+  pres publish --path presentations/my-talk.json --offline
+  pres publish --path presentations/my-talk.json --csp --allow https://images.example.com
+  pres publish --path presentations/my-talk.json --force`,
+	Args: cobra.NoArgs,
+	RunE: runPublish,
+}
+
+func init() {
+	rootCmd.AddCommand(publishCmd)
+
+	publishCmd.Flags().StringVarP(&publishPath, "path", "p", "", "Path to presentation JSON file (required)")
+	publishCmd.Flags().StringVarP(&publishDir, "dir", "d", "public", "Directory to publish into")
+	publishCmd.Flags().StringVar(&publishURL, "url", "", "Address the publish directory is hosted at")
+	publishCmd.Flags().StringToStringVar(&publishSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	publishCmd.Flags().BoolVar(&publishEmbed, "embed", false, "Print an iframe snippet for embedding the published deck")
+	publishCmd.Flags().BoolVar(&publishForce, "force", false, "Publish even if the presentation is not approved")
+	publishCmd.Flags().BoolVar(&publishOffline, "offline", false, "Make the deck installable and viewable offline")
+	publishCmd.Flags().BoolVar(&publishCSP, "csp", false, "Add a Content-Security-Policy and check that all resources are local or allowlisted")
+	publishCmd.Flags().StringSliceVar(&publishAllow, "allow", nil, "Origin the deck may load resources from with --csp (can be repeated)")
+	publishCmd.Flags().StringSliceVar(&publishIncludeTags, "include-tags", nil, "Only include tagged slides with one of these tags")
+	publishCmd.Flags().StringSliceVar(&publishExcludeTags, "exclude-tags", nil, "Leave out slides with any of these tags")
+	publishCmd.MarkFlagRequired("path")
+}
+
+func runPublish(cmd *cobra.Command, args []string) error {
+	if publishEmbed && publishURL == "" {
+		return fmt.Errorf("--embed needs --url, the address the published deck is hosted at")
+	}
+
+	writer := newWriter()
+	data, err := writer.LoadPresentation(publishPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	if !data.Metadata.IsPublishable() {
+		if !publishForce {
+			return fmt.Errorf("presentation is %s; only approved presentations can be published (set it with pres status set, or use --force)", data.Metadata.GetStatus())
+		}
+		fmt.Printf("⚠️  Publishing a presentation that is %s\n", data.Metadata.GetStatus())
+	}
+
+	fmt.Printf("🚀 Publishing: %s\n", data.Metadata.Title)
+
+	data.Metadata.SetVariables(publishSet)
+
+	generator := presentation.NewGenerator()
+	generator.Offline = publishOffline
+	generator.CSP = publishCSP
+	generator.Allowlist = publishAllow
+	if err := applyTagFilters(generator, publishIncludeTags, publishExcludeTags); err != nil {
+		return err
+	}
+	data, warnings, err := generator.Prepare(data, publishPath)
+	if err != nil {
+		return err
+	}
+	printWarnings(warnings)
+
+	name := strings.TrimSuffix(filepath.Base(publishPath), filepath.Ext(publishPath))
+	outputPath := filepath.Join(publishDir, name+".html")
+	if err := os.MkdirAll(publishDir, 0755); err != nil {
+		return fmt.Errorf("failed to create publish directory: %w", err)
+	}
+	if err := generator.GenerateHTML(data, outputPath); err != nil {
+		return fmt.Errorf("failed to generate HTML: %w", err)
+	}
+	fmt.Printf("✓ Generated %s\n", outputPath)
+
+	// Media such as narration is referenced relative to the deck
+	assets := filepath.Join(presentation.AssetsDir, name)
+	source := filepath.Join(filepath.Dir(publishPath), assets)
+	if _, err := os.Stat(source); err == nil {
+		if err := copyDir(source, filepath.Join(publishDir, assets)); err != nil {
+			return fmt.Errorf("failed to copy assets: %w", err)
+		}
+		fmt.Printf("✓ Copied %s\n", filepath.Join(publishDir, assets))
+	}
+
+	deckURL := name + ".html"
+	if publishURL != "" {
+		deckURL = strings.TrimSuffix(publishURL, "/") + "/" + deckURL
+	}
+
+	if publishEmbed {
+		fmt.Printf("\nEmbed snippet:\n\n%s\n", presentation.EmbedSnippet(deckURL, data.Metadata.Title))
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Upload %s to your web host\n", publishDir)
+	if publishURL != "" {
+		fmt.Printf("  • Share the link: %s\n", deckURL)
+	}
+	if !publishEmbed {
+		fmt.Printf("  • Embed it in a page: pres publish --path %s --url <address> --embed\n", publishPath)
+	}
+
+	return nil
+}
+
+// copyDir copies the files under src into dst, replacing existing ones
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, raw, 0644)
+	})
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	questionsPath string
+	questionsAll  bool
+)
+
+var questionsCmd = &cobra.Command{
+	Use:   "questions",
+	Short: "Review questions the audience asked while presenting",
+	Long: `Review the questions the audience submitted through /ask while the deck was
+served with pres serve.
+
+Questions still open after the talk can be added to the deck as a
+"Follow-ups" slide, to answer them in a follow-up email or session.
+
+Examples:
+  pres questions list --path presentations/my-talk.json
+  pres questions export --path presentations/my-talk.json
+  pres questions export --path presentations/my-talk.json --all`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var questionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List questions asked about a presentation",
+	Args:  cobra.NoArgs,
+	RunE:  runQuestionsList,
+}
+
+var questionsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Add the open questions to the deck as a follow-ups slide",
+	Args:  cobra.NoArgs,
+	RunE:  runQuestionsExport,
+}
+
+func init() {
+	rootCmd.AddCommand(questionsCmd)
+	questionsCmd.AddCommand(questionsListCmd)
+	questionsCmd.AddCommand(questionsExportCmd)
+
+	for _, c := range []*cobra.Command{questionsListCmd, questionsExportCmd} {
+		c.Flags().StringVarP(&questionsPath, "path", "p", "", "Path to presentation JSON file (required)")
+		c.Flags().BoolVarP(&questionsAll, "all", "a", false, "Include answered questions")
+		c.MarkFlagRequired("path")
+	}
+}
+
+// selectQuestions returns the questions about the deck, leaving out answered
+// ones unless --all is set
+func selectQuestions() ([]presentation.Question, error) {
+	questions, err := presentation.ReadQuestions(questionsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if questionsAll {
+		return questions, nil
+	}
+	var open []presentation.Question
+	for _, question := range questions {
+		if !question.Answered {
+			open = append(open, question)
+		}
+	}
+	return open, nil
+}
+
+func runQuestionsList(cmd *cobra.Command, args []string) error {
+	questions, err := selectQuestions()
+	if err != nil {
+		return err
+	}
+
+	if len(questions) == 0 {
+		fmt.Println("No questions.")
+		return nil
+	}
+
+	for _, q := range questions {
+		state := " "
+		if q.Answered {
+			state = "✓"
+		}
+		author := ""
+		if q.Author != "" {
+			author = " (" + q.Author + ")"
+		}
+		fmt.Printf("[%s] %s  %s%s\n", state, q.ID, q.Text, author)
+	}
+
+	return nil
+}
+
+func runQuestionsExport(cmd *cobra.Command, args []string) error {
+	questions, err := selectQuestions()
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.AddFollowUpSlide(questionsPath, questions)
+	if err != nil {
+		return fmt.Errorf("failed to add follow-ups slide: %w", err)
+	}
+
+	fmt.Printf("✓ Added a follow-ups slide with %d questions as slide %d\n", len(questions), len(data.Slides))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the slide: pres info --path %s --slides\n", questionsPath)
+	fmt.Printf("  • Regenerate HTML: pres generate --path %s\n", questionsPath)
+
+	return nil
+}
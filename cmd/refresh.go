@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/datasource"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	refreshPath    string
+	refreshOutput  string
+	refreshDate    string
+	refreshSet     map[string]string
+	refreshRequest string
+	refreshForce   bool
+)
+
+// datePattern matches a YYYY-MM-DD date in a file name
+var datePattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}`)
+
+var refreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Produce the next edition of a recurring deck",
+	Long: `Produce the next edition of a recurring deck, using the current one as a
+template.
+
+Slide titles, content, and notes, as well as the deck's title, subtitle, and
+date, may contain {{name}} placeholders that are filled in from the deck's
+variables when it is generated. Refreshing a deck copies it to a new file and
+updates its variables:
+
+  - date, week, year, month, and quarter are recalculated for today (or --date)
+  - each data source under metadata.sources is loaded again
+  - values given with --set are stored as they are
+
+The new deck starts as a draft without comments or an event date. No AI is
+involved unless --request asks for content changes, which are then applied
+to the new deck like pres update, with the same guardrails: --max-deletes
+and --allow-metadata work as they do there.
+
+The output defaults to the input file name with its date replaced by the new
+date (or the new date appended).
+
+Data sources are declared in the deck's metadata:
+  "sources": [
+    {"name": "signups", "type": "csv", "path": "data/signups.csv"},
+    {"name": "uptime", "type": "json", "url": "https://status.example.com/api/summary"}
+  ]
+
+A csv source provides {{signups}} as a markdown table, {{signups.rows}}, and
+{{signups.<column>}} for the last row; a json source provides {{uptime}} and
+{{uptime.<key>}} for top-level values; a text source provides its content.
+
+Examples:
+  pres refresh --path presentations/weekly-2025-11-10.json
+  pres refresh --path presentations/weekly.json --output presentations/weekly-w47.json --set owner=Sam
+  pres refresh --path presentations/weekly-2025-11-10.json --request "Add a slide about the incident on Tuesday"`,
+	Args: cobra.NoArgs,
+	RunE: runRefresh,
+}
+
+func init() {
+	rootCmd.AddCommand(refreshCmd)
+
+	refreshCmd.Flags().StringVarP(&refreshPath, "path", "p", "", "Path to the previous edition (required)")
+	refreshCmd.Flags().StringVarP(&refreshOutput, "output", "o", "", "Output path for the new edition (default: derived from the input)")
+	refreshCmd.Flags().StringVar(&refreshDate, "date", "", "Date of the new edition as YYYY-MM-DD (default: today)")
+	refreshCmd.Flags().StringToStringVar(&refreshSet, "set", nil, "Set a variable as key=value (can be repeated)")
+	refreshCmd.Flags().StringVarP(&refreshRequest, "request", "r", "", "Content changes to make with AI after refreshing")
+	refreshCmd.Flags().BoolVarP(&refreshForce, "force", "f", false, "Overwrite the output file if it exists")
+	// The guardrails are those of pres update, so they share its settings
+	refreshCmd.Flags().IntVar(&updateMaxDeletes, "max-deletes", presentation.DefaultMaxDeletes, "Most slides the --request changes may delete (-1 for no limit)")
+	refreshCmd.Flags().BoolVar(&updateMetadata, "allow-metadata", false, "Allow metadata changes even when --request does not explicitly ask for them")
+	refreshCmd.MarkFlagRequired("path")
+}
+
+func runRefresh(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	now := time.Now()
+	if refreshDate != "" {
+		date, err := time.ParseInLocation(presentation.EventDateFormat, refreshDate, time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid --date %q: expected YYYY-MM-DD", refreshDate)
+		}
+		now = date
+	}
+
+	fmt.Printf("🔁 Refreshing presentation: %s\n", refreshPath)
+
+	writer := newWriter()
+	previous, err := writer.LoadPresentation(refreshPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	vars := presentation.BuiltinVariables(now)
+	for _, source := range previous.Metadata.Sources {
+		fmt.Printf("  Loading %s (%s)\n", source.Name, source.Type)
+		values, err := datasource.Fetch(ctx, source, filepath.Dir(refreshPath))
+		if err != nil {
+			return fmt.Errorf("failed to load data: %w", err)
+		}
+		maps.Copy(vars, values)
+	}
+	maps.Copy(vars, refreshSet)
+
+	next := previous.Clone()
+	next.Metadata.SetVariables(vars)
+	next.Metadata.Status = presentation.StatusDraft
+	next.Metadata.EventDate = ""
+	next.Metadata.Created = time.Now()
+	next.Metadata.Modified = time.Now()
+	for i := range next.Slides {
+		next.Slides[i].Comments = nil
+	}
+
+	outputPath := refreshOutput
+	if outputPath == "" {
+		outputPath = refreshOutputPath(refreshPath, previous.Metadata.Variables["date"], vars["date"])
+	}
+	if filepath.Clean(outputPath) == filepath.Clean(refreshPath) {
+		return fmt.Errorf("the new edition would overwrite %s; set --output", refreshPath)
+	}
+	if _, err := os.Stat(outputPath); err == nil && !refreshForce {
+		return fmt.Errorf("%s already exists (use --force to overwrite)", outputPath)
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	savedPath, err := writer.SaveData(next, outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	// Show which variables changed since the previous edition
+	fmt.Printf("\nVariables:\n")
+	for _, name := range slices.Sorted(maps.Keys(vars)) {
+		old, had := previous.Metadata.Variables[name]
+		value := vars[name]
+		switch {
+		case !had:
+			fmt.Printf("  + %s = %s\n", name, summarizeValue(value))
+		case old != value:
+			fmt.Printf("  ~ %s: %s → %s\n", name, summarizeValue(old), summarizeValue(value))
+		}
+	}
+
+	if refreshRequest != "" {
+		fmt.Println("\nGenerating update operations...")
+		deck := next.BuildContext(presentation.MentionedSlides(refreshRequest), presentation.EstimateTokens(refreshRequest), presentation.DefaultContextBudget)
+		printContextBudget(deck)
+		session, err := newAISession("pres refresh", refreshRequest, "")
+		if err != nil {
+			return err
+		}
+		updates, err := generateUpdateOperations(ctx, refreshRequest, deck.Text, nil, session.option())
+		if err != nil {
+			return fmt.Errorf("failed to generate updates: %w", err)
+		}
+
+		writer.SetAudit("pres refresh", auditActor())
+		writer.AddProvenance(session.collect()...)
+		writer.SetPolicy(updatePolicy(nil, refreshRequest))
+		results, err := writer.UpdatePresentation(savedPath, updates, false)
+		if errors.Is(err, presentation.ErrUpdateRejected) {
+			printOperationResults(results)
+			fmt.Printf("\n⚠ The requested changes were not applied. Try again with: pres update --path %s \"...\"\n", savedPath)
+		} else if err != nil {
+			return fmt.Errorf("failed to apply updates: %w", err)
+		} else {
+			printOperationResults(results)
+		}
+	}
+
+	fmt.Printf("\n✓ Presentation refreshed successfully!\n")
+	fmt.Printf("  Location: %s\n", savedPath)
+	fmt.Printf("  Date: %s (week %s)\n", vars["date"], vars["week"])
+	fmt.Printf("  Slides: %d\n", len(next.Slides))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Generate HTML: pres generate --path %s\n", savedPath)
+	fmt.Printf("  • Make changes: pres update --path %s \"your update request\"\n", savedPath)
+
+	return nil
+}
+
+// refreshOutputPath derives the path of the next edition by replacing the
+// previous date in the file name with the new one, or appending the new date
+func refreshOutputPath(path, oldDate, newDate string) string {
+	dir := filepath.Dir(path)
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	switch {
+	case oldDate != "" && strings.Contains(name, oldDate):
+		name = strings.Replace(name, oldDate, newDate, 1)
+	case datePattern.MatchString(name):
+		name = datePattern.ReplaceAllString(name, newDate)
+	default:
+		name = name + "-" + newDate
+	}
+
+	return filepath.Join(dir, name+".json")
+}
+
+// summarizeValue shortens multi-line values such as tables for display
+func summarizeValue(value string) string {
+	if first, _, ok := strings.Cut(value, "\n"); ok {
+		return first + " …"
+	}
+	return value
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/datasource"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	regenPath    string
+	regenSection string
+	regenContext []string
+	regenRequest string
+	regenYes     bool
+)
+
+var regenCmd = &cobra.Command{
+	Use:   "regen",
+	Short: "Regenerate one section of a presentation with AI",
+	Long: `Regenerate the slides of one section of a presentation with AI, from the
+section's outline plus the latest context, leaving every other slide exactly
+as it is. Useful when one part of the story changes.
+
+A section starts at a title slide and runs up to the next one; --section picks
+it by the title slide's title. The outline is the section's current slides, in
+order. The latest context is:
+
+  - the current values of the deck's data sources, loaded again
+  - the files given with --context, such as new results or meeting notes
+  - the instructions given with --request
+
+The regenerated section is previewed and saved once confirmed. Slides that
+keep their position keep their ID and tags. Sections with locked slides or
+slides shared from other decks can't be regenerated.
+
+Examples:
+  pres regen --path presentations/review.json --section "Performance"
+  pres regen --path presentations/review.json --section "Performance" --context notes/benchmarks.md
+  pres regen --path presentations/review.json --section "Roadmap" --request "Q3 moved to Q4" --yes`,
+	Args: cobra.NoArgs,
+	RunE: runRegen,
+}
+
+func init() {
+	rootCmd.AddCommand(regenCmd)
+
+	regenCmd.Flags().StringVarP(&regenPath, "path", "p", "", "Path to presentation JSON file (required)")
+	regenCmd.Flags().StringVarP(&regenSection, "section", "s", "", "Title of the section's title slide (required)")
+	regenCmd.Flags().StringSliceVar(&regenContext, "context", nil, "File with new context for the section (can be repeated)")
+	regenCmd.Flags().StringVarP(&regenRequest, "request", "r", "", "Instructions for the regeneration")
+	regenCmd.Flags().BoolVarP(&regenYes, "yes", "y", false, "Save the regenerated section without asking for approval")
+	regenCmd.MarkFlagRequired("path")
+	regenCmd.MarkFlagRequired("section")
+}
+
+func runRegen(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	writer := newWriter()
+	writer.SetAudit("pres regen", auditActor())
+
+	data, err := writer.LoadPresentation(regenPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	section, err := data.FindSection(regenSection)
+	if err != nil {
+		return err
+	}
+	if err := data.CheckSection(section); err != nil {
+		return err
+	}
+
+	fmt.Printf("🔁 Regenerating section %q (slides %d-%d)\n", section.Title, section.Start+1, section.End)
+
+	latest, err := latestContext(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	session, err := newAISession("pres regen", regenRequest, "")
+	if err != nil {
+		return err
+	}
+
+	focus := make([]int, 0, section.End-section.Start)
+	for i := section.Start; i < section.End; i++ {
+		focus = append(focus, i)
+	}
+	outline := data.Outline(section)
+	reserved := presentation.EstimateTokensAll([]string{outline, latest})
+	deck := data.BuildContext(focus, reserved, presentation.DefaultContextBudget)
+
+	slides, err := regenerateSection(ctx, deck.Text, section.Title, outline, latest, session.option())
+	if err != nil {
+		return fmt.Errorf("failed to regenerate section: %w", err)
+	}
+	if len(slides) == 0 {
+		return fmt.Errorf("the model returned no slides for the section")
+	}
+	if slides[0].Layout != "title" {
+		return fmt.Errorf("the regenerated section does not start with a title slide; nothing was changed")
+	}
+	writer.AddProvenance(session.collect()...)
+
+	fmt.Printf("\nRegenerated section (%d slides, was %d):\n", len(slides), section.End-section.Start)
+	opts := render.Options{Width: previewWidth, MaxLines: 8}
+	for i, slide := range slides {
+		fmt.Println(render.Card(presentation.Slide{Slide: slide}, section.Start+i+1, opts))
+	}
+
+	if !regenYes {
+		ok, err := confirmBatch(fmt.Sprintf("Replace section %q with these slides?", section.Title))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println("✗ Regeneration discarded; nothing was changed")
+			return nil
+		}
+	}
+
+	updated, err := writer.ReplaceSection(regenPath, section.Title, slides)
+	if err != nil {
+		return fmt.Errorf("failed to save presentation: %w", err)
+	}
+
+	fmt.Printf("\n✓ Section regenerated successfully!\n")
+	fmt.Printf("  Location: %s\n", regenPath)
+	fmt.Printf("  Slides: %d\n", len(updated.Slides))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the changes: pres audit --path %s\n", regenPath)
+	fmt.Printf("  • Generate HTML: pres generate --path %s\n", regenPath)
+
+	return nil
+}
+
+// latestContext gathers the context to regenerate a section from: the
+// current values of the deck's data sources, the --context files, and the
+// --request instructions
+func latestContext(ctx context.Context, data *presentation.PresentationData) (string, error) {
+	var sb strings.Builder
+
+	for _, source := range data.Metadata.Sources {
+		fmt.Printf("  Loading %s (%s)\n", source.Name, source.Type)
+		values, err := datasource.Fetch(ctx, source, filepath.Dir(regenPath))
+		if err != nil {
+			return "", fmt.Errorf("failed to load data: %w", err)
+		}
+		fmt.Fprintf(&sb, "Data source %s:\n", source.Name)
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			fmt.Fprintf(&sb, "  %s: %s\n", key, values[key])
+		}
+		sb.WriteString("\n")
+	}
+
+	for _, path := range regenContext {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read context: %w", err)
+		}
+		fmt.Fprintf(&sb, "From %s:\n%s\n\n", filepath.Base(path), strings.TrimSpace(string(content)))
+	}
+
+	if regenRequest != "" {
+		fmt.Fprintf(&sb, "Instructions: %s\n", regenRequest)
+	}
+
+	if sb.Len() == 0 {
+		return "No new context; bring the section up to date with the rest of the presentation and tighten its flow.", nil
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
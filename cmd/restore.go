@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	restorePath string
+	restoreID   string
+	restoreDir  string
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore a presentation from the trash",
+	Long: `Restore a presentation moved to the trash with pres rm, along with its
+assets and local data.
+
+Without --path, the decks in the trash of --dir and the directories below it
+are listed. When a deck was deleted more than once, the most recent copy is
+restored unless --id picks another one.
+
+Examples:
+  pres restore
+  pres restore --path presentations/old-talk.json
+  pres restore --path presentations/old-talk.json --id old-talk-20250301T101500`,
+	Args: cobra.NoArgs,
+	RunE: runRestore,
+}
+
+func init() {
+	rootCmd.AddCommand(restoreCmd)
+
+	restoreCmd.Flags().StringVarP(&restorePath, "path", "p", "", "Path the presentation was deleted from")
+	restoreCmd.Flags().StringVar(&restoreID, "id", "", "Trash entry to restore, when the deck was deleted more than once")
+	restoreCmd.Flags().StringVarP(&restoreDir, "dir", "d", "", "Directory whose trash is listed (default: the project's presentations directory)")
+}
+
+func runRestore(cmd *cobra.Command, args []string) error {
+	if restoreDir == "" {
+		restoreDir = presentationsDir()
+	}
+	if restorePath == "" {
+		return listTrash()
+	}
+
+	entry, err := presentation.RestorePresentation(restorePath, restoreID)
+	if err != nil {
+		return fmt.Errorf("failed to restore presentation: %w", err)
+	}
+
+	fmt.Printf("✓ Restored %s (%s), deleted %s\n", entry.Path, entry.Title, entry.Deleted.Format("2006-01-02 15:04"))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • View details: pres info --path %s\n", entry.Path)
+
+	return nil
+}
+
+// listTrash prints the decks in the trash of --dir
+func listTrash() error {
+	entries, err := presentation.ListTrash(restoreDir)
+	if err != nil {
+		return fmt.Errorf("failed to read trash: %w", err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("The trash in %s is empty\n", restoreDir)
+		return nil
+	}
+
+	for _, entry := range entries {
+		fmt.Printf("%s\n", entry.Title)
+		fmt.Printf("  %s · %d slides · deleted %s", entry.Path, entry.Slides, entry.Deleted.Format("2006-01-02 15:04"))
+		if entry.Actor != "" {
+			fmt.Printf(" by %s", entry.Actor)
+		}
+		fmt.Printf(" · id %s\n", entry.ID)
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Restore a deck: pres restore --path %s\n", entries[0].Path)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	rmPath string
+	rmDir  string
+)
+
+var rmCmd = &cobra.Command{
+	Use:   "rm",
+	Short: "Move a presentation to the trash",
+	Long: `Move a presentation to the trash instead of deleting it for good.
+
+The deck, its media in assets/<name>/, and its local data in .pres/ (audit
+log, questions, feedback, revision history) are moved to .pres/trash/ next
+to it. Bring them back with pres restore. Decks in --dir that reference
+slides of the deck are listed, since their references break until it is
+restored.
+
+Examples:
+  pres rm --path presentations/old-talk.json
+  pres restore --path presentations/old-talk.json`,
+	Args: cobra.NoArgs,
+	RunE: runRm,
+}
+
+func init() {
+	rootCmd.AddCommand(rmCmd)
+
+	rmCmd.Flags().StringVarP(&rmPath, "path", "p", "", "Path to presentation JSON file (required)")
+	rmCmd.Flags().StringVarP(&rmDir, "dir", "d", "", "Directory to scan for decks referring to the deck (default: the project's presentations directory)")
+	rmCmd.MarkFlagRequired("path")
+}
+
+func runRm(cmd *cobra.Command, args []string) error {
+	if rmDir == "" {
+		rmDir = presentationsDir()
+	}
+	writer := newWriter()
+	writer.SetAudit("pres rm", auditActor())
+
+	var referrers []string
+	if _, err := os.Stat(rmDir); err == nil {
+		entries, err := writer.ScanPresentations(rmDir)
+		if err != nil {
+			return fmt.Errorf("failed to scan presentations: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.Data.ReferencesDeck(entry.Path, rmPath) {
+				referrers = append(referrers, entry.Path)
+			}
+		}
+	}
+
+	entry, err := writer.TrashPresentation(rmPath)
+	if err != nil {
+		return fmt.Errorf("failed to move presentation to the trash: %w", err)
+	}
+
+	fmt.Printf("🗑  Moved %s (%s) to the trash\n", rmPath, entry.Title)
+	for _, path := range referrers {
+		fmt.Printf("⚠ %s references slides in this deck\n", path)
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Undo: pres restore --path %s\n", rmPath)
+
+	return nil
+}
@@ -3,16 +3,32 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"os/user"
+	"path/filepath"
+	"time"
 
+	"github.com/geoffjay/pres/internal/platform"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/release"
+	"github.com/geoffjay/pres/internal/workspace"
 	"github.com/spf13/cobra"
 )
 
+var (
+	strictLoad bool
+
+	// project is the pres project the working directory is in, or nil
+	project *workspace.Workspace
+)
+
 var rootCmd = &cobra.Command{
 	Use:   "pres",
 	Short: "A presentation generation CLI utility",
 	Long: `pres is a CLI utility for simplifying the creation of presentations.
 It provides commands for working with presentations, such as creating,
 updating, and generating presentation output.`,
+	Version:           release.Current(),
+	PersistentPreRunE: setup,
 	Run: func(cmd *cobra.Command, args []string) {
 		// Default behavior when no subcommand is specified
 		cmd.Help()
@@ -22,13 +38,109 @@ updating, and generating presentation output.`,
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	start := time.Now()
+	go checkForUpdate()
+	cmd, err := rootCmd.ExecuteC()
+	recordUsage(cmd, time.Since(start), err == nil)
+	printUpdateNotice(cmd)
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
 
 func init() {
-	// Global flags can be added here
-	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.pres.yaml)")
+	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "Config file (default: ~/.config/pres/config.yaml)")
+	rootCmd.PersistentFlags().BoolVar(&strictLoad, "strict", false, "Reject presentation files with unknown keys, missing fields, or in the raw format")
+	rootCmd.PersistentFlags().String("provider", "", "LLM service or BAML client AI calls use (see pres config)")
+	rootCmd.PersistentFlags().String("model", "", "Model the provider is asked for")
+	rootCmd.PersistentFlags().Bool("git-commit", false, "Commit each presentation pres creates or updates to its git repository")
+	settings.BindPFlag("provider", rootCmd.PersistentFlags().Lookup("provider"))
+	settings.BindPFlag("model", rootCmd.PersistentFlags().Lookup("model"))
+	settings.BindPFlag("git_commit", rootCmd.PersistentFlags().Lookup("git-commit"))
+}
+
+// setup runs before every command: it finds the project, asks the setup
+// questions the first time pres runs, and loads the configuration
+func setup(cmd *cobra.Command, args []string) error {
+	if err := findProject(cmd, args); err != nil {
+		return err
+	}
+	if needsFirstRun(cmd) {
+		if err := firstRun(); err != nil {
+			return err
+		}
+	}
+	return loadConfig()
+}
+
+// findProject finds the project the working directory is in, like git finds
+// a repository, and resolves a --path given relative to the project root
+func findProject(cmd *cobra.Command, args []string) error {
+	var err error
+	if project, err = workspace.Find("."); err != nil {
+		return err
+	}
+	if project == nil {
+		return nil
+	}
+
+	if flag := cmd.Flags().Lookup("path"); flag != nil && flag.Changed {
+		return flag.Value.Set(project.Resolve(flag.Value.String()))
+	}
+	return nil
+}
+
+// presentationsDir returns the directory presentations are kept in: the
+// project's presentations directory, or the configured output_dir outside a
+// project
+func presentationsDir() string {
+	if project == nil {
+		return platform.ExpandHome(settings.GetString("output_dir"))
+	}
+	return workspace.Relative(project.PresentationsDir())
+}
+
+// resolveTemplate finds an HTML template given relative to the working
+// directory, the project root, or the project's templates directory
+func resolveTemplate(path string) string {
+	if project == nil || path == "" {
+		return path
+	}
+	if resolved := project.Resolve(path); resolved != path {
+		return resolved
+	}
+	if _, err := os.Stat(path); err != nil {
+		if _, err := os.Stat(filepath.Join(project.TemplatesDir(), path)); err == nil {
+			return workspace.Relative(filepath.Join(project.TemplatesDir(), path))
+		}
+	}
+	return path
+}
+
+// newWriter creates a presentation writer configured from the global flags
+func newWriter() *presentation.Writer {
+	writer := presentation.NewWriter(".")
+	writer.SetStrict(strictLoad)
+	writer.SetGitCommit(settings.GetBool("git_commit"))
+	return writer
+}
+
+// printWarnings shows problems that did not stop a command
+func printWarnings(warnings []string) {
+	for _, warning := range warnings {
+		fmt.Printf("⚠ %s\n", warning)
+	}
+}
+
+// auditActor identifies who is running pres for the audit log. PRES_ACTOR
+// overrides the login name, e.g. to name a CI job.
+func auditActor() string {
+	if actor := os.Getenv("PRES_ACTOR"); actor != "" {
+		return actor
+	}
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
 }
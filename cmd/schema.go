@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Work with the presentation JSON Schema",
+	Long: `Work with the JSON Schema describing the presentation file format.
+
+Presentation files are validated against this schema when they are loaded.
+Point your editor at the printed schema to get autocompletion and validation
+while editing presentation JSON by hand.
+
+Examples:
+  pres schema print
+  pres schema print > presentation.schema.json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var schemaPrintCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Print the presentation JSON Schema",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := os.Stdout.Write(presentation.GetSchema())
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+	schemaCmd.AddCommand(schemaPrintCmd)
+}
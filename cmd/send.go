@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/notify"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	sendPath     string
+	sendTo       string
+	sendAttach   []string
+	sendSubject  string
+	sendTemplate string
+	sendDryRun   bool
+)
+
+var sendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "Email a presentation handout to a list of recipients",
+	Long: `Email a presentation handout to each recipient in a CSV list.
+
+The list has one recipient per line: an email address and an optional name.
+A header row starting with "email" is skipped.
+
+By default the generated reveal.js HTML is attached as the handout; use
+--attach to send other files, such as a PDF export, instead. The subject and
+body are Go templates with the fields {{.Name}}, {{.Email}}, {{.Title}},
+{{.Subtitle}}, {{.Author}}, and {{.Date}}.
+
+SMTP settings are read from the environment:
+  SMTP_HOST      Server host name (required)
+  SMTP_PORT      Server port (default: 587)
+  SMTP_USERNAME  Login name, if the server requires authentication
+  SMTP_PASSWORD  Login password
+  SMTP_FROM      Sender address, e.g. "Jane Doe <jane@example.com>" (required)
+
+Examples:
+  pres send --path presentations/my-talk.json --to attendees.csv
+  pres send --path presentations/my-talk.json --to attendees.csv --attach my-talk.pdf
+  pres send --path presentations/my-talk.json --to attendees.csv --template message.txt --subject "Thanks for coming!"
+  pres send --path presentations/my-talk.json --to attendees.csv --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runSend,
+}
+
+func init() {
+	rootCmd.AddCommand(sendCmd)
+
+	sendCmd.Flags().StringVarP(&sendPath, "path", "p", "", "Path to presentation JSON file (required)")
+	sendCmd.Flags().StringVar(&sendTo, "to", "", "CSV file of recipients (required)")
+	sendCmd.Flags().StringSliceVarP(&sendAttach, "attach", "a", nil, "Files to attach (default: the generated HTML)")
+	sendCmd.Flags().StringVar(&sendSubject, "subject", notify.DefaultEmailSubject, "Subject template")
+	sendCmd.Flags().StringVar(&sendTemplate, "template", "", "File containing the message body template")
+	sendCmd.Flags().BoolVar(&sendDryRun, "dry-run", false, "Print the messages instead of sending them")
+	sendCmd.MarkFlagRequired("path")
+	sendCmd.MarkFlagRequired("to")
+}
+
+func runSend(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(sendPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	data, _, err = presentation.NewGenerator().Prepare(data, sendPath)
+	if err != nil {
+		return err
+	}
+
+	recipients, err := notify.ReadRecipients(sendTo)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return fmt.Errorf("no recipients found in %s", sendTo)
+	}
+
+	body := notify.DefaultEmailBody
+	if sendTemplate != "" {
+		content, err := os.ReadFile(sendTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to read template: %w", err)
+		}
+		body = string(content)
+	}
+
+	attachments, err := loadAttachments(data)
+	if err != nil {
+		return err
+	}
+
+	config := notify.SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	}
+	if config.Port == "" {
+		config.Port = "587"
+	}
+	if !sendDryRun && (config.Host == "" || config.From == "") {
+		return fmt.Errorf("SMTP_HOST and SMTP_FROM must be set to send email")
+	}
+
+	fmt.Printf("📧 Sending %s to %d recipient(s)\n", data.Metadata.Title, len(recipients))
+
+	sent := 0
+	for _, recipient := range recipients {
+		fields := notify.NewEmailFields(data, recipient)
+		subject, err := notify.RenderTemplate("subject", sendSubject, fields)
+		if err != nil {
+			return err
+		}
+		message, err := notify.RenderTemplate("body", body, fields)
+		if err != nil {
+			return err
+		}
+
+		if sendDryRun {
+			fmt.Printf("\nTo: %s\nSubject: %s\n\n%s\n", recipient.Email, subject, message)
+			continue
+		}
+
+		email, err := notify.BuildEmail(config.From, recipient, subject, message, attachments)
+		if err != nil {
+			return fmt.Errorf("failed to compose email: %w", err)
+		}
+		if err := notify.SendEmail(config, recipient.Email, email); err != nil {
+			fmt.Printf("  ✗ %v\n", err)
+			continue
+		}
+		fmt.Printf("  ✓ %s\n", recipient.Email)
+		sent++
+	}
+
+	if sendDryRun {
+		names := make([]string, 0, len(attachments))
+		for _, attachment := range attachments {
+			names = append(names, attachment.Name)
+		}
+		fmt.Printf("\nAttachments: %s\n", strings.Join(names, ", "))
+		return nil
+	}
+
+	fmt.Printf("\n✓ Sent %d of %d email(s)\n", sent, len(recipients))
+	if sent < len(recipients) {
+		return fmt.Errorf("%d email(s) could not be sent", len(recipients)-sent)
+	}
+
+	return nil
+}
+
+// loadAttachments reads the files given with --attach, or renders the
+// prepared deck's reveal.js HTML when none were given
+func loadAttachments(data *presentation.PresentationData) ([]notify.Attachment, error) {
+	if len(sendAttach) == 0 {
+		name := strings.TrimSuffix(filepath.Base(sendPath), filepath.Ext(sendPath)) + ".html"
+		html, err := presentation.NewGenerator().RenderHTML(data)
+		if err != nil {
+			return nil, err
+		}
+		return []notify.Attachment{{Name: name, Data: []byte(html)}}, nil
+	}
+
+	attachments := make([]notify.Attachment, 0, len(sendAttach))
+	for _, path := range sendAttach {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read attachment: %w", err)
+		}
+		attachments = append(attachments, notify.Attachment{Name: filepath.Base(path), Data: content})
+	}
+	return attachments, nil
+}
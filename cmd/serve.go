@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/geoffjay/pres/internal/platform"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/web"
+	"github.com/spf13/cobra"
+)
+
+var (
+	servePath        string
+	serveAddr        string
+	serveSet         map[string]string
+	serveWebcam      bool
+	serveRecord      bool
+	serveFollow      bool
+	serveFeedback    bool
+	serveIncludeTags []string
+	serveExcludeTags []string
+	serveNoReload    bool
+	serveTemplate    string
+	serveOpen        bool
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Present a deck from a local web server",
+	Long: `Serve a presentation from a local web server for presenting.
+
+The deck is regenerated from its JSON file on every load, and browsers
+showing it reload on their own, staying on the current slide, whenever the
+file changes, e.g. after pres update or an edit in your editor. Disable this
+with --no-reload. Images and other files next to the deck are served as well,
+except deck JSON and HTML files.
+
+Open the deck with the presenter link printed at startup. Only that copy
+carries the speaker notes; the audience link serves the deck without them.
+
+Companion views follow the slide the presenter is on:
+  /teleprompter  Auto-scrolls the current slide's speaker notes at an
+                 adjustable speed, for recorded or virtual presentations;
+                 it needs the presenter key (link printed at startup)
+
+The audience can send questions from /ask. They queue up on the question
+queue page (link printed at startup), where the presenter marks them as
+answered. Afterwards, add them to the deck as a follow-ups slide with
+pres questions export.
+
+With --feedback, viewers reaching the last slide are asked for a rating and
+an optional comment. Responses are stored next to the deck and summarized
+by pres feedback report.
+
+With --follow, audience browsers opening the deck are locked to the slide
+the presenter is on, which helps when screen sharing quality is poor. Each
+viewer can switch to browsing freely and back. The presenter opens the deck
+with the presenter link printed at startup; only that browser moves the
+audience along.
+
+With --record, press R in the deck to start recording narration from the
+microphone. Each slide's take is saved when you move to the next slide, under
+assets/<deck>/audio/ next to the deck, and linked from the slide's audio
+field; recording a slide again replaces its take. Generated decks play the
+narration and advance on their own when N is pressed (or with ?narrate in the
+URL), turning a rehearsal into a narrated deck in one pass.
+
+With --template, the deck is built from your own HTML document template, as
+for pres generate.
+
+Examples:
+  pres serve --path presentations/my-talk.json
+  pres serve --path presentations/master.json --set region=EU --addr localhost:9000
+  pres serve --path presentations/my-talk.json --webcam
+  pres serve --path presentations/my-talk.json --open
+  pres serve --path presentations/my-talk.json --record
+  pres serve --path presentations/my-talk.json --follow --addr 0.0.0.0:8000
+  pres serve --path presentations/my-talk.json --follow --feedback --addr 0.0.0.0:8000`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().StringVarP(&servePath, "path", "p", "", "Path to presentation JSON file (required)")
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "localhost:8000", "Address to listen on")
+	serveCmd.Flags().StringToStringVar(&serveSet, "set", nil, "Override a variable as key=value (can be repeated)")
+	serveCmd.Flags().BoolVar(&serveWebcam, "webcam", false, "Show a self-view webcam bubble on the slides")
+	serveCmd.Flags().BoolVar(&serveRecord, "record", false, "Record narration per slide from the microphone (press R)")
+	serveCmd.Flags().BoolVar(&serveFollow, "follow", false, "Keep audience browsers on the presenter's current slide")
+	serveCmd.Flags().BoolVar(&serveFeedback, "feedback", false, "Ask viewers for a rating and comment at the end of the deck")
+	serveCmd.Flags().StringSliceVar(&serveIncludeTags, "include-tags", nil, "Only include tagged slides with one of these tags")
+	serveCmd.Flags().StringSliceVar(&serveExcludeTags, "exclude-tags", nil, "Leave out slides with any of these tags")
+	serveCmd.Flags().BoolVar(&serveNoReload, "no-reload", false, "Don't reload browsers when the presentation file changes")
+	serveCmd.Flags().StringVar(&serveTemplate, "template", "", "HTML document template to build the page from (default: built-in)")
+	serveCmd.Flags().BoolVar(&serveOpen, "open", false, "Open the deck in the default browser once the server is listening")
+	serveCmd.MarkFlagRequired("path")
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	// Fail early on a deck that cannot be loaded
+	writer := newWriter()
+	data, err := writer.LoadPresentation(servePath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	generator := presentation.NewGenerator()
+	generator.Webcam = serveWebcam
+	if err := applyTagFilters(generator, serveIncludeTags, serveExcludeTags); err != nil {
+		return err
+	}
+	if serveTemplate != "" {
+		if err := generator.LoadTemplate(resolveTemplate(serveTemplate)); err != nil {
+			return err
+		}
+	}
+	server := web.NewDeckServer(servePath, serveSet, generator)
+	server.Record = serveRecord
+	server.Follow = serveFollow
+	server.Feedback = serveFeedback
+	server.LiveReload = !serveNoReload
+
+	fmt.Printf("🎤 Serving %s\n", data.Metadata.Title)
+	fmt.Printf("  Presenter: http://%s/?presenter=%s\n", serveAddr, server.PresenterKey())
+	fmt.Printf("  Audience: http://%s/\n", serveAddr)
+	fmt.Printf("  Teleprompter: http://%s/teleprompter?presenter=%s\n", serveAddr, server.PresenterKey())
+	fmt.Printf("  Ask a question: http://%s/ask\n", serveAddr)
+	fmt.Printf("  Question queue: http://%s/questions?presenter=%s\n", serveAddr, server.PresenterKey())
+	if serveRecord {
+		fmt.Printf("\nPress R in the deck to start and stop recording narration\n")
+	}
+	if serveFeedback {
+		fmt.Printf("\nFeedback is collected on the last slide; review it with: pres feedback report --path %s\n", servePath)
+	}
+	if server.LiveReload {
+		fmt.Printf("\nWatching %s for changes\n", servePath)
+	}
+	fmt.Printf("\nPress Ctrl+C to stop\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.Watch(ctx)
+
+	listener, err := net.Listen("tcp", serveAddr)
+	if err != nil {
+		return err
+	}
+	if serveOpen {
+		deck := browserURL(serveAddr) + "/?presenter=" + server.PresenterKey()
+		if err := platform.Open(deck); err != nil {
+			fmt.Printf("⚠ Could not open a browser: %v\n", err)
+		}
+	}
+	return http.Serve(listener, server.Handler())
+}
+
+// browserURL returns the URL a browser on this machine reaches a server
+// listening on addr at; an address on all interfaces is reached at localhost
+func browserURL(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "http://" + addr
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+	return "http://" + net.JoinHostPort(host, port)
+}
@@ -0,0 +1,581 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/internal/platform"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/geoffjay/pres/internal/tui"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// skippedConfig is written when the first-run setup is skipped, so it is
+// not offered again
+const skippedConfig = "# pres configuration: run pres setup to fill it in, or see pres config for the keys\n"
+
+var setupCmd = &cobra.Command{
+	Use:   "setup",
+	Short: "Set up the AI provider, author, and defaults",
+	Long: `Set up pres with a few questions: the AI provider and its credentials, the
+author of new presentations, where they are saved, and their theme. The
+answers are written to your config file, ~/.config/pres/config.yaml or the
+file given with --config, keeping any other keys in it. An API key is saved
+to the file only when its environment variable is not set, and the file is
+then readable only by you.
+
+The first time pres runs without a config file in a terminal, it asks these
+questions before the command. Press Esc to skip them; pres setup asks them
+again any time.
+
+Examples:
+  pres setup
+  pres --config team.yaml setup`,
+	Args: cobra.NoArgs,
+	RunE: runSetup,
+}
+
+func init() {
+	rootCmd.AddCommand(setupCmd)
+}
+
+func runSetup(cmd *cobra.Command, args []string) error {
+	path := configPath()
+	answers, ok, err := runSetupWizard()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("setup cancelled; nothing was saved")
+	}
+	if err := saveSetup(path, answers); err != nil {
+		return err
+	}
+
+	printSetupSummary(path, answers)
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Check the settings: pres config\n")
+	fmt.Printf("  • Create a presentation: pres create \"your topic\"\n")
+	return nil
+}
+
+// configPath returns the config file setup writes: the one given with
+// --config, or the user's
+func configPath() string {
+	if configFile != "" {
+		return platform.ExpandHome(configFile)
+	}
+	return defaultConfigFile()
+}
+
+// needsFirstRun reports whether the setup questions are asked before cmd:
+// the first time someone runs pres in a terminal, before they have a config
+// file
+func needsFirstRun(cmd *cobra.Command) bool {
+	if configFile != "" || os.Getenv("CI") != "" || !isUserCommand(cmd) || !cmd.HasParent() {
+		return false
+	}
+	for c := cmd; c != nil; c = c.Parent() {
+		switch c.Name() {
+		case "setup", "help", "completion", "telemetry", "upgrade", "tutorial":
+			return false
+		}
+	}
+	for _, file := range []*os.File{os.Stdin, os.Stdout} {
+		if info, err := file.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+			return false
+		}
+	}
+	path := defaultConfigFile()
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return errors.Is(err, fs.ErrNotExist)
+}
+
+// firstRun asks the setup questions and writes the user's config file
+// before their first command runs. Skipping them writes a config file with
+// only a comment, so they are asked once.
+func firstRun() error {
+	path := defaultConfigFile()
+	fmt.Printf("👋 Welcome to pres! A few questions set up %s; press Esc to skip them\n\n", path)
+
+	answers, ok, err := runSetupWizard()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create config directory: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(skippedConfig), 0600); err != nil {
+			return fmt.Errorf("failed to write config %s: %w", path, err)
+		}
+		fmt.Printf("Skipped setup; run pres setup to answer the questions later\n\n")
+		return nil
+	}
+	if err := saveSetup(path, answers); err != nil {
+		return err
+	}
+
+	printSetupSummary(path, answers)
+	fmt.Println()
+	return nil
+}
+
+// printSetupSummary shows what setup saved, and warns when the provider
+// still has no API key
+func printSetupSummary(path string, answers map[string]string) {
+	fmt.Printf("✓ Configuration saved to %s\n", path)
+	for _, step := range setupSteps() {
+		value, ok := answers[step.key]
+		switch {
+		case !ok || value == "" && step.choices == nil:
+			continue
+		case step.secret:
+			value = "(saved)"
+		case step.key == "provider" || step.key == "theme":
+			value = step.label(value)
+		}
+		fmt.Printf("  %s: %s\n", step.title, value)
+	}
+
+	if variable := providerKeyEnv(answers["provider"]); variable != "" && answers["api_key"] == "" && os.Getenv(variable) == "" {
+		fmt.Printf("⚠ No API key was saved; set $%s before using AI commands\n", variable)
+	}
+}
+
+// saveSetup writes the setup answers to the config file at path, keeping its
+// other keys. Settings the chosen provider doesn't use are removed, so a
+// model left from another provider doesn't break the config.
+func saveSetup(path string, answers map[string]string) error {
+	config := map[string]any{}
+	raw, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(raw, &config); err != nil {
+		return fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+	if config == nil {
+		config = map[string]any{}
+	}
+
+	for _, step := range setupSteps() {
+		if value := answers[step.key]; value != "" {
+			config[step.key] = value
+		} else {
+			delete(config, step.key)
+		}
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	// The file may hold an API key
+	if err := os.WriteFile(path, out, 0600); err != nil {
+		return fmt.Errorf("failed to write config %s: %w", path, err)
+	}
+	return os.Chmod(path, 0600)
+}
+
+// setupChoice is an answer to pick for a setup question
+type setupChoice struct {
+	value       string
+	description string
+}
+
+// setupStep is a question of the setup wizard, answered with the config key
+// it sets
+type setupStep struct {
+	key      string
+	title    string
+	question string
+	// help explains the question given the answers so far
+	help func(answers map[string]string) string
+	// choices are the answers to pick from; a question without them is
+	// answered with text
+	choices []setupChoice
+	// initial is the answer the question starts with, given the answers so
+	// far: the current setting or a default
+	initial func(answers map[string]string) string
+	// required rejects an empty answer, given the answers so far
+	required func(answers map[string]string) bool
+	// asked reports whether the question applies given the answers so far
+	asked  func(answers map[string]string) bool
+	secret bool
+}
+
+// label returns how a choice is shown
+func (s setupStep) label(value string) string {
+	for _, choice := range s.choices {
+		if choice.value == value {
+			if value == "" {
+				return choice.description
+			}
+			return value
+		}
+	}
+	return value
+}
+
+// setupSteps returns the setup questions, starting from the current settings
+func setupSteps() []setupStep {
+	// current returns a provider setting while the provider is unchanged
+	current := func(answers map[string]string, key string) string {
+		if answers["provider"] == settings.GetString("provider") {
+			return settings.GetString(key)
+		}
+		return ""
+	}
+	always := func(map[string]string) bool { return true }
+	never := func(map[string]string) bool { return false }
+	setting := func(key, fallback string) func(map[string]string) string {
+		return func(map[string]string) string {
+			if value := settings.GetString(key); value != "" {
+				return value
+			}
+			return fallback
+		}
+	}
+
+	providers := []setupChoice{
+		{"", "Claude Sonnet 4, falling back to Claude Opus 4 (default)"},
+		{"anthropic", "Anthropic, with the model you choose"},
+		{"openai", "OpenAI"},
+		{"azure-openai", "An Azure OpenAI deployment"},
+		{"ollama", "Ollama, running locally or on your network, without an API key"},
+		{"openai-generic", "Any OpenAI-compatible endpoint"},
+	}
+	if provider := settings.GetString("provider"); provider != "" && !slices.ContainsFunc(providers, func(c setupChoice) bool { return c.value == provider }) {
+		providers = append(providers, setupChoice{provider, "The client in baml_src/clients.baml"})
+	}
+
+	themes := []setupChoice{{"", "Let the model pick one for each presentation"}}
+	for _, theme := range render.Themes() {
+		themes = append(themes, setupChoice{theme.Name, theme.Description})
+	}
+
+	return []setupStep{
+		{
+			key:      "provider",
+			title:    "Provider",
+			question: "Which AI provider should pres use?",
+			help:     func(map[string]string) string { return "Other clients can be set with provider in the config file" },
+			choices:  providers,
+			initial:  setting("provider", ""),
+			required: never,
+			asked:    always,
+		},
+		{
+			key:      "model",
+			title:    "Model",
+			question: "Which model should it use?",
+			help: func(answers map[string]string) string {
+				if answers["provider"] == "azure-openai" {
+					return "The name of your deployment"
+				}
+				return "Press Enter to keep the model shown"
+			},
+			initial: func(answers map[string]string) string {
+				if model := current(answers, "model"); model != "" {
+					return model
+				}
+				return llmProviders[answers["provider"]].model
+			},
+			required: always,
+			asked: func(answers map[string]string) bool {
+				_, ok := llmProviders[answers["provider"]]
+				return ok
+			},
+		},
+		{
+			key:      "base_url",
+			title:    "Endpoint",
+			question: "What is the provider's endpoint?",
+			help: func(answers map[string]string) string {
+				if answers["provider"] == "azure-openai" {
+					return "e.g. https://<resource>.openai.azure.com/openai; leave empty to use $AZURE_OPENAI_RESOURCE"
+				}
+				return "The base URL of its OpenAI-compatible API, e.g. http://localhost:11434/v1"
+			},
+			initial: func(answers map[string]string) string {
+				if url := current(answers, "base_url"); url != "" {
+					return url
+				}
+				return llmProviders[answers["provider"]].baseURL
+			},
+			required: func(answers map[string]string) bool {
+				return answers["provider"] != "azure-openai" || os.Getenv("AZURE_OPENAI_RESOURCE") == ""
+			},
+			asked: func(answers map[string]string) bool {
+				return slices.Contains([]string{"azure-openai", "ollama", "openai-generic"}, answers["provider"])
+			},
+		},
+		{
+			key:      "api_key",
+			title:    "API key",
+			question: "What is your API key?",
+			help: func(answers map[string]string) string {
+				return fmt.Sprintf("Saved to the config file, readable only by you; leave empty to set $%s instead", providerKeyEnv(answers["provider"]))
+			},
+			initial:  func(answers map[string]string) string { return current(answers, "api_key") },
+			required: never,
+			asked: func(answers map[string]string) bool {
+				variable := providerKeyEnv(answers["provider"])
+				return variable != "" && os.Getenv(variable) == ""
+			},
+			secret: true,
+		},
+		{
+			key:      "author",
+			title:    "Author",
+			question: "Whose name goes on new presentations?",
+			help:     func(map[string]string) string { return "Leave empty to leave it to the model" },
+			initial:  setting("author", defaultAuthor()),
+			required: never,
+			asked:    always,
+		},
+		{
+			key:      "output_dir",
+			title:    "Presentations directory",
+			question: "Where should new presentations be saved?",
+			help:     func(map[string]string) string { return "Used outside a project; a project keeps its own" },
+			initial:  setting("output_dir", "presentations"),
+			required: always,
+			asked:    always,
+		},
+		{
+			key:      "theme",
+			title:    "Theme",
+			question: "Which theme should new presentations use?",
+			help:     func(map[string]string) string { return "Preview them with pres themes --samples" },
+			choices:  themes,
+			initial:  setting("theme", ""),
+			required: never,
+			asked:    always,
+		},
+	}
+}
+
+// defaultAuthor guesses the user's name from git, or their account
+func defaultAuthor() string {
+	if out, err := exec.Command("git", "config", "--get", "user.name").Output(); err == nil {
+		if name := strings.TrimSpace(string(out)); name != "" {
+			return name
+		}
+	}
+	if u, err := user.Current(); err == nil {
+		return u.Name
+	}
+	return ""
+}
+
+// runSetupWizard asks the setup questions, returning the answers by config
+// key, or false when they were cancelled
+func runSetupWizard() (map[string]string, bool, error) {
+	final, err := tea.NewProgram(newSetupWizard(setupSteps())).Run()
+	if err != nil {
+		return nil, false, fmt.Errorf("error running setup: %w", err)
+	}
+	wizard := final.(setupWizard)
+	return wizard.answers, wizard.done, nil
+}
+
+// setupWizard is the bubbletea model asking the setup questions one at a
+// time. Questions that don't apply to the answers so far are left out, and
+// shift+tab goes back to the previous one.
+type setupWizard struct {
+	steps   []setupStep
+	answers map[string]string
+	// previous holds the steps answered before the current one
+	previous []int
+	current  int
+
+	input  []rune
+	cursor int
+	err    string
+	done   bool
+}
+
+// newSetupWizard creates a wizard starting at the first question
+func newSetupWizard(steps []setupStep) setupWizard {
+	w := setupWizard{steps: steps, answers: map[string]string{}}
+	return w.enter(0)
+}
+
+// enter moves to a step, starting from its answer so far
+func (w setupWizard) enter(index int) setupWizard {
+	w.current, w.err = index, ""
+	step := w.steps[index]
+	value, ok := w.answers[step.key]
+	if !ok {
+		value = step.initial(w.answers)
+	}
+	w.input = []rune(value)
+	w.cursor = max(slices.IndexFunc(step.choices, func(c setupChoice) bool { return c.value == value }), 0)
+	return w
+}
+
+func (w setupWizard) Init() tea.Cmd {
+	return nil
+}
+
+func (w setupWizard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return w, nil
+	}
+	step := w.steps[w.current]
+
+	switch key.String() {
+	case "ctrl+c", "esc":
+		return w, tea.Quit
+	case "shift+tab":
+		if len(w.previous) > 0 {
+			index := w.previous[len(w.previous)-1]
+			w.previous = w.previous[:len(w.previous)-1]
+			return w.enter(index), nil
+		}
+		return w, nil
+	case "enter":
+		return w.submit()
+	}
+
+	if step.choices != nil {
+		switch key.String() {
+		case "up", "k":
+			w.cursor = max(w.cursor-1, 0)
+		case "down", "j", "tab":
+			w.cursor = min(w.cursor+1, len(step.choices)-1)
+		}
+		return w, nil
+	}
+
+	switch key.Type {
+	case tea.KeyRunes:
+		w.input = append(w.input, key.Runes...)
+	case tea.KeySpace:
+		w.input = append(w.input, ' ')
+	case tea.KeyBackspace:
+		if len(w.input) > 0 {
+			w.input = w.input[:len(w.input)-1]
+		}
+	case tea.KeyCtrlU:
+		w.input = nil
+	}
+	return w, nil
+}
+
+// submit accepts the current answer and moves to the next question that
+// applies, or finishes
+func (w setupWizard) submit() (tea.Model, tea.Cmd) {
+	step := w.steps[w.current]
+	value := strings.TrimSpace(string(w.input))
+	if step.choices != nil {
+		value = step.choices[w.cursor].value
+	}
+	if value == "" && step.required(w.answers) {
+		w.err = "please provide an answer"
+		return w, nil
+	}
+	w.answers[step.key] = value
+
+	for next := w.current + 1; next < len(w.steps); next++ {
+		if w.steps[next].asked(w.answers) {
+			w.previous = append(w.previous, w.current)
+			return w.enter(next), nil
+		}
+	}
+
+	// Questions left out are dropped, as they don't apply
+	for _, step := range w.steps {
+		if !step.asked(w.answers) {
+			delete(w.answers, step.key)
+		}
+	}
+	w.done = true
+	return w, tea.Quit
+}
+
+func (w setupWizard) View() string {
+	if w.done {
+		return ""
+	}
+	step := w.steps[w.current]
+
+	total := 0
+	for _, s := range w.steps {
+		if s.asked(w.answers) {
+			total++
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(tui.TitleStyle.Render("pres setup"))
+	b.WriteString("\n\n")
+	b.WriteString(tui.HelpStyle.Render(fmt.Sprintf("%s · question %d of %d", step.title, len(w.previous)+1, total)))
+	b.WriteString("\n\n")
+	b.WriteString(tui.QuestionStyle.Render(step.question))
+	b.WriteString("\n")
+	if help := step.help(w.answers); help != "" {
+		b.WriteString(tui.HelpStyle.Render(help))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+
+	if step.choices != nil {
+		for i, choice := range step.choices {
+			line := choice.description
+			if choice.value != "" {
+				line = fmt.Sprintf("%-15s %s", choice.value, choice.description)
+			}
+			if i == w.cursor {
+				b.WriteString(tui.InputStyle.Bold(true).Render("› " + line))
+			} else {
+				b.WriteString("  " + line)
+			}
+			b.WriteString("\n")
+		}
+	} else {
+		value := string(w.input)
+		if step.secret {
+			value = strings.Repeat("•", len(w.input))
+		}
+		b.WriteString(tui.InputStyle.Render("> " + value + "█"))
+		b.WriteString("\n")
+	}
+
+	if w.err != "" {
+		b.WriteString("\n")
+		b.WriteString(tui.ErrorStyle.Render("⚠ " + w.err))
+		b.WriteString("\n")
+	}
+
+	hints := "Enter to continue"
+	if step.choices != nil {
+		hints = "↑/↓ to select • " + hints
+	} else {
+		hints += " • Ctrl+U to clear"
+	}
+	if len(w.previous) > 0 {
+		hints += " • Shift+Tab to go back"
+	}
+	b.WriteString("\n")
+	b.WriteString(tui.HelpStyle.Render(hints + " • Esc to cancel"))
+	return b.String()
+}
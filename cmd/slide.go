@@ -0,0 +1,444 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	slidePath     string
+	slideNumber   int
+	slideRevision int
+)
+
+var slideCmd = &cobra.Command{
+	Use:   "slide",
+	Short: "Manage individual slides",
+	Long: `Manage individual slides in a presentation.
+
+Hidden slides are backup slides: they are left out of the main flow and
+placed in an appendix after the last slide of the generated deck, where they
+can be reached by navigating past the end or through links.
+
+A slide with a when condition is only included in generated output when the
+condition holds for the deck's variables, so one master deck can produce
+variants per audience (see pres generate --set). Conditions support variable
+names, "quoted" strings, ==, !=, &&, ||, ! and parentheses.
+
+Time budgets set how long each slide should take. The speaker view (press S
+in the generated deck) compares them with the elapsed time and shows when you
+are running behind; slides without a budget are estimated from the length of
+their speaker notes.
+
+Page-break hints control how the deck prints from a browser ("Print to PDF"):
+every slide starts a new page unless it is marked continue (printed below
+the previous slide) or skip (left out of printouts).
+
+Locked slides cannot be modified or deleted by pres update, which protects
+slides such as legal disclaimers from being rewritten by AI. The update
+prompt is told to leave them alone, and operations on them are rejected.
+
+Tags mark the topics of slides, so subsets of a modular deck can be
+generated with --include-tags and --exclude-tags (see pres generate).
+
+Every change to a slide's content keeps the version it replaced, so one
+slide can be reverted without rolling back the rest of the deck. The last
+20 versions of each slide are kept in the presentation file.
+
+Examples:
+  pres slide hide --path presentations/my-talk.json 12 13
+  pres slide show --path presentations/my-talk.json 12
+  pres slide lock --path presentations/my-talk.json 2 24
+  pres slide unlock --path presentations/my-talk.json 24
+  pres slide when --path presentations/master.json 7 'region == "EU"'
+  pres slide when --path presentations/master.json 7 ""
+  pres slide budget --path presentations/my-talk.json 1m30s 4
+  pres slide budget --path presentations/my-talk.json auto 4
+  pres slide page-break --path presentations/my-talk.json continue 5 6
+  pres slide page-break --path presentations/my-talk.json skip 1
+  pres slide tag --path presentations/my-talk.json demo,metrics 8 9
+  pres slide untag --path presentations/my-talk.json metrics 9
+  pres slide history --path presentations/my-talk.json --slide 5
+  pres slide revert --path presentations/my-talk.json --slide 5 --to 2`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var slideHideCmd = &cobra.Command{
+	Use:   "hide [slide]...",
+	Short: "Hide slides, turning them into backup slides",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSlideHide,
+}
+
+var slideShowCmd = &cobra.Command{
+	Use:   "show [slide]...",
+	Short: "Return hidden slides to the main flow",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSlideShow,
+}
+
+var slideLockCmd = &cobra.Command{
+	Use:   "lock [slide]...",
+	Short: "Lock slides so updates cannot modify or delete them",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSlideLock,
+}
+
+var slideUnlockCmd = &cobra.Command{
+	Use:   "unlock [slide]...",
+	Short: "Unlock slides so updates can change them again",
+	Args:  cobra.MinimumNArgs(1),
+	RunE:  runSlideUnlock,
+}
+
+var slideWhenCmd = &cobra.Command{
+	Use:   "when [slide] [condition]",
+	Short: "Set the condition under which a slide is included",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runSlideWhen,
+}
+
+var slideBudgetCmd = &cobra.Command{
+	Use:   "budget [duration|auto] [slide]...",
+	Short: "Set how long slides should take to present",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runSlideBudget,
+}
+
+var slidePageBreakCmd = &cobra.Command{
+	Use:   "page-break [page|continue|skip] [slide]...",
+	Short: "Set how slides break across pages when printed",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runSlidePageBreak,
+}
+
+var slideTagCmd = &cobra.Command{
+	Use:   "tag [tags] [slide]...",
+	Short: "Add comma-separated tags to slides",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runSlideTag,
+}
+
+var slideUntagCmd = &cobra.Command{
+	Use:   "untag [tags] [slide]...",
+	Short: "Remove comma-separated tags from slides",
+	Args:  cobra.MinimumNArgs(2),
+	RunE:  runSlideUntag,
+}
+
+var slideHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List the earlier versions of a slide",
+	Args:  cobra.NoArgs,
+	RunE:  runSlideHistory,
+}
+
+var slideRevertCmd = &cobra.Command{
+	Use:   "revert",
+	Short: "Revert a slide to an earlier version",
+	Long: `Revert one slide to an earlier version, leaving the rest of the deck as it
+is. The version it replaces is kept, so a revert can itself be reverted, and
+the change is recorded in the audit log. Locked slides cannot be reverted.
+
+List a slide's versions with pres slide history.`,
+	Args: cobra.NoArgs,
+	RunE: runSlideRevert,
+}
+
+func init() {
+	rootCmd.AddCommand(slideCmd)
+	slideCmd.AddCommand(slideHideCmd)
+	slideCmd.AddCommand(slideShowCmd)
+	slideCmd.AddCommand(slideLockCmd)
+	slideCmd.AddCommand(slideUnlockCmd)
+	slideCmd.AddCommand(slideWhenCmd)
+	slideCmd.AddCommand(slideBudgetCmd)
+	slideCmd.AddCommand(slidePageBreakCmd)
+	slideCmd.AddCommand(slideTagCmd)
+	slideCmd.AddCommand(slideUntagCmd)
+	slideCmd.AddCommand(slideHistoryCmd)
+	slideCmd.AddCommand(slideRevertCmd)
+
+	for _, c := range []*cobra.Command{slideHideCmd, slideShowCmd, slideLockCmd, slideUnlockCmd, slideWhenCmd, slideBudgetCmd, slidePageBreakCmd, slideTagCmd, slideUntagCmd, slideHistoryCmd, slideRevertCmd} {
+		c.Flags().StringVarP(&slidePath, "path", "p", "", "Path to presentation JSON file (required)")
+		c.MarkFlagRequired("path")
+	}
+	for _, c := range []*cobra.Command{slideHistoryCmd, slideRevertCmd} {
+		c.Flags().IntVarP(&slideNumber, "slide", "s", 0, "Slide number (required)")
+		c.MarkFlagRequired("slide")
+	}
+	slideRevertCmd.Flags().IntVar(&slideRevision, "to", 0, "Revision to revert to (required)")
+	slideRevertCmd.MarkFlagRequired("to")
+}
+
+// parseSlideNumbers converts 1-based slide number arguments to indexes
+func parseSlideNumbers(args []string) ([]int, error) {
+	indexes := make([]int, 0, len(args))
+	for _, arg := range args {
+		number, err := strconv.Atoi(arg)
+		if err != nil || number < 1 {
+			return nil, fmt.Errorf("invalid slide number %q", arg)
+		}
+		indexes = append(indexes, number-1)
+	}
+	return indexes, nil
+}
+
+// parseSlideRange converts a list of 1-based slide numbers and ranges, such
+// as "1-5,8", to indexes
+func parseSlideRange(spec string) ([]int, error) {
+	var indexes []int
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		first, last, isRange := strings.Cut(part, "-")
+		if !isRange {
+			last = first
+		}
+		start, err := strconv.Atoi(strings.TrimSpace(first))
+		end, err2 := strconv.Atoi(strings.TrimSpace(last))
+		if err != nil || err2 != nil || start < 1 || end < start {
+			return nil, fmt.Errorf("invalid slide range %q", part)
+		}
+		for number := start; number <= end; number++ {
+			indexes = append(indexes, number-1)
+		}
+	}
+	return indexes, nil
+}
+
+func runSlideHide(cmd *cobra.Command, args []string) error {
+	return setSlidesHidden(args, true)
+}
+
+func runSlideShow(cmd *cobra.Command, args []string) error {
+	return setSlidesHidden(args, false)
+}
+
+// setSlidesHidden hides or shows the slides numbered in args
+func setSlidesHidden(args []string, hidden bool) error {
+	indexes, err := parseSlideNumbers(args)
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.SetHidden(slidePath, indexes, hidden)
+	if err != nil {
+		return fmt.Errorf("failed to update slides: %w", err)
+	}
+
+	action := "Hid"
+	if !hidden {
+		action = "Showed"
+	}
+	for _, index := range indexes {
+		fmt.Printf("✓ %s slide %d: %s\n", action, index+1, data.Slides[index].Title)
+	}
+
+	return nil
+}
+
+func runSlideLock(cmd *cobra.Command, args []string) error {
+	return setSlidesLocked(args, true)
+}
+
+func runSlideUnlock(cmd *cobra.Command, args []string) error {
+	return setSlidesLocked(args, false)
+}
+
+// setSlidesLocked locks or unlocks the slides numbered in args
+func setSlidesLocked(args []string, locked bool) error {
+	indexes, err := parseSlideNumbers(args)
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.SetLocked(slidePath, indexes, locked)
+	if err != nil {
+		return fmt.Errorf("failed to update slides: %w", err)
+	}
+
+	action := "Locked"
+	if !locked {
+		action = "Unlocked"
+	}
+	for _, index := range indexes {
+		fmt.Printf("✓ %s slide %d: %s\n", action, index+1, data.Slides[index].Title)
+	}
+
+	return nil
+}
+
+func runSlideWhen(cmd *cobra.Command, args []string) error {
+	indexes, err := parseSlideNumbers(args[:1])
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.SetWhen(slidePath, indexes[0], args[1])
+	if err != nil {
+		return fmt.Errorf("failed to update slide: %w", err)
+	}
+
+	slide := data.Slides[indexes[0]]
+	if slide.When == "" {
+		fmt.Printf("✓ Slide %d (%s) is always included\n", indexes[0]+1, slide.Title)
+	} else {
+		fmt.Printf("✓ Slide %d (%s) is included when: %s\n", indexes[0]+1, slide.Title, slide.When)
+	}
+
+	return nil
+}
+
+func runSlideBudget(cmd *cobra.Command, args []string) error {
+	budget, err := presentation.ParseTimeBudget(args[0])
+	if err != nil {
+		return err
+	}
+
+	indexes, err := parseSlideNumbers(args[1:])
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.SetTimeBudget(slidePath, indexes, budget)
+	if err != nil {
+		return fmt.Errorf("failed to update slides: %w", err)
+	}
+
+	for _, index := range indexes {
+		slide := data.Slides[index]
+		if budget == 0 {
+			fmt.Printf("✓ Slide %d (%s) time budget: estimated %s\n", index+1, slide.Title, presentation.FormatClock(slide.GetTimeBudget()))
+		} else {
+			fmt.Printf("✓ Slide %d (%s) time budget: %s\n", index+1, slide.Title, presentation.FormatClock(budget))
+		}
+	}
+	fmt.Printf("  Total: %s\n", presentation.FormatClock(data.TotalTimeBudget()))
+
+	return nil
+}
+
+func runSlidePageBreak(cmd *cobra.Command, args []string) error {
+	hint, err := presentation.ParsePageBreak(args[0])
+	if err != nil {
+		return err
+	}
+
+	indexes, err := parseSlideNumbers(args[1:])
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.SetPageBreak(slidePath, indexes, hint)
+	if err != nil {
+		return fmt.Errorf("failed to update slides: %w", err)
+	}
+
+	for _, index := range indexes {
+		fmt.Printf("✓ Slide %d (%s) page break: %s\n", index+1, data.Slides[index].Title, hint)
+	}
+
+	return nil
+}
+
+func runSlideTag(cmd *cobra.Command, args []string) error {
+	return tagSlides(args, false)
+}
+
+func runSlideUntag(cmd *cobra.Command, args []string) error {
+	return tagSlides(args, true)
+}
+
+// tagSlides adds or removes the tags in args[0] on the slides numbered in
+// the remaining args
+func tagSlides(args []string, remove bool) error {
+	tags, err := presentation.ParseTags(args[0])
+	if err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return fmt.Errorf("no tags given")
+	}
+
+	indexes, err := parseSlideNumbers(args[1:])
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.TagSlides(slidePath, indexes, tags, remove)
+	if err != nil {
+		return fmt.Errorf("failed to update slides: %w", err)
+	}
+
+	for _, index := range indexes {
+		slide := data.Slides[index]
+		current := "none"
+		if len(slide.Tags) > 0 {
+			current = strings.Join(slide.Tags, ", ")
+		}
+		fmt.Printf("✓ Slide %d (%s) tags: %s\n", index+1, slide.Title, current)
+	}
+
+	return nil
+}
+
+func runSlideHistory(cmd *cobra.Command, args []string) error {
+	data, err := newWriter().LoadPresentation(slidePath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	if slideNumber < 1 || slideNumber > len(data.Slides) {
+		return fmt.Errorf("slide %d does not exist (presentation has %d slides)", slideNumber, len(data.Slides))
+	}
+	slide := data.Slides[slideNumber-1]
+
+	fmt.Printf("🕘 History of slide %d: %s\n\n", slideNumber, slide.Title)
+	for _, revision := range slide.History {
+		fmt.Printf("  r%-3d %q\n", revision.Revision, revision.Slide.Title)
+		fmt.Printf("        replaced %s by %s\n", revision.Replaced.Local().Format("2006-01-02 15:04"), revision.Source)
+	}
+	fmt.Printf("  r%-3d %q (current)\n", slide.CurrentRevision(), slide.Title)
+
+	if len(slide.History) == 0 {
+		fmt.Printf("\nThe slide has not been changed since it was created.\n")
+	} else {
+		fmt.Printf("\nRevert: pres slide revert --path %s --slide %d --to <revision>\n", slidePath, slideNumber)
+	}
+	return nil
+}
+
+func runSlideRevert(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	writer.SetAudit("pres slide revert", auditActor())
+	// The slide is reverted by ID, so it needs one
+	data, err := writer.EnsureSlideIDs(slidePath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+	if slideNumber < 1 || slideNumber > len(data.Slides) {
+		return fmt.Errorf("slide %d does not exist (presentation has %d slides)", slideNumber, len(data.Slides))
+	}
+	slide := data.Slides[slideNumber-1]
+
+	data, err = writer.RevertSlide(slidePath, slide.ID, slideRevision)
+	if err != nil {
+		return fmt.Errorf("failed to revert slide: %w", err)
+	}
+
+	reverted := data.Slides[slideNumber-1]
+	fmt.Printf("✓ Reverted slide %d to revision %d: %s\n", slideNumber, slideRevision, reverted.Title)
+	fmt.Printf("  The replaced version is kept as revision %d\n", reverted.History[len(reverted.History)-1].Revision)
+	return nil
+}
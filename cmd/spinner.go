@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/internal/tui"
+)
+
+// errStopped is returned by withSpinner when the call is stopped with Ctrl+C
+var errStopped = errors.New("stopped")
+
+// withSpinner runs a slow call, such as an AI call, showing a spinner, the
+// message, and the time taken so far until it returns, so it's clear pres
+// hasn't hung. Lines the call prints with println appear above the spinner.
+// Ctrl+C cancels the call's context; a call that returns because of it
+// returns errStopped. Outside a terminal the message is printed once and the
+// call runs as it is.
+func withSpinner[T any](ctx context.Context, message string, call func(ctx context.Context, println func(string)) (T, error)) (T, error) {
+	if info, err := os.Stdout.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		fmt.Println(message)
+		return call(ctx, func(line string) { fmt.Println(line) })
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	program := tea.NewProgram(newSpinnerModel(message, cancel))
+	var result T
+	var err error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		result, err = call(ctx, func(line string) { program.Println(line) })
+		program.Send(spinnerDoneMsg{})
+	}()
+
+	if _, runErr := program.Run(); runErr != nil {
+		cancel()
+		<-done
+		var zero T
+		return zero, fmt.Errorf("error running spinner: %w", runErr)
+	}
+	<-done
+	if err != nil && ctx.Err() != nil {
+		return result, errStopped
+	}
+	return result, err
+}
+
+// spinnerDoneMsg tells the spinner the call has returned
+type spinnerDoneMsg struct{}
+
+// spinnerModel is the bubbletea model showing a call in progress
+type spinnerModel struct {
+	spinner  spinner.Model
+	message  string
+	started  time.Time
+	cancel   context.CancelFunc
+	stopping bool
+	done     bool
+}
+
+// newSpinnerModel creates a spinner for a call that cancel stops
+func newSpinnerModel(message string, cancel context.CancelFunc) spinnerModel {
+	s := spinner.New(spinner.WithSpinner(spinner.Dot), spinner.WithStyle(tui.InputStyle))
+	return spinnerModel{spinner: s, message: message, started: time.Now(), cancel: cancel}
+}
+
+func (m spinnerModel) Init() tea.Cmd {
+	return m.spinner.Tick
+}
+
+func (m spinnerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case spinnerDoneMsg:
+		m.done = true
+		return m, tea.Quit
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.stopping = true
+			m.cancel()
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.spinner, cmd = m.spinner.Update(msg)
+	return m, cmd
+}
+
+func (m spinnerModel) View() string {
+	if m.done {
+		return ""
+	}
+	message := m.message
+	if m.stopping {
+		message = "Stopping..."
+	}
+	elapsed := time.Since(m.started).Truncate(time.Second)
+	return fmt.Sprintf("%s %s %s\n", m.spinner.View(), message, tui.HelpStyle.Render(elapsed.String()))
+}
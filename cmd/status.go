@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statusPath string
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show or change the review status of a presentation",
+	Long: `Show or change where a presentation is in the review workflow.
+
+Presentations move through the following states:
+  draft      - Work in progress (default for new presentations)
+  in-review  - Shared with reviewers for feedback
+  approved   - Signed off and ready to be shared externally
+  delivered  - Presented to its audience
+
+Only approved or delivered presentations can be published with pres publish.
+
+Examples:
+  pres status show --path presentations/my-talk.json
+  pres status set --path presentations/my-talk.json in-review
+  pres status set --path presentations/my-talk.json approved`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var statusShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the review status of a presentation",
+	Args:  cobra.NoArgs,
+	RunE:  runStatusShow,
+}
+
+var statusSetCmd = &cobra.Command{
+	Use:   "set [status]",
+	Short: "Set the review status of a presentation",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runStatusSet,
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	statusCmd.AddCommand(statusShowCmd)
+	statusCmd.AddCommand(statusSetCmd)
+
+	for _, c := range []*cobra.Command{statusShowCmd, statusSetCmd} {
+		c.Flags().StringVarP(&statusPath, "path", "p", "", "Path to presentation JSON file (required)")
+		c.MarkFlagRequired("path")
+	}
+}
+
+func runStatusShow(cmd *cobra.Command, args []string) error {
+	writer := newWriter()
+	data, err := writer.LoadPresentation(statusPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	fmt.Printf("%s: %s\n", data.Metadata.Title, data.Metadata.GetStatus())
+
+	return nil
+}
+
+func runStatusSet(cmd *cobra.Command, args []string) error {
+	status, err := presentation.ParseStatus(args[0])
+	if err != nil {
+		return err
+	}
+
+	writer := newWriter()
+	data, err := writer.SetStatus(statusPath, status)
+	if err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	fmt.Printf("✓ %s is now %s\n", data.Metadata.Title, data.Metadata.GetStatus())
+
+	return nil
+}
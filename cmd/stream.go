@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/geoffjay/pres/baml_client/stream_types"
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// slideProgress prints a presentation's title and slides as they are
+// streamed from the model, one line each, with println. A field is only
+// printed once the model has moved past it, so lines are never printed
+// half-written.
+type slideProgress struct {
+	println func(string)
+	titled  bool
+	slides  int
+}
+
+// update prints what a partial presentation has completed since the last
+// update
+func (p *slideProgress) update(partial stream_types.Presentation) {
+	// The title is written before the slides
+	if !p.titled && len(partial.Slides) > 0 && partial.Title != nil {
+		p.println(fmt.Sprintf("  📊 %s", *partial.Title))
+		p.titled = true
+	}
+	// A slide is complete once the next one has started
+	for p.slides < len(partial.Slides)-1 {
+		slide := partial.Slides[p.slides]
+		p.slides++
+		p.printSlide(stringValue(slide.Title), stringValue(slide.Layout))
+	}
+}
+
+// finish prints the slides of the final presentation not yet printed
+func (p *slideProgress) finish(final types.Presentation) {
+	if !p.titled {
+		p.println(fmt.Sprintf("  📊 %s", final.Title))
+		p.titled = true
+	}
+	for p.slides < len(final.Slides) {
+		slide := final.Slides[p.slides]
+		p.slides++
+		p.printSlide(slide.Title, slide.Layout)
+	}
+}
+
+// printSlide prints the line for the slide just completed
+func (p *slideProgress) printSlide(title, layout string) {
+	if title == "" {
+		title = "(untitled)"
+	}
+	p.println(fmt.Sprintf("  %2d. %s [%s]", p.slides, title, layout))
+}
+
+// stringValue returns the value of a streamed field, empty until it starts
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/geoffjay/pres/internal/telemetry"
+	"github.com/spf13/cobra"
+)
+
+// telemetryFlushTimeout bounds how long sending queued events may delay a
+// command's exit
+const telemetryFlushTimeout = 2 * time.Second
+
+var telemetryCmd = &cobra.Command{
+	Use:   "telemetry",
+	Short: "Show or change whether anonymous usage metrics are sent",
+	Long: `Show whether pres sends anonymous usage metrics, which help the
+maintainers decide what to work on. Telemetry is off unless you turn it on
+with pres telemetry on.
+
+When on, each command run records only:
+  - the command, e.g. "pres slide tag", without arguments or flag values
+  - how long it took, and whether it succeeded
+  - the pres version, operating system, and architecture
+  - a random ID created when telemetry is turned on, and the hour it ran
+
+File names, presentation content, prompts, and AI responses are never
+recorded. Events are queued in your config directory, where you can read
+them, and sent in batches. pres telemetry off deletes the queue and the ID.
+
+Setting DO_NOT_TRACK=1 or PRES_TELEMETRY=off turns telemetry off whatever
+was chosen here.
+
+Examples:
+  pres telemetry
+  pres telemetry on
+  pres telemetry off`,
+	Args: cobra.NoArgs,
+	RunE: runTelemetry,
+}
+
+var telemetryOnCmd = &cobra.Command{
+	Use:   "on",
+	Short: "Send anonymous usage metrics",
+	Args:  cobra.NoArgs,
+	RunE:  runTelemetryOn,
+}
+
+var telemetryOffCmd = &cobra.Command{
+	Use:   "off",
+	Short: "Stop sending usage metrics and delete any not sent yet",
+	Args:  cobra.NoArgs,
+	RunE:  runTelemetryOff,
+}
+
+func init() {
+	rootCmd.AddCommand(telemetryCmd)
+	telemetryCmd.AddCommand(telemetryOnCmd)
+	telemetryCmd.AddCommand(telemetryOffCmd)
+}
+
+// newRecorder creates a telemetry recorder keeping its files next to the
+// user's config file
+func newRecorder() (*telemetry.Recorder, error) {
+	dir, err := userConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return telemetry.NewRecorder(dir), nil
+}
+
+func runTelemetry(cmd *cobra.Command, args []string) error {
+	recorder, err := newRecorder()
+	if err != nil {
+		return err
+	}
+	consent, err := recorder.Consent()
+	if err != nil {
+		return err
+	}
+
+	switch variable := telemetry.DisabledByEnv(); {
+	case variable != "":
+		fmt.Printf("Telemetry is off: %s is set\n", variable)
+	case consent.Enabled:
+		fmt.Printf("✓ Telemetry is on (since %s)\n", consent.Changed.Format("2006-01-02"))
+	default:
+		fmt.Printf("Telemetry is off\n")
+	}
+
+	events, err := recorder.Pending()
+	if err != nil {
+		return err
+	}
+	if len(events) > 0 {
+		fmt.Printf("  %d events waiting to be sent: %s\n", len(events), recorder.QueuePath())
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	if consent.Enabled {
+		fmt.Printf("  • Turn it off: pres telemetry off\n")
+	} else {
+		fmt.Printf("  • Turn it on: pres telemetry on\n")
+	}
+	fmt.Printf("  • See what is recorded: pres telemetry --help\n")
+
+	return nil
+}
+
+func runTelemetryOn(cmd *cobra.Command, args []string) error {
+	recorder, err := newRecorder()
+	if err != nil {
+		return err
+	}
+	if _, err := recorder.Enable(); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Telemetry turned on. Thank you!\n")
+	fmt.Printf("  Only the command, its duration, and whether it succeeded are recorded; see pres telemetry --help\n")
+	if variable := telemetry.DisabledByEnv(); variable != "" {
+		fmt.Printf("⚠ %s is set, so nothing is recorded until it is unset\n", variable)
+	}
+	return nil
+}
+
+func runTelemetryOff(cmd *cobra.Command, args []string) error {
+	recorder, err := newRecorder()
+	if err != nil {
+		return err
+	}
+	if err := recorder.Disable(); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Telemetry turned off; events not sent yet were deleted\n")
+	return nil
+}
+
+// recordUsage queues a telemetry event for the command that ran, and sends
+// the queue when it is due. Telemetry never fails or noticeably slows a
+// command, so errors are ignored.
+func recordUsage(cmd *cobra.Command, duration time.Duration, success bool) {
+	if !isUserCommand(cmd) {
+		return
+	}
+	recorder, err := newRecorder()
+	if err != nil {
+		return
+	}
+	if recorder.Record(cmd.CommandPath(), duration, success) != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), telemetryFlushTimeout)
+	defer cancel()
+	recorder.Flush(ctx)
+}
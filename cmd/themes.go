@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	themesPath    string
+	themesSamples bool
+)
+
+// sampleSlides are previewed in each theme when there is no deck to preview
+var sampleSlides = []presentation.Slide{
+	{Slide: types.Slide{Title: "Sample Presentation", Layout: "title", Content: "A preview of the theme"}},
+	{Slide: types.Slide{Title: "Why it matters", Layout: "content", Content: "- Headings and text in the theme's colors\n- Lists, like this one\n  - Nested points\n- Code and quotes in the same palette"}},
+}
+
+var themesCmd = &cobra.Command{
+	Use:   "themes",
+	Short: "List the reveal.js themes",
+	Long: `List the reveal.js themes a presentation can use, with a swatch of each
+theme's colors. With --samples, a sample slide is shown in each theme. The
+terminal colors approximate the themes; fonts and backgrounds with gradients
+are only shown in the generated deck.
+
+With --path, the presentation's current theme is marked. Pick a theme with a
+live preview of the deck's own slides with pres themes preview.
+
+Examples:
+  pres themes
+  pres themes --samples
+  pres themes --path presentations/my-talk.json`,
+	Args: cobra.NoArgs,
+	RunE: runThemes,
+}
+
+var themesPreviewCmd = &cobra.Command{
+	Use:   "preview",
+	Short: "Pick a theme with a live preview of the slides",
+	Long: `Pick a presentation's theme interactively. The themes are listed beside a
+preview of the deck's slides in the colors of the theme under the cursor.
+
+Keys:
+  ↑, ↓    move between themes
+  ←, →    move between the slides previewed
+  enter   choose the theme
+  q, esc  cancel
+
+With --path, the chosen theme is saved to the presentation's metadata and
+recorded in the audit log. Without it, sample slides are previewed and the
+chosen theme is printed.
+
+Examples:
+  pres themes preview --path presentations/my-talk.json
+  pres themes preview`,
+	Args: cobra.NoArgs,
+	RunE: runThemesPreview,
+}
+
+func init() {
+	rootCmd.AddCommand(themesCmd)
+	themesCmd.AddCommand(themesPreviewCmd)
+
+	themesCmd.Flags().StringVarP(&themesPath, "path", "p", "", "Path to presentation JSON file whose theme to mark")
+	themesCmd.Flags().BoolVar(&themesSamples, "samples", false, "Show a sample slide in each theme")
+
+	themesPreviewCmd.Flags().StringVarP(&themesPath, "path", "p", "", "Path to presentation JSON file to preview and save the theme to")
+}
+
+func runThemes(cmd *cobra.Command, args []string) error {
+	current := ""
+	if themesPath != "" {
+		data, err := newWriter().LoadPresentation(themesPath)
+		if err != nil {
+			return fmt.Errorf("failed to load presentation: %w", err)
+		}
+		current = data.Metadata.Theme
+	}
+
+	fmt.Printf("🎨 reveal.js themes\n\n")
+	for _, theme := range render.Themes() {
+		marker := " "
+		if theme.Name == current {
+			marker = "✓"
+		}
+		fmt.Printf("%s %-10s %s  %s\n", marker, theme.Name, theme.Swatch(), theme.Description)
+		if themesSamples {
+			fmt.Printf("\n%s\n\n", render.ThemedSlide(sampleSlides[1], theme, render.Options{Width: 48, MaxLines: 4}))
+		}
+	}
+
+	if current != "" {
+		fmt.Printf("\n%s uses %s\n", themesPath, current)
+	}
+	fmt.Printf("\nPick one with a live preview: pres themes preview --path <presentation>\n")
+	return nil
+}
+
+func runThemesPreview(cmd *cobra.Command, args []string) error {
+	slides, current := sampleSlides, ""
+	var writer *presentation.Writer
+	if themesPath != "" {
+		writer = newWriter()
+		writer.SetAudit("pres themes preview", auditActor())
+		data, err := writer.LoadPresentation(themesPath)
+		if err != nil {
+			return fmt.Errorf("failed to load presentation: %w", err)
+		}
+		current = data.Metadata.Theme
+
+		// Shared slides and variables are previewed as they are presented
+		resolved, err := presentation.NewGenerator().ResolveReferences(data, themesPath)
+		if err != nil {
+			return fmt.Errorf("failed to resolve slide references: %w", err)
+		}
+		if resolved = resolved.ExpandVariables(); len(resolved.Slides) > 0 {
+			slides = resolved.Slides
+		}
+	}
+
+	final, err := tea.NewProgram(render.NewThemePicker(slides, current), tea.WithAltScreen()).Run()
+	if err != nil {
+		return fmt.Errorf("error running theme picker: %w", err)
+	}
+	chosen := final.(render.ThemePicker).Chosen()
+
+	switch {
+	case chosen == "":
+		fmt.Println("✗ No theme chosen; nothing was changed")
+	case writer == nil:
+		fmt.Printf("🎨 Chose %s\n", chosen)
+		fmt.Printf("  Apply it with: pres meta set --match <presentation> --theme %s\n", chosen)
+	case chosen == current:
+		fmt.Printf("✓ %s already uses %s\n", themesPath, chosen)
+	default:
+		update := types.PresentationUpdate{
+			Operation:        "update_metadata",
+			Metadata_updates: map[string]string{"theme": chosen},
+			Rationale:        "Theme chosen with a preview",
+		}
+		if _, err := writer.UpdatePresentation(themesPath, []types.PresentationUpdate{update}, false); err != nil {
+			return fmt.Errorf("failed to save theme: %w", err)
+		}
+		fmt.Printf("✓ Theme changed: %s → %s\n", current, chosen)
+		fmt.Printf("  Generate HTML: pres generate --path %s\n", themesPath)
+	}
+	return nil
+}
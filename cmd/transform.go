@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	transformPath       string
+	transformSlides     string
+	transformMinBullets int
+	transformResume     bool
+	transformRetry      bool
+	transformRestart    bool
+)
+
+var transformCmd = &cobra.Command{
+	Use:   "transform",
+	Short: "Rewrite slides in another style with AI",
+	Long: `Rewrite the slides of a presentation in another style with AI, one slide at
+a time, with the rest of the deck as context.
+
+Use one of the subcommands to choose the style.`,
+}
+
+var transformAssertionEvidenceCmd = &cobra.Command{
+	Use:   "assertion-evidence",
+	Short: "Convert bullet-heavy slides to the assertion-evidence style",
+	Long: `Convert bullet-heavy slides to the assertion-evidence style: a headline that
+states the slide's takeaway as a full sentence, supported by a single piece of
+visual evidence (a diagram, chart, image, code sample, or short table) rather
+than a list. The detail the bullets carried moves to the speaker notes.
+
+Content and two-column slides with at least --min-bullets bullets are
+converted; with --slides, only the chosen slides among them. Hidden slides,
+locked slides, and slides shared from other decks are skipped.
+
+Each slide is saved as soon as it is converted, and the progress of the pass
+is kept in the deck's .pres directory, so an interrupted pass can be
+continued with --resume or discarded with --restart. A slide that can't be
+converted is left unchanged and marked as failed, and the pass moves on;
+--retry-failed tries the failed slides again.
+
+Examples:
+  pres transform assertion-evidence --path presentations/my-talk.json
+  pres transform assertion-evidence --path presentations/my-talk.json --slides 3-8 --min-bullets 3
+  pres transform assertion-evidence --path presentations/my-talk.json --resume`,
+	Args: cobra.NoArgs,
+	RunE: runTransformAssertionEvidence,
+}
+
+func init() {
+	rootCmd.AddCommand(transformCmd)
+	transformCmd.AddCommand(transformAssertionEvidenceCmd)
+
+	transformAssertionEvidenceCmd.Flags().StringVarP(&transformPath, "path", "p", "", "Path to presentation JSON file (required)")
+	transformAssertionEvidenceCmd.Flags().StringVar(&transformSlides, "slides", "", "Slides to consider, e.g. 1-5 or 1,3,5-7 (default: every slide)")
+	transformAssertionEvidenceCmd.Flags().IntVar(&transformMinBullets, "min-bullets", 4, "Fewest bullets a slide needs to be converted")
+	transformAssertionEvidenceCmd.Flags().BoolVar(&transformResume, "resume", false, "Continue an interrupted pass")
+	transformAssertionEvidenceCmd.Flags().BoolVar(&transformRetry, "retry-failed", false, "Continue an interrupted pass and retry the slides it failed on")
+	transformAssertionEvidenceCmd.Flags().BoolVar(&transformRestart, "restart", false, "Discard an interrupted pass and start over")
+	transformAssertionEvidenceCmd.MarkFlagRequired("path")
+	transformAssertionEvidenceCmd.MarkFlagsMutuallyExclusive("resume", "retry-failed", "restart")
+}
+
+func runTransformAssertionEvidence(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	const pass = "assertion-evidence"
+
+	if transformMinBullets < 1 {
+		return fmt.Errorf("--min-bullets must be at least 1")
+	}
+
+	writer := newWriter()
+	writer.SetAudit("pres transform assertion-evidence", auditActor())
+
+	data, err := writer.LoadPresentation(transformPath)
+	if err != nil {
+		return fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	state, err := presentation.LoadPassState(transformPath, pass)
+	if err != nil {
+		return err
+	}
+	if state != nil && transformRestart {
+		if err := state.Remove(transformPath); err != nil {
+			return err
+		}
+		state = nil
+	}
+
+	slides, err := bulletSlides(data)
+	if err != nil {
+		return err
+	}
+	if state == nil && len(slides) == 0 && !transformResume && !transformRetry {
+		fmt.Printf("✓ No selected slide has %d or more bullets\n", transformMinBullets)
+		return nil
+	}
+
+	session, err := newAISession("pres transform assertion-evidence", "", "")
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔁 Converting to assertion-evidence: %s\n\n", data.Metadata.Title)
+
+	run := slidePass{
+		name:        pass,
+		path:        transformPath,
+		slides:      slides,
+		resume:      transformResume,
+		retryFailed: transformRetry,
+		step: func(ctx context.Context, data *presentation.PresentationData, index int) error {
+			deck := data.BuildContext([]int{index}, 0, presentation.DefaultContextBudget)
+			slide, err := convertToAssertionEvidence(ctx, deck.Text, int64(index), session.option())
+			if err != nil {
+				return err
+			}
+			slide.Layout = "assertion-evidence"
+			writer.AddProvenance(session.collect()...)
+			_, err = writer.RewriteSlide(transformPath, data.Slides[index].ID, slide)
+			return err
+		},
+	}
+	if err := run.run(ctx); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n✓ Slides converted\n")
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the changes: pres audit --path %s\n", transformPath)
+	fmt.Printf("  • Generate the deck: pres generate --path %s\n", transformPath)
+
+	return nil
+}
+
+// bulletSlides returns the indexes of the slides to convert: content and
+// two-column slides, among those chosen with --slides, with at least
+// --min-bullets bullets, less the ones that can't be changed
+func bulletSlides(data *presentation.PresentationData) ([]int, error) {
+	var candidates []int
+	if transformSlides != "" {
+		indexes, err := parseSlideRange(transformSlides)
+		if err != nil {
+			return nil, err
+		}
+		candidates = indexes
+	} else {
+		for i := range data.Slides {
+			candidates = append(candidates, i)
+		}
+	}
+
+	var slides []int
+	for _, index := range candidates {
+		if index >= len(data.Slides) {
+			return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+		slide := data.Slides[index]
+		if slide.Hidden || slide.Locked || slide.Ref != "" {
+			continue
+		}
+		if slide.Layout != "content" && slide.Layout != "two-column" {
+			continue
+		}
+		if presentation.CountBullets(slide.Content) < transformMinBullets {
+			continue
+		}
+		slides = append(slides, index)
+	}
+	return slides, nil
+}
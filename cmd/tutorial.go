@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/internal/replay"
+	"github.com/geoffjay/pres/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// tutorialFixtures are the canned AI responses of pres tutorial, served as
+// the default fixture of each function in replay mode
+//
+//go:embed tutorial/*.json
+var tutorialFixtures embed.FS
+
+var tutorialDir string
+
+var tutorialCmd = &cobra.Command{
+	Use:   "tutorial",
+	Short: "Learn pres by creating, updating, and generating a sample deck",
+	Long: `Walk through the pres workflow end to end: create a sample presentation
+with the Q&A form, update it, and generate its HTML. Each lesson explains a
+command and then runs it for real.
+
+The AI's questions and responses are canned, so the tutorial needs no
+provider or API key and costs nothing; your answers are asked for but don't
+change what comes back. The sample deck and its HTML are written to a new
+temporary directory, or to --dir, and kept afterwards.
+
+Examples:
+  pres tutorial
+  pres tutorial --dir ~/pres-tutorial`,
+	Args: cobra.NoArgs,
+	RunE: runTutorial,
+}
+
+func init() {
+	rootCmd.AddCommand(tutorialCmd)
+
+	tutorialCmd.Flags().StringVar(&tutorialDir, "dir", "", "Directory to write the sample deck to (default: a new temporary directory)")
+}
+
+// tutorialLesson is one step of pres tutorial: what it teaches, the command
+// it runs, and how to run it
+type tutorialLesson struct {
+	title   string
+	text    string
+	command string
+	run     func() error
+}
+
+func runTutorial(cmd *cobra.Command, args []string) error {
+	dir := tutorialDir
+	if dir == "" {
+		var err error
+		if dir, err = os.MkdirTemp("", "pres-tutorial-"); err != nil {
+			return fmt.Errorf("failed to create tutorial directory: %w", err)
+		}
+	} else if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create tutorial directory: %w", err)
+	}
+
+	// Every AI call is answered from the canned fixtures
+	fixtures := filepath.Join(dir, "ai-fixtures")
+	if err := writeTutorialFixtures(fixtures); err != nil {
+		return err
+	}
+	os.Setenv("PRES_AI_MODE", string(replay.ModeReplay))
+	os.Setenv("PRES_AI_FIXTURES", fixtures)
+
+	deck := filepath.Join(dir, "why-we-write-tests.json")
+	html := strings.TrimSuffix(deck, ".json") + ".html"
+	lessons := []tutorialLesson{
+		{
+			title: "Welcome",
+			text: `pres turns a short description into a reveal.js presentation, asking you
+a few questions first so the slides fit your audience.
+
+In this tutorial you'll create a sample deck, update it, and generate its
+HTML. The AI's side is canned, so nothing is sent anywhere and nothing costs
+money; answer however you like.`,
+		},
+		{
+			title: "Create a presentation",
+			text: `pres create asks the AI what it needs to know about the talk, then asks
+you. Questions come in a few kinds:
+
+  • Text questions: type an answer and press Enter
+  • Choice questions: pick with ↑/↓ and press Enter
+  • Multi-select questions: press Space on each option you want
+  • Optional questions: press Tab to skip them
+
+Press ↑ on a text question (or Shift+Tab on a list) to go back and change an
+answer. Once you've answered, the slides are generated and saved as JSON.`,
+			command: fmt.Sprintf("pres create \"Why we write tests\" --output %s", deck),
+			run: func() error {
+				createOutput = deck
+				return runCreate(createCmd, []string{"Why we write tests"})
+			},
+		},
+		{
+			title: "Update it",
+			text: `pres update changes a presentation from a request in plain words. The AI
+may ask a few questions about it first, then plans operations (add, modify,
+delete, or reorder slides) which are previewed and applied.
+
+Every update is kept in the deck's revision history, so pres undo can take it
+back.`,
+			command: fmt.Sprintf("pres update --path %s \"add a slide with a testing checklist\"", deck),
+			run: func() error {
+				updatePath = deck
+				return runUpdate(updateCmd, []string{"add a slide with a testing checklist"})
+			},
+		},
+		{
+			title: "Generate the HTML",
+			text: `pres generate builds a reveal.js page from the JSON, ready to open in a
+browser or present with pres present. No AI is involved.`,
+			command: fmt.Sprintf("pres generate --path %s", deck),
+			run: func() error {
+				generatePath = deck
+				return runGenerate(generateCmd, nil)
+			},
+		},
+	}
+
+	for i, lesson := range lessons {
+		next, err := runTutorialLesson(lesson, i, len(lessons))
+		if err != nil {
+			return err
+		}
+		if !next {
+			fmt.Printf("Tutorial stopped. Run pres tutorial to start again.\n")
+			return nil
+		}
+		if lesson.run != nil {
+			fmt.Printf("\n$ %s\n\n", lesson.command)
+			if err := lesson.run(); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+	}
+
+	fmt.Printf("🎓 Tutorial complete!\n")
+	fmt.Printf("  Deck: %s\n", deck)
+	fmt.Printf("  HTML: %s\n", html)
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Present the sample deck: pres present --path %s\n", deck)
+	fmt.Printf("  • Set up your provider for real decks: pres setup\n")
+	fmt.Printf("  • Create your own: pres create \"your topic\"\n")
+	return nil
+}
+
+// writeTutorialFixtures writes the canned AI responses to dir
+func writeTutorialFixtures(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	entries, err := tutorialFixtures.ReadDir("tutorial")
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		data, err := tutorialFixtures.ReadFile("tutorial/" + entry.Name())
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, entry.Name()), data, 0644); err != nil {
+			return fmt.Errorf("failed to write fixture: %w", err)
+		}
+	}
+	return nil
+}
+
+// runTutorialLesson shows a lesson until it is started with Enter, reporting
+// false when the tutorial is stopped instead
+func runTutorialLesson(lesson tutorialLesson, index, total int) (bool, error) {
+	final, err := tea.NewProgram(tutorialScreen{lesson: lesson, index: index, total: total}).Run()
+	if err != nil {
+		return false, fmt.Errorf("error running tutorial: %w", err)
+	}
+	return final.(tutorialScreen).next, nil
+}
+
+// tutorialScreen is the bubbletea model introducing a lesson
+type tutorialScreen struct {
+	lesson tutorialLesson
+	index  int
+	total  int
+	next   bool
+	done   bool
+}
+
+func (s tutorialScreen) Init() tea.Cmd {
+	return nil
+}
+
+func (s tutorialScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "ctrl+c", "esc", "q":
+			s.done = true
+			return s, tea.Quit
+		case "enter":
+			s.next, s.done = true, true
+			return s, tea.Quit
+		}
+	}
+	return s, nil
+}
+
+func (s tutorialScreen) View() string {
+	if s.done {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(tui.TitleStyle.Render("pres tutorial"))
+	b.WriteString("\n\n")
+	b.WriteString(tui.HelpStyle.Render(fmt.Sprintf("Lesson %d of %d", s.index+1, s.total)))
+	b.WriteString("\n\n")
+	b.WriteString(tui.QuestionStyle.Render(s.lesson.title))
+	b.WriteString("\n\n")
+	b.WriteString(s.lesson.text)
+	b.WriteString("\n\n")
+
+	hint := "Press Enter to continue"
+	if s.lesson.command != "" {
+		b.WriteString(tui.InputStyle.Render("$ " + s.lesson.command))
+		b.WriteString("\n\n")
+		hint = "Press Enter to run it"
+	}
+	b.WriteString(tui.HelpStyle.Render(hint + " • Esc to stop the tutorial"))
+	return b.String()
+}
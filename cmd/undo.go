@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	undoPath     string
+	undoRevision int
+
+	revisionsPath string
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo",
+	Short: "Roll a presentation back to its previous version",
+	Long: `Roll a presentation back to the version before its last save.
+
+Every command that saves a presentation, such as pres update or pres slide
+tag, first keeps the version it replaces in .pres/history/<name>/ next to
+the deck. pres undo restores the most recent of these, and running it again
+goes further back. List the versions with pres revisions; --revision rolls
+back to a given one, dropping the versions after it.
+
+The version pres undo replaces is not kept, so an undo can't be undone.
+
+Examples:
+  pres undo --path presentations/my-talk.json
+  pres undo --path presentations/my-talk.json --revision 3`,
+	Args: cobra.NoArgs,
+	RunE: runUndo,
+}
+
+var revisionsCmd = &cobra.Command{
+	Use:   "revisions",
+	Short: "List the earlier versions of a presentation",
+	Long: `List the earlier versions of a presentation that pres undo can restore,
+most recent first, with when and by which command each was replaced.
+
+The last 50 versions are kept.
+
+Examples:
+  pres revisions --path presentations/my-talk.json`,
+	Args: cobra.NoArgs,
+	RunE: runRevisions,
+}
+
+func init() {
+	rootCmd.AddCommand(undoCmd)
+	rootCmd.AddCommand(revisionsCmd)
+
+	undoCmd.Flags().StringVarP(&undoPath, "path", "p", "", "Path to presentation JSON file (required)")
+	undoCmd.Flags().IntVarP(&undoRevision, "revision", "r", 0, "Revision to roll back to, as listed by pres revisions (default: the most recent)")
+	undoCmd.MarkFlagRequired("path")
+
+	revisionsCmd.Flags().StringVarP(&revisionsPath, "path", "p", "", "Path to presentation JSON file (required)")
+	revisionsCmd.MarkFlagRequired("path")
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	revision, err := presentation.UndoPresentation(undoPath, undoRevision)
+	if err != nil {
+		return fmt.Errorf("failed to undo: %w", err)
+	}
+
+	fmt.Printf("✓ Restored %s to revision %d (%s, %d slides)\n", undoPath, revision.Revision, revision.Title, revision.Slides)
+	fmt.Printf("  Replaced %s%s\n", revision.Replaced.Format("2006-01-02 15:04"), revisionSource(revision))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • View details: pres info --path %s\n", undoPath)
+	fmt.Printf("  • Go back further: pres undo --path %s\n", undoPath)
+
+	return nil
+}
+
+func runRevisions(cmd *cobra.Command, args []string) error {
+	revisions, err := presentation.ListRevisions(revisionsPath)
+	if err != nil {
+		return err
+	}
+
+	if len(revisions) == 0 {
+		fmt.Printf("%s has no earlier versions\n", revisionsPath)
+		return nil
+	}
+
+	fmt.Printf("🕘 Revisions of %s\n\n", revisionsPath)
+	for i := len(revisions) - 1; i >= 0; i-- {
+		revision := revisions[i]
+		fmt.Printf("  %3d. %s · %d slides · replaced %s%s\n", revision.Revision, revision.Title, revision.Slides,
+			revision.Replaced.Format("2006-01-02 15:04"), revisionSource(&revision))
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Undo the last save: pres undo --path %s\n", revisionsPath)
+	fmt.Printf("  • Roll back further: pres undo --path %s --revision %d\n", revisionsPath, revisions[0].Revision)
+
+	return nil
+}
+
+// revisionSource describes the command and person that replaced a revision
+func revisionSource(revision *presentation.DeckRevision) string {
+	var source string
+	if revision.Source != "" {
+		source += " by " + revision.Source
+	}
+	if revision.Actor != "" {
+		source += " (" + revision.Actor + ")"
+	}
+	return source
+}
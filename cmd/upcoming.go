@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var (
+	upcomingDir  string
+	upcomingDays int
+)
+
+var upcomingCmd = &cobra.Command{
+	Use:   "upcoming",
+	Short: "List presentations with approaching event dates",
+	Long: `List presentations whose event date is coming up, most urgent first.
+
+Presentations are found by scanning the presentations directory. Decks whose
+event has passed but that have not been marked delivered are listed as overdue.
+
+Examples:
+  pres upcoming
+  pres upcoming --days 7
+  pres upcoming --dir talks`,
+	Args: cobra.NoArgs,
+	RunE: runUpcoming,
+}
+
+func init() {
+	rootCmd.AddCommand(upcomingCmd)
+
+	upcomingCmd.Flags().StringVarP(&upcomingDir, "dir", "d", "", "Directory to scan for presentations (default: the project's presentations directory)")
+	upcomingCmd.Flags().IntVar(&upcomingDays, "days", 30, "How many days ahead to look")
+}
+
+func runUpcoming(cmd *cobra.Command, args []string) error {
+	if upcomingDir == "" {
+		upcomingDir = presentationsDir()
+	}
+	writer := newWriter()
+	entries, err := writer.ScanPresentations(upcomingDir)
+	if err != nil {
+		return fmt.Errorf("failed to scan presentations: %w", err)
+	}
+
+	upcoming := presentation.GetUpcoming(entries, time.Now(), upcomingDays)
+	if len(upcoming) == 0 {
+		fmt.Printf("No presentations scheduled in the next %d days.\n", upcomingDays)
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "WHEN\tDATE\tTITLE\tVENUE\tSTATUS\tPATH")
+	for _, entry := range upcoming {
+		meta := entry.Data.Metadata
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			describeDaysLeft(entry.DaysLeft),
+			meta.EventDate,
+			meta.Title,
+			meta.Venue,
+			meta.GetStatus(),
+			entry.Path,
+		)
+	}
+
+	return tw.Flush()
+}
+
+// describeDaysLeft renders a day count relative to today
+func describeDaysLeft(days int) string {
+	switch {
+	case days < 0:
+		return fmt.Sprintf("overdue %dd", -days)
+	case days == 0:
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	default:
+		return fmt.Sprintf("in %dd", days)
+	}
+}
@@ -1,18 +1,38 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/geoffjay/agar/tui"
-	"github.com/geoffjay/pres/baml_client"
+	"github.com/geoffjay/pres/baml_client/types"
 	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/render"
+	"github.com/geoffjay/pres/internal/tui"
 	"github.com/spf13/cobra"
 )
 
 var (
-	updatePath string
+	updatePath         string
+	updateFromComments bool
+	updateNoPreview    bool
+	updatePartial      bool
+	updateBudget       int
+	updateRelevant     int
+	updateRequestsFile string
+	updateYes          bool
+	updateMaxDeletes   int
+	updateMetadata     bool
+	updateProtect      string
+	updateTranscript   string
+	updateDryRun       bool
 )
 
 var updateCmd = &cobra.Command{
@@ -24,13 +44,56 @@ The command will:
 1. Load the existing presentation
 2. Gather contextual information about the changes
 3. Apply updates to the presentation
-4. Save the modified presentation
+4. Preview the slide produced by each planned operation
+5. Save the modified presentation
+
+Updates are all-or-nothing: if any planned operation is invalid (for example
+an out-of-range slide index), nothing is saved. Use --partial to save the
+valid operations and skip the rest.
+
+Guardrails make operations invalid that go further than a request should:
+deleting more than --max-deletes slides, changing the deck's metadata when
+the request does not explicitly ask for it, e.g. "change the deck title" or
+"set the author" (unless --allow-metadata is given), and modifying or
+deleting the slides given with --protect.
+
+The deck's slides are sent along with the request. On decks of 15 or more
+slides, only the slides relevant to the request are sent in full, with their
+IDs, and the rest by title: those the request mentions by number, and up to
+--relevant more found by matching its keywords against slide titles, tags,
+content, and notes.
+
+When a deck is too large for the context budget (--context-budget, in
+estimated tokens), the relevant slides are sent in full with as many of
+their neighbours as fit, and the rest are reduced to their titles, or to
+ranges of slides for very large decks.
+
+With --requests-file, the requests are taken from a numbered markdown list.
+Operations are planned for each request in turn, against the deck as the
+requests before it leave it, and all of them are saved together after a
+single approval (skipped with --yes). A request with invalid operations is
+left out, unless --partial is given.
+
+With --from-comments, the request is taken from the unresolved reviewer
+comments on each slide instead. Each comment is addressed in turn and marked
+resolved once its changes have been applied.
+
+With --save-transcript, the Q&A and the prompt and response of every AI call
+are written to a file (Markdown, or JSON for a .json path).
+
+With --dry-run, the planned operations are applied to a copy of the deck and
+the changes they would make are printed slide by slide, with the content
+before and after, and the presentation file is left untouched.
 
 Examples:
   pres update --path presentations/my-talk.json "Add a slide at the beginning with an executive summary"
   pres update --path presentations/review.json "Change the theme to 'night'"
-  pres update --path presentations/intro.json "Add more details to the goroutines slide"`,
-	Args: cobra.ExactArgs(1),
+  pres update --path presentations/intro.json "Add more details to the goroutines slide"
+  pres update --path presentations/review.json --from-comments
+  pres update --path presentations/my-talk.json --requests-file changes.md
+  pres update --path presentations/my-talk.json "Shorten the intro" --save-transcript transcript.md
+  pres update --path presentations/my-talk.json "Merge the two pricing slides" --dry-run`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runUpdate,
 }
 
@@ -38,31 +101,90 @@ func init() {
 	rootCmd.AddCommand(updateCmd)
 
 	updateCmd.Flags().StringVarP(&updatePath, "path", "p", "", "Path to presentation JSON file (required)")
+	updateCmd.Flags().BoolVar(&updateFromComments, "from-comments", false, "Address unresolved reviewer comments instead of a request")
+	updateCmd.Flags().StringVarP(&updateRequestsFile, "requests-file", "f", "", "Markdown file with a numbered list of requests to apply together")
+	updateCmd.Flags().BoolVarP(&updateYes, "yes", "y", false, "Apply the requests from --requests-file without asking for approval")
+	updateCmd.Flags().BoolVar(&updateNoPreview, "no-preview", false, "Don't render a preview of the planned operations")
+	updateCmd.Flags().BoolVar(&updatePartial, "partial", false, "Save the valid operations even if some are invalid")
+	updateCmd.Flags().IntVar(&updateMaxDeletes, "max-deletes", presentation.DefaultMaxDeletes, "Most slides an update may delete (-1 for no limit)")
+	updateCmd.Flags().BoolVar(&updateMetadata, "allow-metadata", false, "Allow metadata changes even when the request does not explicitly ask for them")
+	updateCmd.Flags().StringVar(&updateProtect, "protect", "", "Slides that may not be modified or deleted, e.g. 1,2,10-12")
+	updateCmd.Flags().IntVar(&updateRelevant, "relevant", presentation.DefaultRelevantSlides, "Slides to pick by keyword relevance on large decks (0 to send every slide that fits)")
+	updateCmd.Flags().IntVar(&updateBudget, "context-budget", presentation.DefaultContextBudget, "Estimated tokens the deck, request, and answers may use (0 for no limit)")
+	updateCmd.Flags().StringVar(&updateTranscript, "save-transcript", "", "Write the Q&A, prompts, and model responses of the session to a file")
+	updateCmd.Flags().BoolVar(&updateDryRun, "dry-run", false, "Show the changes the update would make without saving them")
 	updateCmd.MarkFlagRequired("path")
 }
 
 func runUpdate(cmd *cobra.Command, args []string) error {
-	request := args[0]
 	ctx := context.Background()
 
+	if updateFromComments {
+		if len(args) > 0 {
+			return fmt.Errorf("an update request cannot be combined with --from-comments")
+		}
+		if updateRequestsFile != "" {
+			return fmt.Errorf("--requests-file cannot be combined with --from-comments")
+		}
+		return runUpdateFromComments(ctx)
+	}
+
+	if updateRequestsFile != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("an update request cannot be combined with --requests-file")
+		}
+		return runUpdateFromFile(ctx)
+	}
+
+	if len(args) == 0 {
+		return fmt.Errorf("an update request is required (or use --requests-file or --from-comments)")
+	}
+	request := args[0]
+
 	fmt.Printf("🔄 Updating presentation: %s\n", updatePath)
 	fmt.Printf("Request: %s\n\n", request)
 
 	// Load existing presentation
-	writer := presentation.NewWriter(".")
-	existingData, err := writer.LoadPresentation(updatePath)
+	writer := newWriter()
+	writer.SetAudit("pres update", auditActor())
+	existingData, protected, err := loadUpdateDeck(writer)
 	if err != nil {
-		return fmt.Errorf("failed to load presentation: %w", err)
+		return err
 	}
+	writer.SetPolicy(updatePolicy(protected, request))
 
 	fmt.Printf("Loaded: %s (%d slides)\n\n", existingData.Metadata.Title, len(existingData.Slides))
 
-	// Generate presentation summary for context
-	presentationSummary := existingData.GetSummary()
+	// Slides relevant to the request are sent in full, on large decks on
+	// their own and otherwise when the deck has to be trimmed to fit the
+	// context budget
+	focus := existingData.RelevantSlides(request, updateRelevant)
+	deckContext := func(qa []string) presentation.DeckContext {
+		return buildUpdateContext(existingData, focus, request, qa)
+	}
+	if focusedUpdate(existingData) && len(focus) > 0 {
+		fmt.Printf("🔍 Relevant slides:\n")
+		for _, index := range focus {
+			fmt.Printf("  %d. %s\n", index+1, existingData.Slides[index].Title)
+		}
+		fmt.Println()
+	} else {
+		printContextBudget(deckContext(nil))
+	}
 
-	const maxIterations = 3
+	maxIterations := settings.GetInt("max_iterations")
 	var allQAResponses []string
 
+	session, err := newAISession("pres update", request, updateTranscript)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := session.saveTranscript(updatePath, allQAResponses); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}()
+
 	// Iterative information gathering
 	config := tui.IterationConfig{
 		MaxIterations:    maxIterations,
@@ -73,10 +195,11 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	form := tui.NewIterativeForm("Presentation Update", config)
 
 	for iteration := 0; iteration < maxIterations; iteration++ {
-		fmt.Printf("Preparing questions (iteration %d/%d)...\n", iteration+1, maxIterations)
-
 		// Prepare questions using BAML
-		preparation, err := baml_client.PrepareUpdatePresentation(ctx, request, presentationSummary, int64(iteration), allQAResponses)
+		message := fmt.Sprintf("Preparing questions (iteration %d/%d)...", iteration+1, maxIterations)
+		preparation, err := withSpinner(ctx, message, func(ctx context.Context, _ func(string)) (types.PresentationPreparation, error) {
+			return prepareUpdatePresentation(ctx, request, deckContext(allQAResponses).Text, int64(iteration), allQAResponses, session.option())
+		})
 		if err != nil {
 			return fmt.Errorf("failed to prepare questions: %w", err)
 		}
@@ -91,24 +214,17 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		// Convert BAML questions to TUI questions
 		var questions []tui.IterativeQuestion
 		for _, q := range preparation.Questions {
-			questions = append(questions, tui.IterativeQuestion{
-				Question:  q.Question,
-				HelpText:  q.Help_text,
-				Iteration: int(q.Iteration),
-			})
+			questions = append(questions, formQuestion(q))
 		}
 
 		form.AddQuestions(questions)
 
 		// Run interactive TUI
-		p := tea.NewProgram(form)
-		finalModel, err := p.Run()
+		form, err = runForm(form)
 		if err != nil {
 			return fmt.Errorf("error running interactive form: %w", err)
 		}
 
-		form = finalModel.(tui.IterativeFormModel)
-
 		if !form.IsDone() && !form.NeedsMoreInfo() {
 			return fmt.Errorf("update cancelled")
 		}
@@ -117,8 +233,7 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		iterationResponses := form.GetResponsesForIteration(iteration)
 		for i, q := range preparation.Questions {
 			if i < len(iterationResponses) {
-				qa := fmt.Sprintf("Q: %s\nA: %s", q.Question, iterationResponses[i])
-				allQAResponses = append(allQAResponses, qa)
+				allQAResponses = append(allQAResponses, formResponse(q.Question, iterationResponses[i]))
 			}
 		}
 
@@ -139,10 +254,12 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		form.NextIteration()
 	}
 
-	fmt.Println("\nGenerating update operations...")
+	fmt.Println()
 
 	// Generate update operations
-	updates, err := baml_client.GenerateUpdateOperations(ctx, request, presentationSummary, allQAResponses)
+	updates, err := withSpinner(ctx, "Generating update operations...", func(ctx context.Context, _ func(string)) ([]types.PresentationUpdate, error) {
+		return generateUpdateOperations(ctx, request, deckContext(allQAResponses).Text, allQAResponses, session.option())
+	})
 	if err != nil {
 		return fmt.Errorf("failed to generate updates: %w", err)
 	}
@@ -158,11 +275,36 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  %d. %s: %s\n", i+1, update.Operation, update.Rationale)
 	}
 
+	if !updateNoPreview {
+		fmt.Printf("\nPreview:\n")
+		printUpdatePreview(writer, existingData, updates)
+	}
+
+	if updateDryRun {
+		fmt.Println("\nChecking updates...")
+		if _, err := dryRunUpdates(writer, existingData, updates); err != nil {
+			if errors.Is(err, presentation.ErrUpdateRejected) {
+				fmt.Println("\n⚠ The update would be rejected. Use --partial to apply only the valid operations.")
+			}
+			return err
+		}
+		fmt.Printf("\n🔍 Dry run: %s was not changed\n", updatePath)
+		return nil
+	}
+
 	// Apply updates
 	fmt.Println("\nApplying updates...")
-	if err := writer.UpdatePresentation(updatePath, updates); err != nil {
+	writer.AddProvenance(session.collect()...)
+	results, err := writer.UpdatePresentation(updatePath, updates, updatePartial)
+	if errors.Is(err, presentation.ErrUpdateRejected) {
+		printOperationResults(results)
+		fmt.Println("\n⚠ No changes were saved. Use --partial to apply only the valid operations.")
+		return err
+	}
+	if err != nil {
 		return fmt.Errorf("failed to apply updates: %w", err)
 	}
+	printOperationResults(results)
 
 	// Reload to show summary
 	updatedData, err := writer.LoadPresentation(updatePath)
@@ -170,8 +312,11 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to reload presentation: %w", err)
 	}
 
+	printChangeSummary(existingData, updatedData)
+
 	fmt.Printf("\n✓ Presentation updated successfully!\n")
 	fmt.Printf("  Location: %s\n", updatePath)
+	fmt.Printf("  Operations: %d of %d applied\n", presentation.CountApplied(results), len(results))
 	fmt.Printf("  Slides: %d\n", len(updatedData.Slides))
 	fmt.Printf("  Modified: %s\n", updatedData.Metadata.Modified.Format("2006-01-02 15:04:05"))
 
@@ -182,3 +327,556 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// runUpdateFromComments generates and applies update operations for each
+// unresolved reviewer comment, resolving comments as their changes land
+func runUpdateFromComments(ctx context.Context) error {
+	fmt.Printf("🔄 Addressing reviewer comments: %s\n", updatePath)
+
+	writer := newWriter()
+	writer.SetAudit("pres update --from-comments", auditActor())
+	data, protected, err := loadUpdateDeck(writer)
+	if err != nil {
+		return err
+	}
+
+	comments := data.GetComments(false)
+	if len(comments) == 0 {
+		fmt.Println("No unresolved comments.")
+		return nil
+	}
+
+	fmt.Printf("Found %d unresolved comment(s)\n", len(comments))
+
+	session, err := newAISession("pres update --from-comments", "Address the unresolved reviewer comments", updateTranscript)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := session.saveTranscript(updatePath, nil); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}()
+
+	resolved := 0
+	working := data
+	for _, c := range comments {
+		// Reload each time so operations are generated against the current
+		// slides; a dry run keeps them in memory instead
+		data := working
+		if !updateDryRun {
+			data, err = writer.LoadPresentation(updatePath)
+			if err != nil {
+				return fmt.Errorf("failed to reload presentation: %w", err)
+			}
+		}
+
+		index := data.GetCommentSlideIndex(c.Comment.ID)
+		if index < 0 {
+			fmt.Printf("\n⚠ Skipping comment %s: its slide no longer exists\n", c.Comment.ID)
+			continue
+		}
+
+		fmt.Printf("\nComment %s on slide %d: %s\n", c.Comment.ID, index+1, c.Comment.Text)
+
+		request := fmt.Sprintf("Address this reviewer comment on slide %d (slide_index %d): %s", index+1, index, c.Comment.Text)
+		writer.SetPolicy(updatePolicy(protected, c.Comment.Text))
+		deck := data.BuildContext([]int{index}, presentation.EstimateTokens(request), updateBudget)
+		summary := deck.Text + fmt.Sprintf("\n\nSlide under review: slide %d (index %d)", index+1, index)
+
+		updates, err := generateUpdateOperations(ctx, request, summary, nil, session.option())
+		if err != nil {
+			return fmt.Errorf("failed to generate updates for comment %s: %w", c.Comment.ID, err)
+		}
+
+		if len(updates) == 0 {
+			fmt.Println("  ⚠ No updates generated, leaving comment unresolved")
+			continue
+		}
+
+		for i, update := range updates {
+			fmt.Printf("  %d. %s: %s\n", i+1, update.Operation, update.Rationale)
+		}
+
+		if !updateNoPreview {
+			printUpdatePreview(writer, data, updates)
+		}
+
+		if updateDryRun {
+			next, err := dryRunUpdates(writer, data, updates)
+			if errors.Is(err, presentation.ErrUpdateRejected) {
+				fmt.Println("  ⚠ Some updates are invalid, the comment would be left unresolved")
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("failed to check updates for comment %s: %w", c.Comment.ID, err)
+			}
+			working = next
+			resolved++
+			continue
+		}
+
+		writer.AddProvenance(session.collect()...)
+		results, err := writer.UpdatePresentation(updatePath, updates, updatePartial)
+		if errors.Is(err, presentation.ErrUpdateRejected) {
+			printOperationResults(results)
+			fmt.Println("  ⚠ Some updates are invalid, no changes saved and comment left unresolved")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to apply updates for comment %s: %w", c.Comment.ID, err)
+		}
+		printOperationResults(results)
+		if updated, err := writer.LoadPresentation(updatePath); err == nil {
+			printChangeSummary(data, updated)
+		}
+
+		if presentation.CountApplied(results) < len(results) {
+			fmt.Println("  ⚠ Some updates were skipped, leaving comment unresolved")
+			continue
+		}
+
+		// The operations may have removed the slide, taking the comment with it
+		if err := writer.ResolveComment(updatePath, c.Comment.ID); err != nil && !errors.Is(err, presentation.ErrCommentNotFound) {
+			return fmt.Errorf("failed to resolve comment %s: %w", c.Comment.ID, err)
+		}
+		resolved++
+	}
+
+	if updateDryRun {
+		fmt.Printf("\n🔍 Dry run: would resolve %d of %d comment(s); %s was not changed\n", resolved, len(comments), updatePath)
+		return nil
+	}
+	fmt.Printf("\n✓ Resolved %d of %d comment(s)\n", resolved, len(comments))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review remaining comments: pres comment list --path %s\n", updatePath)
+	fmt.Printf("  • Generate HTML: pres generate --path %s\n", updatePath)
+
+	return nil
+}
+
+// printOperationResults shows whether each update operation was applied
+func printOperationResults(results []presentation.OperationResult) {
+	for _, result := range results {
+		if result.Applied {
+			fmt.Printf("  ✓ %d. %s\n", result.Index+1, result.Operation)
+		} else {
+			fmt.Printf("  ✗ %d. %s skipped: %s\n", result.Index+1, result.Operation, result.Reason)
+		}
+	}
+}
+
+// previewWidth is the width of slide previews rendered in the terminal
+const previewWidth = 72
+
+// loadUpdateDeck loads the presentation to update and returns it with the
+// IDs of the slides given with --protect. Slides without an ID are assigned
+// one first, which is saved unless this is a dry run.
+func loadUpdateDeck(writer *presentation.Writer) (*presentation.PresentationData, []string, error) {
+	var indexes []int
+	if updateProtect != "" {
+		var err error
+		if indexes, err = parseSlideRange(updateProtect); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	load := writer.LoadPresentation
+	if updateProtect != "" && !updateDryRun {
+		load = writer.EnsureSlideIDs
+	}
+	data, err := load(updatePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load presentation: %w", err)
+	}
+
+	ids := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		if index >= len(data.Slides) {
+			return nil, nil, fmt.Errorf("cannot protect slide %d: presentation has %d slides", index+1, len(data.Slides))
+		}
+		ids = append(ids, data.Slides[index].ID)
+	}
+	return data, ids, nil
+}
+
+// updatePolicy returns the guardrails for the operations planned for a
+// request
+func updatePolicy(protected []string, request string) *presentation.UpdatePolicy {
+	return &presentation.UpdatePolicy{
+		MaxDeletes: updateMaxDeletes,
+		Metadata:   updateMetadata || presentation.RequestsMetadata(request),
+		Protected:  protected,
+	}
+}
+
+// printChangeSummary lists what an update changed, slide by slide
+func printChangeSummary(before, after *presentation.PresentationData) {
+	diff := presentation.DiffPresentations(before, after)
+	if diff.IsEmpty() {
+		fmt.Println("\nNo changes.")
+		return
+	}
+
+	fmt.Printf("\nChanges:\n")
+	for _, change := range diff.Slides {
+		switch change.Kind {
+		case presentation.SlideAdded:
+			fmt.Printf("  + slide %d: added %q\n", change.Index+1, change.Title)
+		case presentation.SlideDeleted:
+			fmt.Printf("  - slide %d: deleted %q\n", change.Index+1, change.Title)
+		default:
+			fmt.Printf("  ~ slide %d: %s\n", change.Index+1, strings.Join(change.Details, ", "))
+		}
+	}
+	for _, change := range diff.Metadata {
+		fmt.Printf("  ~ %s: %q → %q\n", change.Key, change.Old, change.New)
+	}
+}
+
+// dryRunUpdates applies updates to a copy of data and prints the changes
+// they make, without saving anything. It returns the updated copy, or an
+// error wrapping presentation.ErrUpdateRejected when some of the updates are
+// invalid and --partial is not given.
+func dryRunUpdates(writer *presentation.Writer, data *presentation.PresentationData, updates []types.PresentationUpdate) (*presentation.PresentationData, error) {
+	next := data.Clone()
+	results, err := writer.ApplyUpdates(next, updates)
+	if err != nil {
+		return nil, err
+	}
+	printOperationResults(results)
+	if skipped := len(results) - presentation.CountApplied(results); skipped > 0 && !updatePartial {
+		return nil, fmt.Errorf("%w: %d of %d operations are invalid", presentation.ErrUpdateRejected, skipped, len(results))
+	}
+	printUpdateDiff(data, next)
+	return next, nil
+}
+
+// printUpdateDiff prints the changes between two versions of a deck with
+// the slides' fields before and after: added slides in full, deleted slides
+// in full, and the title, layout, content, and notes of modified slides
+// line by line
+func printUpdateDiff(before, after *presentation.PresentationData) {
+	diff := presentation.DiffPresentations(before, after)
+	if diff.IsEmpty() {
+		fmt.Println("\nNo changes.")
+		return
+	}
+
+	fmt.Printf("\nDiff:\n")
+	for _, change := range diff.Slides {
+		switch change.Kind {
+		case presentation.SlideAdded:
+			slide := after.Slides[change.Index]
+			fmt.Printf("  + slide %d: added %q [%s]\n", change.Index+1, change.Title, slide.Layout)
+			printFieldDiff("content", "", slide.Content)
+			printFieldDiff("notes", "", slide.Notes)
+		case presentation.SlideDeleted:
+			slide := before.Slides[change.OldIndex]
+			fmt.Printf("  - slide %d: deleted %q [%s]\n", change.OldIndex+1, change.Title, slide.Layout)
+			printFieldDiff("content", slide.Content, "")
+			printFieldDiff("notes", slide.Notes, "")
+		default:
+			old, slide := before.Slides[change.OldIndex], after.Slides[change.Index]
+			fmt.Printf("  ~ slide %d: %s\n", change.Index+1, strings.Join(change.Details, ", "))
+			if old.Title != slide.Title {
+				fmt.Printf("      title: %q → %q\n", old.Title, slide.Title)
+			}
+			if old.Layout != slide.Layout {
+				fmt.Printf("      layout: %s → %s\n", old.Layout, slide.Layout)
+			}
+			printFieldDiff("content", old.Content, slide.Content)
+			printFieldDiff("notes", old.Notes, slide.Notes)
+		}
+	}
+	for _, change := range diff.Metadata {
+		fmt.Printf("  ~ %s: %q → %q\n", change.Key, change.Old, change.New)
+	}
+}
+
+// printFieldDiff prints the lines of a slide field that differ between two
+// versions, among the lines they share
+func printFieldDiff(field, before, after string) {
+	if before == after {
+		return
+	}
+	fmt.Printf("      %s:\n", field)
+	for _, line := range presentation.DiffLines(before, after) {
+		fmt.Printf("      %c %s\n", line.Op, line.Text)
+	}
+}
+
+// focusedUpdate reports whether update requests on data are sent with only
+// their relevant slides in full
+func focusedUpdate(data *presentation.PresentationData) bool {
+	return updateRelevant > 0 && len(data.Slides) >= presentation.RetrievalMinSlides
+}
+
+// buildUpdateContext renders data for an update request and its Q&A within
+// the context budget, keeping the focus slides in full
+func buildUpdateContext(data *presentation.PresentationData, focus []int, request string, qa []string) presentation.DeckContext {
+	reserved := presentation.EstimateTokens(request) + presentation.EstimateTokensAll(qa)
+	if focusedUpdate(data) {
+		return data.BuildFocusedContext(focus, reserved, updateBudget)
+	}
+	return data.BuildContext(focus, reserved, updateBudget)
+}
+
+// printContextBudget warns when a deck had to be trimmed to fit the context
+// budget
+func printContextBudget(deck presentation.DeckContext) {
+	if deck.Summarized == 0 {
+		return
+	}
+	fmt.Printf("⚠ Deck exceeds the context budget: %d slides sent in full, %d summarized (~%d tokens)\n\n", deck.Full, deck.Summarized, deck.Tokens)
+}
+
+// printUpdatePreview renders what each planned operation does to the
+// presentation. Operations are applied in order to a copy of data, so each
+// preview reflects the operations before it.
+func printUpdatePreview(writer *presentation.Writer, data *presentation.PresentationData, updates []types.PresentationUpdate) {
+	preview := data.Clone()
+	opts := render.Options{Width: previewWidth, MaxLines: 8}
+
+	for i, update := range updates {
+		fmt.Printf("\n  %d. %s\n", i+1, update.Operation)
+
+		if resolved, err := preview.ResolveSlideID(update); err == nil {
+			update = resolved
+		}
+		var removed presentation.Slide
+		if update.Operation == "delete_slide" && update.Slide_index >= 0 && update.Slide_index < int64(len(preview.Slides)) {
+			removed = preview.Slides[update.Slide_index]
+		}
+
+		results, err := writer.ApplyUpdates(preview, []types.PresentationUpdate{update})
+		if err != nil {
+			fmt.Printf("  ✗ %v\n", err)
+			continue
+		}
+		if !results[0].Applied {
+			fmt.Printf("  ✗ skipped: %s\n", results[0].Reason)
+			continue
+		}
+
+		switch update.Operation {
+		case "add_slide", "modify_slide":
+			fmt.Println(render.Card(preview.Slides[update.Slide_index], int(update.Slide_index)+1, opts))
+		case "delete_slide":
+			fmt.Printf("  Removing slide %d:\n", update.Slide_index+1)
+			fmt.Println(render.Card(removed, int(update.Slide_index)+1, opts))
+		case "reorder_slides":
+			for j, slide := range preview.Slides {
+				fmt.Printf("    %d. %s\n", j+1, slide.Title)
+			}
+		case "update_metadata":
+			for _, key := range slices.Sorted(maps.Keys(update.Metadata_updates)) {
+				fmt.Printf("    %s: %q\n", key, update.Metadata_updates[key])
+			}
+		}
+	}
+}
+
+// requestItemPattern matches an item of a numbered list, such as "1. " or "2) "
+var requestItemPattern = regexp.MustCompile(`^\s*\d+[.)]\s+(.*)$`)
+
+// batchRequest is a change request from a requests file and the operations
+// planned for it
+type batchRequest struct {
+	Text    string
+	Updates []types.PresentationUpdate
+	Results []presentation.OperationResult
+	// Skipped explains why none of the request's operations will be applied
+	Skipped string
+}
+
+// parseRequestsFile reads the numbered list of change requests in a markdown
+// file. Lines following an item that do not start a new one continue it;
+// anything before the first item, such as a heading, is ignored.
+func parseRequestsFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read requests file: %w", err)
+	}
+	defer f.Close()
+
+	var requests []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := requestItemPattern.FindStringSubmatch(line); m != nil {
+			requests = append(requests, strings.TrimSpace(m[1]))
+			continue
+		}
+		if line = strings.TrimSpace(line); line != "" && len(requests) > 0 {
+			requests[len(requests)-1] += " " + line
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read requests file: %w", err)
+	}
+
+	for i, request := range requests {
+		if request == "" {
+			return nil, fmt.Errorf("request %d in %s is empty", i+1, path)
+		}
+	}
+	if len(requests) == 0 {
+		return nil, fmt.Errorf("no numbered requests found in %s", path)
+	}
+	return requests, nil
+}
+
+// runUpdateFromFile plans the operations for each request in the requests
+// file in turn, against the deck as changed by the requests before it, and
+// saves them all together once approved
+func runUpdateFromFile(ctx context.Context) error {
+	requests, err := parseRequestsFile(updateRequestsFile)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔄 Updating presentation: %s\n", updatePath)
+	fmt.Printf("Requests: %d from %s\n", len(requests), updateRequestsFile)
+
+	writer := newWriter()
+	writer.SetAudit("pres update --requests-file", auditActor())
+	data, protected, err := loadUpdateDeck(writer)
+	if err != nil {
+		return err
+	}
+
+	session, err := newAISession("pres update --requests-file", strings.Join(requests, "\n"), updateTranscript)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := session.saveTranscript(updatePath, nil); err != nil {
+			fmt.Printf("⚠ %v\n", err)
+		}
+	}()
+
+	working := data.Clone()
+	batch := make([]batchRequest, 0, len(requests))
+	for i, request := range requests {
+		fmt.Printf("\nRequest %d/%d: %s\n", i+1, len(requests), request)
+
+		focus := working.RelevantSlides(request, updateRelevant)
+		deck := buildUpdateContext(working, focus, request, nil)
+		updates, err := generateUpdateOperations(ctx, request, deck.Text, nil, session.option())
+		if err != nil {
+			return fmt.Errorf("failed to generate updates for request %d: %w", i+1, err)
+		}
+
+		writer.SetPolicy(updatePolicy(protected, request))
+		item := batchRequest{Text: request, Updates: updates}
+		if len(updates) == 0 {
+			item.Skipped = "no updates generated"
+			fmt.Printf("  ⚠ No updates generated\n")
+			batch = append(batch, item)
+			continue
+		}
+
+		for j, update := range updates {
+			fmt.Printf("  %d. %s: %s\n", j+1, update.Operation, update.Rationale)
+		}
+		if !updateNoPreview {
+			printUpdatePreview(writer, working, updates)
+		}
+
+		// Each request is all-or-nothing, like a single update, so a
+		// rejected request leaves the deck as the next request expects it
+		next := working.Clone()
+		results, err := writer.ApplyUpdates(next, updates)
+		if err != nil {
+			return fmt.Errorf("request %d: %w", i+1, err)
+		}
+		item.Results = results
+		if presentation.CountApplied(results) < len(results) && !updatePartial {
+			item.Skipped = "some operations are invalid"
+			printOperationResults(results)
+		} else {
+			working = next
+		}
+		batch = append(batch, item)
+	}
+
+	// Consolidated approval of everything planned
+	var updates []types.PresentationUpdate
+	fmt.Printf("\nPlanned changes:\n")
+	for i, item := range batch {
+		if item.Skipped != "" {
+			fmt.Printf("  ✗ %d. %s (skipped: %s)\n", i+1, item.Text, item.Skipped)
+			continue
+		}
+		applied := presentation.CountApplied(item.Results)
+		fmt.Printf("  ✓ %d. %s (%d operations)\n", i+1, item.Text, applied)
+		for _, result := range item.Results {
+			if result.Applied {
+				updates = append(updates, item.Updates[result.Index])
+			}
+		}
+	}
+
+	if len(updates) == 0 {
+		fmt.Println("\n⚠ No updates to apply.")
+		return nil
+	}
+
+	if updateDryRun {
+		printUpdateDiff(data, working)
+		fmt.Printf("\n🔍 Dry run: %s was not changed\n", updatePath)
+		return nil
+	}
+
+	if !updateYes {
+		approved, err := confirmBatch(fmt.Sprintf("Apply %d operations to %s?", len(updates), updatePath))
+		if err != nil {
+			return err
+		}
+		if !approved {
+			fmt.Println("No changes were saved.")
+			return nil
+		}
+	}
+
+	// The guardrails were checked request by request while planning
+	writer.SetPolicy(nil)
+
+	fmt.Println("\nApplying updates...")
+	writer.AddProvenance(session.collect()...)
+	results, err := writer.UpdatePresentation(updatePath, updates, updatePartial)
+	if err != nil {
+		printOperationResults(results)
+		return fmt.Errorf("failed to apply updates: %w", err)
+	}
+
+	updated, err := writer.LoadPresentation(updatePath)
+	if err != nil {
+		return fmt.Errorf("failed to reload presentation: %w", err)
+	}
+	printChangeSummary(data, updated)
+
+	fmt.Printf("\n✓ Presentation updated successfully!\n")
+	fmt.Printf("  Location: %s\n", updatePath)
+	fmt.Printf("  Operations: %d of %d applied\n", presentation.CountApplied(results), len(results))
+	fmt.Printf("  Slides: %d\n", len(updated.Slides))
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the changes: pres audit --path %s\n", updatePath)
+	fmt.Printf("  • Generate HTML: pres generate --path %s\n", updatePath)
+
+	return nil
+}
+
+// confirmBatch asks whether to go ahead with the planned changes
+func confirmBatch(prompt string) (bool, error) {
+	input := tui.NewYesNoInput(prompt, "Nothing is saved until you confirm.")
+	finalModel, err := tea.NewProgram(input).Run()
+	if err != nil {
+		return false, fmt.Errorf("error running confirmation: %w", err)
+	}
+	answer := finalModel.(tui.YesNoModel)
+	return answer.IsDone() && answer.GetAnswer(), nil
+}
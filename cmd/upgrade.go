@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/release"
+	"github.com/spf13/cobra"
+)
+
+// updateCheckTimeout bounds the background lookup of the latest release
+const updateCheckTimeout = 5 * time.Second
+
+var (
+	upgradeCheck bool
+	upgradeForce bool
+)
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade pres to the latest release",
+	Long: `Upgrade pres to the latest release published on GitHub.
+
+The binary for your operating system and architecture is downloaded, its
+SHA-256 checked against the release's checksums.txt, and the running pres
+replaced with it. If pres is installed somewhere you can't write to, such as
+/usr/local/bin, run the upgrade with the permissions needed to change it.
+
+Once a day, pres also looks up the latest release in the background and
+mentions a new version after a command finishes. Set PRES_NO_UPDATE_CHECK=1
+to turn this off; it is also off when CI is set, and for development builds.
+Set GITHUB_TOKEN if you share a network with many users of the GitHub API.
+
+Examples:
+  pres upgrade
+  pres upgrade --check`,
+	Args: cobra.NoArgs,
+	RunE: runUpgrade,
+}
+
+func init() {
+	rootCmd.AddCommand(upgradeCmd)
+
+	upgradeCmd.Flags().BoolVar(&upgradeCheck, "check", false, "Only report whether a new version is available")
+	upgradeCmd.Flags().BoolVar(&upgradeForce, "force", false, "Install the latest release even if it is not newer, e.g. over a development build")
+}
+
+func runUpgrade(cmd *cobra.Command, args []string) error {
+	current := release.Current()
+	fmt.Printf("🔍 Checking for a new version of pres (running %s)\n\n", current)
+
+	latest, err := release.Latest(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case release.Newer(latest.Tag, current):
+		fmt.Printf("New version available: %s\n", latest.Tag)
+		fmt.Printf("  Release notes: %s\n", latest.URL)
+		if upgradeCheck {
+			fmt.Printf("\nNext steps:\n")
+			fmt.Printf("  • Upgrade: pres upgrade\n")
+			return nil
+		}
+	case current == "devel":
+		fmt.Printf("This is a development build; the latest release is %s\n", latest.Tag)
+		if upgradeCheck || !upgradeForce {
+			fmt.Printf("\nNext steps:\n")
+			fmt.Printf("  • Install the release over it: pres upgrade --force\n")
+			return nil
+		}
+	default:
+		fmt.Printf("✓ pres is up to date (%s)\n", current)
+		if upgradeCheck || !upgradeForce {
+			return nil
+		}
+	}
+
+	path, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find the pres executable: %w", err)
+	}
+	if path, err = filepath.EvalSymlinks(path); err != nil {
+		return fmt.Errorf("failed to find the pres executable: %w", err)
+	}
+
+	fmt.Printf("\nDownloading %s...\n", release.BinaryName(runtime.GOOS, runtime.GOARCH))
+	if err := latest.Install(cmd.Context(), path); err != nil {
+		return fmt.Errorf("failed to upgrade: %w", err)
+	}
+
+	fmt.Printf("✓ Checksum verified\n")
+	fmt.Printf("✓ Upgraded %s from %s to %s\n", path, current, latest.Tag)
+	return nil
+}
+
+// isUserCommand reports whether cmd is a command someone ran, rather than
+// one a shell runs for completion on every tab press
+func isUserCommand(cmd *cobra.Command) bool {
+	return cmd != nil && !cmd.Hidden && !strings.HasPrefix(cmd.Name(), "__")
+}
+
+// updateCheckEnabled reports whether pres looks for new releases in the
+// background
+func updateCheckEnabled() bool {
+	return os.Getenv("PRES_NO_UPDATE_CHECK") == "" && os.Getenv("CI") == "" && release.Current() != "devel"
+}
+
+// checkForUpdate looks up the latest release in the background while a
+// command runs, at most once a day, for the notice after the next command
+func checkForUpdate() {
+	if !updateCheckEnabled() {
+		return
+	}
+	dir, err := userConfigDir()
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), updateCheckTimeout)
+	defer cancel()
+	release.CheckForUpdate(ctx, dir)
+}
+
+// printUpdateNotice mentions a new release after a command, on the terminal
+// only, so output piped elsewhere stays clean
+func printUpdateNotice(cmd *cobra.Command) {
+	if !updateCheckEnabled() || !isUserCommand(cmd) || cmd == upgradeCmd {
+		return
+	}
+	if info, err := os.Stderr.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return
+	}
+	dir, err := userConfigDir()
+	if err != nil {
+		return
+	}
+	if latest := release.Available(dir); latest != "" {
+		fmt.Fprintf(os.Stderr, "\nA new version of pres is available: %s (running %s)\n", latest, release.Current())
+		fmt.Fprintf(os.Stderr, "  Upgrade: pres upgrade\n")
+	}
+}
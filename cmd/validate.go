@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/spf13/cobra"
+)
+
+var validatePaths []string
+
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check presentations for problems before presenting them",
+	Long: `Check presentation files for problems that make them render badly, such
+as decks an AI call generated with an unknown layout.
+
+Each file is checked against the presentation JSON Schema (see pres schema),
+with --strict also rejecting unknown keys and requiring more fields. The
+presentation must then have a title and a known theme, and slides that are
+not all hidden. Each slide must have a known layout, must not be empty, and
+must have well-formed markdown: code blocks that are closed and no
+</textarea>, which ends a slide's markdown early. Slides shared from other
+decks are checked too, and must exist.
+
+Unpaired ** bold markers, links missing their closing parenthesis, and
+two-column slides without a ||| separator are reported as warnings.
+
+The command fails when any presentation has problems, so it can gate a
+review or CI job. --path takes glob patterns to check many files at once.
+
+Examples:
+  pres validate --path presentations/my-talk.json
+  pres validate --path 'presentations/*.json' --strict`,
+	Args: cobra.NoArgs,
+	RunE: runValidate,
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().StringSliceVarP(&validatePaths, "path", "p", nil, "Path or glob pattern of presentation JSON files (required, can be repeated)")
+	validateCmd.MarkFlagRequired("path")
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	paths, err := presentation.MatchPresentations(validatePaths)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no files match %s", strings.Join(validatePaths, ", "))
+	}
+
+	writer := newWriter()
+	var failed []string
+	for i, path := range paths {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("🔍 Validating %s\n\n", path)
+		if !validatePresentation(writer, path) {
+			failed = append(failed, path)
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	fmt.Printf("\nNext steps:\n")
+	fmt.Printf("  • Review the slides: pres info --path %s --slides\n", failed[0])
+	fmt.Printf("  • Roll back a bad update: pres undo --path %s\n", failed[0])
+
+	if len(paths) == 1 {
+		return fmt.Errorf("%s has problems", failed[0])
+	}
+	return fmt.Errorf("%d of %d presentations have problems", len(failed), len(paths))
+}
+
+// validatePresentation prints the problems in the presentation at path and
+// reports whether it passed: it may have warnings, but no errors
+func validatePresentation(writer *presentation.Writer, path string) bool {
+	data, err := writer.LoadPresentation(path)
+	if err != nil {
+		fmt.Printf("✗ %v\n", err)
+		return false
+	}
+	resolved, err := presentation.NewGenerator().ResolveReferences(data, path)
+	if err != nil {
+		fmt.Printf("✗ %v\n", err)
+		return false
+	}
+
+	problems, warnings := 0, 0
+	for _, issue := range resolved.Validate() {
+		mark := "✗"
+		if issue.Warning {
+			mark = "⚠"
+			warnings++
+		} else {
+			problems++
+		}
+		if issue.Slide < 0 {
+			fmt.Printf("%s %s\n", mark, issue.Message)
+			continue
+		}
+		slide := resolved.Slides[issue.Slide]
+		fmt.Printf("%s Slide %d %q: %s\n", mark, issue.Slide+1, slide.Title, issue.Message)
+	}
+
+	if problems > 0 {
+		return false
+	}
+	if warnings > 0 {
+		fmt.Println()
+	}
+	fmt.Printf("✓ %s is valid (%d slides)\n", path, len(resolved.Slides))
+	return true
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/geoffjay/pres/internal/web"
+	"github.com/spf13/cobra"
+)
+
+var (
+	webDir  string
+	webAddr string
+)
+
+var webCmd = &cobra.Command{
+	Use:   "web",
+	Short: "Browse the presentation library in a web browser",
+	Long: `Start a local web server for browsing the presentation library.
+
+The dashboard aggregates statistics across every presentation in the
+directory: decks per tag, average length, most reused shared slides,
+and recently modified decks.
+
+Examples:
+  pres web
+  pres web --dir talks --addr localhost:9000`,
+	Args: cobra.NoArgs,
+	RunE: runWeb,
+}
+
+func init() {
+	rootCmd.AddCommand(webCmd)
+
+	webCmd.Flags().StringVarP(&webDir, "dir", "d", "", "Directory containing presentations (default: the project's presentations directory)")
+	webCmd.Flags().StringVar(&webAddr, "addr", "localhost:8080", "Address to listen on")
+}
+
+func runWeb(cmd *cobra.Command, args []string) error {
+	if webDir == "" {
+		webDir = presentationsDir()
+	}
+	server := web.NewServer(webDir)
+
+	fmt.Printf("🌐 Serving %s\n", webDir)
+	fmt.Printf("  Dashboard: http://%s/\n", webAddr)
+	fmt.Printf("\nPress Ctrl+C to stop\n")
+
+	return http.ListenAndServe(webAddr, server.Handler())
+}
@@ -0,0 +1,90 @@
+// Package article fetches a web page, such as a blog post or a shared Google
+// Doc, and extracts its main content with readability parsing, leaving out
+// navigation, ads, and other page furniture.
+package article
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	readability "github.com/go-shiori/go-readability"
+	"golang.org/x/net/html"
+)
+
+// maxPageSize caps how much of a page is read
+const maxPageSize = 10 << 20
+
+// googleDocPattern matches the URL of a Google Doc, capturing its ID
+var googleDocPattern = regexp.MustCompile(`^https://docs\.google\.com/document/d/([A-Za-z0-9_-]+)`)
+
+// Article is the main content of a web page
+type Article struct {
+	Title   string
+	Byline  string
+	Excerpt string
+	// Content is the article's HTML, cleaned of everything but its text,
+	// headings, lists, tables, code, and images
+	Content *html.Node
+}
+
+// Fetch downloads the page at rawURL and extracts its article. A Google Doc
+// is fetched as its HTML export, which works for documents shared with
+// anyone who has the link.
+func Fetch(ctx context.Context, rawURL string) (*Article, error) {
+	pageURL, err := url.Parse(exportURL(rawURL))
+	if err != nil || (pageURL.Scheme != "http" && pageURL.Scheme != "https") {
+		return nil, fmt.Errorf("invalid URL %q: expected an http or https URL", rawURL)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/html")
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		if googleDocPattern.MatchString(rawURL) && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			return nil, fmt.Errorf("GET %s: %s (share the document with anyone who has the link)", rawURL, resp.Status)
+		}
+		return nil, fmt.Errorf("GET %s: %s", rawURL, resp.Status)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" && !strings.Contains(contentType, "html") {
+		return nil, fmt.Errorf("GET %s: expected an HTML page, got %s", rawURL, contentType)
+	}
+
+	parsed, err := readability.FromReader(io.LimitReader(resp.Body, maxPageSize), pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the article from %s: %w", rawURL, err)
+	}
+	if parsed.Node == nil {
+		return nil, fmt.Errorf("no article found at %s", rawURL)
+	}
+
+	return &Article{
+		Title:   strings.TrimSpace(parsed.Title),
+		Byline:  strings.TrimSpace(parsed.Byline),
+		Excerpt: strings.TrimSpace(parsed.Excerpt),
+		Content: parsed.Node,
+	}, nil
+}
+
+// exportURL returns the URL of a Google Doc's HTML export, or any other URL
+// as it is
+func exportURL(rawURL string) string {
+	match := googleDocPattern.FindStringSubmatch(rawURL)
+	if match == nil {
+		return rawURL
+	}
+	return "https://docs.google.com/document/d/" + match[1] + "/export?format=html"
+}
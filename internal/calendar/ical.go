@@ -0,0 +1,221 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event holds the fields of a calendar event used to seed a presentation
+type Event struct {
+	Summary     string
+	Description string
+	Location    string
+	Organizer   string
+	Attendees   []string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+}
+
+// Duration returns the length of the event, or zero if it has no end
+func (e *Event) Duration() time.Duration {
+	if e.Start.IsZero() || e.End.IsZero() {
+		return 0
+	}
+	return e.End.Sub(e.Start)
+}
+
+// property is a single content line: NAME;PARAM=VALUE:value
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// durationPattern matches ISO 8601 durations as used by iCalendar
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ParseICS reads the first event from an iCalendar (RFC 5545) file
+func ParseICS(r io.Reader) (*Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var event *Event
+	var duration time.Duration
+	for _, line := range lines {
+		prop, ok := parseProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			if event == nil {
+				event = &Event{}
+			}
+			continue
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT") && event != nil:
+			if event.End.IsZero() && duration != 0 {
+				event.End = event.Start.Add(duration)
+			}
+			return event, nil
+		}
+
+		if event == nil {
+			continue
+		}
+
+		switch prop.name {
+		case "SUMMARY":
+			event.Summary = unescape(prop.value)
+		case "DESCRIPTION":
+			event.Description = unescape(prop.value)
+		case "LOCATION":
+			event.Location = unescape(prop.value)
+		case "ORGANIZER":
+			event.Organizer = personName(prop)
+		case "ATTENDEE":
+			event.Attendees = append(event.Attendees, personName(prop))
+		case "DTSTART":
+			event.Start, event.AllDay, err = parseDateTime(prop)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DTSTART: %w", err)
+			}
+		case "DTEND":
+			event.End, _, err = parseDateTime(prop)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DTEND: %w", err)
+			}
+		case "DURATION":
+			duration, err = parseDuration(prop.value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid DURATION: %w", err)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no event found in calendar file")
+}
+
+// unfold joins continuation lines, which start with a space or tab
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read calendar file: %w", err)
+	}
+	return lines, nil
+}
+
+// parseProperty splits a content line into its name, parameters, and value
+func parseProperty(line string) (property, bool) {
+	// The value starts at the first colon outside a quoted parameter value
+	inQuotes := false
+	split := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		} else if r == ':' && !inQuotes {
+			split = i
+			break
+		}
+	}
+	if split < 0 {
+		return property{}, false
+	}
+
+	parts := strings.Split(line[:split], ";")
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  line[split+1:],
+	}
+	for _, param := range parts[1:] {
+		if key, value, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+		}
+	}
+	return prop, true
+}
+
+// parseDateTime parses a DATE or DATE-TIME value, honouring TZID
+func parseDateTime(prop property) (time.Time, bool, error) {
+	value := prop.value
+	if prop.params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, time.Local)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	loc := time.Local
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+// parseDuration parses an iCalendar duration such as PT1H30M
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(value)
+	if m == nil {
+		return 0, fmt.Errorf("unrecognised duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var total time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, err
+		}
+		total += time.Duration(n) * unit
+	}
+	if m[1] == "-" {
+		total = -total
+	}
+	return total, nil
+}
+
+// personName returns the display name of an ORGANIZER or ATTENDEE, falling
+// back to the address
+func personName(prop property) string {
+	if name := prop.params["CN"]; name != "" {
+		return name
+	}
+	value := prop.value
+	if len(value) > len("mailto:") && strings.EqualFold(value[:len("mailto:")], "mailto:") {
+		value = value[len("mailto:"):]
+	}
+	return value
+}
+
+// unescape decodes iCalendar TEXT escapes
+func unescape(value string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,164 @@
+package datasource
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/platform"
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// maxResponseSize caps how much is read from a URL source
+const maxResponseSize = 10 << 20
+
+// Fetch loads a data source and returns the variables it provides. Relative
+// paths are resolved against baseDir, normally the deck's directory.
+//
+//   - csv: {{name}} is a markdown table, {{name.rows}} the row count, and
+//     {{name.<column>}} the column's value in the last row (the latest reading)
+//   - json: {{name}} is the document; for an object, {{name.<key>}} is each
+//     top-level scalar value
+//   - text: {{name}} is the trimmed content
+func Fetch(ctx context.Context, source presentation.DataSource, baseDir string) (map[string]string, error) {
+	content, err := read(ctx, source, baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("source %q: %w", source.Name, err)
+	}
+
+	var vars map[string]string
+	switch source.Type {
+	case "csv":
+		vars, err = csvVariables(source.Name, content)
+	case "json":
+		vars, err = jsonVariables(source.Name, content)
+	case "text":
+		vars = map[string]string{source.Name: strings.TrimSpace(string(content))}
+	default:
+		err = fmt.Errorf("unknown type %q (expected csv, json, or text)", source.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("source %q: %w", source.Name, err)
+	}
+
+	return vars, nil
+}
+
+// read returns the raw content of a source from its path or URL
+func read(ctx context.Context, source presentation.DataSource, baseDir string) ([]byte, error) {
+	switch {
+	case source.Path != "":
+		path := platform.LocalPath(source.Path)
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(baseDir, path)
+		}
+		return os.ReadFile(path)
+	case source.URL != "":
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source.URL, nil)
+		if err != nil {
+			return nil, err
+		}
+		client := &http.Client{Timeout: 30 * time.Second}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("GET %s: %s", source.URL, resp.Status)
+		}
+		return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	default:
+		return nil, fmt.Errorf("no path or url given")
+	}
+}
+
+// csvVariables renders CSV data as a markdown table plus per-column values
+func csvVariables(name string, content []byte) (map[string]string, error) {
+	records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("CSV is empty")
+	}
+
+	header, rows := records[0], records[1:]
+
+	var sb strings.Builder
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for _, cell := range cells {
+			sb.WriteString(" " + strings.ReplaceAll(cell, "|", `\|`) + " |")
+		}
+		sb.WriteString("\n")
+	}
+	writeRow(header)
+	sb.WriteString(strings.Repeat("| --- ", len(header)) + "|\n")
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	vars := map[string]string{
+		name:           strings.TrimRight(sb.String(), "\n"),
+		name + ".rows": strconv.Itoa(len(rows)),
+	}
+	if len(rows) > 0 {
+		last := rows[len(rows)-1]
+		for i, column := range header {
+			if i < len(last) {
+				vars[name+"."+strings.TrimSpace(column)] = last[i]
+			}
+		}
+	}
+
+	return vars, nil
+}
+
+// jsonVariables exposes a JSON document and its top-level scalar values
+func jsonVariables(name string, content []byte) (map[string]string, error) {
+	var doc any
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	vars := map[string]string{}
+	if value, ok := scalar(doc); ok {
+		vars[name] = value
+		return vars, nil
+	}
+
+	vars[name] = strings.TrimSpace(string(content))
+	if object, ok := doc.(map[string]any); ok {
+		for key, field := range object {
+			if value, ok := scalar(field); ok {
+				vars[name+"."+key] = value
+			}
+		}
+	}
+
+	return vars, nil
+}
+
+// scalar formats a JSON string, number, or boolean
+func scalar(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
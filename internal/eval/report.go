@@ -0,0 +1,163 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultThreshold is how far a score may drop below the baseline before it
+// is reported as a regression
+const DefaultThreshold = 0.5
+
+// Report holds the results of running a suite
+type Report struct {
+	Suite   string    `json:"suite"`
+	Started time.Time `json:"started"`
+	// Judge is the client that scored the decks, empty for the default
+	Judge   string   `json:"judge,omitempty"`
+	Rubric  []string `json:"rubric"`
+	Results []Result `json:"results"`
+}
+
+// Result is the outcome of generating and judging one case with one model
+type Result struct {
+	Case string `json:"case"`
+	// Model is the client the deck was generated with, empty for the default
+	Model   string  `json:"model,omitempty"`
+	Slides  int     `json:"slides,omitempty"`
+	Scores  []Score `json:"scores,omitempty"`
+	Overall float64 `json:"overall"`
+	Summary string  `json:"summary,omitempty"`
+	// Error is set when the deck could not be generated or judged
+	Error string `json:"error,omitempty"`
+}
+
+// Score is the judge's score for one rubric criterion, from 1 to 5
+type Score struct {
+	Criterion string `json:"criterion"`
+	Score     int    `json:"score"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Regression is a score that dropped below the baseline by more than the
+// threshold, or a case that failed where the baseline passed
+type Regression struct {
+	Case      string
+	Model     string
+	Criterion string
+	Before    float64
+	After     float64
+	Error     string
+}
+
+// NewResult computes the overall score of a judged case as the mean of its
+// criterion scores
+func NewResult(name, model string, slides int, scores []Score, summary string) Result {
+	result := Result{Case: name, Model: model, Slides: slides, Scores: scores, Summary: summary}
+	if len(scores) > 0 {
+		total := 0
+		for _, score := range scores {
+			total += score.Score
+		}
+		result.Overall = float64(total) / float64(len(scores))
+	}
+	return result
+}
+
+// Mean returns the mean overall score of the results that did not fail
+func (r *Report) Mean(model string) float64 {
+	total, count := 0.0, 0
+	for _, result := range r.Results {
+		if result.Model == model && result.Error == "" {
+			total += result.Overall
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
+
+// Models returns the models in the report, in the order they were run
+func (r *Report) Models() []string {
+	var models []string
+	seen := make(map[string]bool)
+	for _, result := range r.Results {
+		if !seen[result.Model] {
+			seen[result.Model] = true
+			models = append(models, result.Model)
+		}
+	}
+	return models
+}
+
+// Save writes the report to path as JSON
+func (r *Report) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// LoadReport reads a report saved by Save
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse report %s: %w", path, err)
+	}
+	return &report, nil
+}
+
+// Compare returns the regressions of the current report against the
+// baseline. Results are matched by case and model; cases that are missing
+// from either report are not compared.
+func Compare(baseline, current *Report, threshold float64) []Regression {
+	before := make(map[[2]string]Result)
+	for _, result := range baseline.Results {
+		before[[2]string{result.Case, result.Model}] = result
+	}
+
+	var regressions []Regression
+	for _, after := range current.Results {
+		base, ok := before[[2]string{after.Case, after.Model}]
+		if !ok || base.Error != "" {
+			continue
+		}
+		if after.Error != "" {
+			regressions = append(regressions, Regression{Case: after.Case, Model: after.Model, Before: base.Overall, Error: after.Error})
+			continue
+		}
+		if base.Overall-after.Overall > threshold {
+			regressions = append(regressions, Regression{Case: after.Case, Model: after.Model, Criterion: "overall", Before: base.Overall, After: after.Overall})
+		}
+		for _, score := range after.Scores {
+			for _, old := range base.Scores {
+				if old.Criterion == score.Criterion && float64(old.Score-score.Score) > threshold {
+					regressions = append(regressions, Regression{
+						Case:      after.Case,
+						Model:     after.Model,
+						Criterion: score.Criterion,
+						Before:    float64(old.Score),
+						After:     float64(score.Score),
+					})
+				}
+			}
+		}
+	}
+	return regressions
+}
@@ -0,0 +1,100 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DefaultRubric is used for suites that do not define their own criteria
+var DefaultRubric = []string{
+	"Coverage: the slides cover everything the description asks for",
+	"Structure: the slides follow a clear, logical flow from introduction to conclusion",
+	"Density: each slide makes one point with a few concise bullets",
+	"Accuracy: the content is correct and free of invented facts",
+	"Notes: the speaker notes add to the slides instead of repeating them",
+}
+
+// Suite is a set of fixture descriptions that are run through generation and
+// judged against a rubric
+type Suite struct {
+	Name string `json:"name"`
+	// Rubric lists the criteria each generated deck is scored on
+	Rubric []string `json:"rubric,omitempty"`
+	Cases  []Case   `json:"cases"`
+}
+
+// Case is a single fixture: the description and Q&A answers a deck is
+// generated from
+type Case struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Answers     []string `json:"answers,omitempty"`
+}
+
+// LoadSuite reads a suite from a JSON file
+func LoadSuite(path string) (*Suite, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read suite: %w", err)
+	}
+
+	var suite Suite
+	if err := json.Unmarshal(data, &suite); err != nil {
+		return nil, fmt.Errorf("failed to parse suite %s: %w", path, err)
+	}
+	if err := suite.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid suite %s: %w", path, err)
+	}
+	if len(suite.Rubric) == 0 {
+		suite.Rubric = DefaultRubric
+	}
+
+	return &suite, nil
+}
+
+// Validate checks that the suite has uniquely named cases with descriptions
+func (s *Suite) Validate() error {
+	if len(s.Cases) == 0 {
+		return fmt.Errorf("no cases")
+	}
+	seen := make(map[string]bool)
+	for i, c := range s.Cases {
+		if strings.TrimSpace(c.Name) == "" {
+			return fmt.Errorf("case %d has no name", i+1)
+		}
+		if seen[c.Name] {
+			return fmt.Errorf("duplicate case %q", c.Name)
+		}
+		seen[c.Name] = true
+		if strings.TrimSpace(c.Description) == "" {
+			return fmt.Errorf("case %q has no description", c.Name)
+		}
+	}
+	return nil
+}
+
+// Filter returns the cases whose names are listed, or every case when names
+// is empty
+func (s *Suite) Filter(names []string) ([]Case, error) {
+	if len(names) == 0 {
+		return s.Cases, nil
+	}
+
+	var cases []Case
+	for _, name := range names {
+		found := false
+		for _, c := range s.Cases {
+			if c.Name == name {
+				cases = append(cases, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("suite has no case %q", name)
+		}
+	}
+	return cases, nil
+}
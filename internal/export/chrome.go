@@ -0,0 +1,105 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// chromeNames are the executable names Chrome and Chromium are installed
+// under, in order of preference
+var chromeNames = []string{
+	"google-chrome",
+	"google-chrome-stable",
+	"chromium",
+	"chromium-browser",
+	"chrome",
+	"msedge",
+}
+
+// chromePaths are the install locations checked when no executable is found
+// on the PATH
+var chromePaths = []string{
+	"/Applications/Google Chrome.app/Contents/MacOS/Google Chrome",
+	"/Applications/Chromium.app/Contents/MacOS/Chromium",
+	`C:\Program Files\Google\Chrome\Application\chrome.exe`,
+	`C:\Program Files (x86)\Google\Chrome\Application\chrome.exe`,
+}
+
+// ErrChromeNotFound is returned when no Chrome or Chromium executable is found
+var ErrChromeNotFound = errors.New("Chrome or Chromium not found (install it or set PRES_CHROME to its path)")
+
+// FindChrome returns the path of the Chrome or Chromium executable to print
+// with: PRES_CHROME when set, else the first one found
+func FindChrome() (string, error) {
+	if chrome := os.Getenv("PRES_CHROME"); chrome != "" {
+		return chrome, nil
+	}
+	for _, name := range chromeNames {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	for _, path := range chromePaths {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", ErrChromeNotFound
+}
+
+// PrintPDF prints a generated reveal.js deck to a PDF with headless Chrome.
+// The deck is opened with ?print-pdf, so reveal.js lays out one slide per
+// page and honors the slides' page-break hints.
+func PrintPDF(ctx context.Context, chrome, htmlPath, outputPath string, timeout time.Duration) error {
+	htmlPath, err := filepath.Abs(htmlPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve deck path: %w", err)
+	}
+	outputPath, err = filepath.Abs(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve output path: %w", err)
+	}
+
+	page := url.URL{Scheme: "file", Path: filepath.ToSlash(htmlPath), RawQuery: "print-pdf"}
+	if !strings.HasPrefix(page.Path, "/") {
+		// Windows paths, e.g. C:/talks/deck.html
+		page.Path = "/" + page.Path
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, chrome,
+		"--headless=new",
+		"--disable-gpu",
+		"--no-sandbox",
+		"--no-pdf-header-footer",
+		"--run-all-compositor-stages-before-draw",
+		// Give reveal.js, fonts, and images time to load before printing
+		fmt.Sprintf("--virtual-time-budget=%d", (timeout/2).Milliseconds()),
+		"--print-to-pdf="+outputPath,
+		page.String(),
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("printing took longer than %s", timeout)
+		}
+		return fmt.Errorf("chrome failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	if info, err := os.Stat(outputPath); err != nil || info.Size() == 0 {
+		return fmt.Errorf("chrome did not write a PDF: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
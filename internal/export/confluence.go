@@ -0,0 +1,182 @@
+package export
+
+import (
+	"fmt"
+	"html/template"
+	"regexp"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// slideLinkPattern matches a markdown link to a slide anchor in the generated
+// deck, which has no equivalent in Confluence
+var slideLinkPattern = regexp.MustCompile(`\[([^\]]*)\]\(#/slide-[^)]*\)`)
+
+// ConfluencePage is a page in Confluence storage format, with optional child
+// pages
+type ConfluencePage struct {
+	Title    string
+	Body     string
+	Children []ConfluencePage
+}
+
+// ConfluencePages converts a presentation, readied with Generator.Prepare, to
+// Confluence pages. Each slide
+// becomes a heading followed by a panel holding its content, with speaker
+// notes in an info panel. With tree set, every title slide after the first
+// starts a child page holding its section, and the top-level page lists the
+// sections.
+func ConfluencePages(data *presentation.PresentationData, tree bool) (ConfluencePage, error) {
+	root := ConfluencePage{Title: data.Metadata.Title}
+
+	var sb strings.Builder
+	writeConfluenceIntro(&sb, data.Metadata)
+
+	var section *ConfluencePage
+	var sectionBody strings.Builder
+
+	flush := func() {
+		if section != nil {
+			section.Body = sectionBody.String()
+			root.Children = append(root.Children, *section)
+			sectionBody.Reset()
+		}
+	}
+
+	for i, slide := range data.Slides {
+		if tree && i > 0 && slide.Layout == "title" && slide.Title != "" {
+			flush()
+			section = &ConfluencePage{Title: data.Metadata.Title + ": " + slide.Title}
+		}
+
+		target := &sb
+		if section != nil {
+			target = &sectionBody
+		}
+		if err := writeConfluenceSlide(target, slide); err != nil {
+			return ConfluencePage{}, fmt.Errorf("slide %d: %w", i+1, err)
+		}
+	}
+	flush()
+
+	if len(root.Children) > 0 {
+		sb.WriteString(`<h2>Sections</h2>`)
+		sb.WriteString(`<ac:structured-macro ac:name="children" />`)
+	}
+	root.Body = sb.String()
+
+	return root, nil
+}
+
+// writeConfluenceIntro writes the deck's subtitle, author, and date, and its
+// custom fields as a table
+func writeConfluenceIntro(sb *strings.Builder, metadata presentation.Metadata) {
+	if metadata.Subtitle != "" {
+		fmt.Fprintf(sb, "<p><em>%s</em></p>", template.HTMLEscapeString(metadata.Subtitle))
+	}
+
+	var details []string
+	if metadata.Author != "" {
+		details = append(details, template.HTMLEscapeString(metadata.Author))
+	}
+	if metadata.Date != "" {
+		details = append(details, template.HTMLEscapeString(metadata.Date))
+	}
+	if len(details) > 0 {
+		fmt.Fprintf(sb, "<p>%s</p>", strings.Join(details, " · "))
+	}
+
+	if len(metadata.Custom) > 0 {
+		sb.WriteString("<table><tbody>")
+		for _, key := range metadata.GetCustomKeys() {
+			fmt.Fprintf(sb, "<tr><th>%s</th><td>%s</td></tr>", template.HTMLEscapeString(key), template.HTMLEscapeString(metadata.Custom[key]))
+		}
+		sb.WriteString("</tbody></table>")
+	}
+}
+
+// writeConfluenceSlide writes a single slide in storage format
+func writeConfluenceSlide(sb *strings.Builder, slide presentation.Slide) error {
+	// Links between slides become plain text
+	slide.Content = slideLinkPattern.ReplaceAllString(slide.Content, "$1")
+
+	// Title slides introduce a section, so they get a larger heading and no panel
+	if slide.Layout == "title" {
+		if slide.Title != "" {
+			fmt.Fprintf(sb, "<h1>%s</h1>", template.HTMLEscapeString(slide.Title))
+		}
+		if slide.Content != "" {
+			content, err := markdownToXHTML(slide.Content)
+			if err != nil {
+				return err
+			}
+			sb.WriteString(content)
+		}
+		writeConfluenceFootnotes(sb, slide.Footnotes)
+		return writeConfluenceNotes(sb, slide.Notes)
+	}
+
+	if slide.Title != "" {
+		fmt.Fprintf(sb, "<h2>%s</h2>", template.HTMLEscapeString(slide.Title))
+	}
+
+	if slide.Content != "" {
+		var body string
+		switch slide.Layout {
+		case "two-column":
+			var cells strings.Builder
+			for _, col := range presentation.SplitColumns(slide.Content) {
+				content, err := markdownToXHTML(strings.TrimSpace(col))
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(&cells, "<td>%s</td>", content)
+			}
+			body = "<table><tbody><tr>" + cells.String() + "</tr></tbody></table>"
+		default:
+			content, err := markdownToXHTML(slide.Content)
+			if err != nil {
+				return err
+			}
+			body = content
+		}
+
+		sb.WriteString(`<ac:structured-macro ac:name="panel">`)
+		if slide.Background_color != "" {
+			fmt.Fprintf(sb, `<ac:parameter ac:name="borderColor">%s</ac:parameter>`, template.HTMLEscapeString(slide.Background_color))
+		}
+		fmt.Fprintf(sb, "<ac:rich-text-body>%s</ac:rich-text-body>", body)
+		sb.WriteString(`</ac:structured-macro>`)
+	}
+
+	writeConfluenceFootnotes(sb, slide.Footnotes)
+
+	return writeConfluenceNotes(sb, slide.Notes)
+}
+
+// writeConfluenceFootnotes writes a slide's footnotes in small print
+func writeConfluenceFootnotes(sb *strings.Builder, footnotes []presentation.Footnote) {
+	for _, note := range footnotes {
+		fmt.Fprintf(sb, "<p><small>%d. %s</small></p>", note.Number, template.HTMLEscapeString(note.Text))
+	}
+}
+
+// writeConfluenceNotes writes speaker notes as an info panel
+func writeConfluenceNotes(sb *strings.Builder, notes string) error {
+	if notes == "" {
+		return nil
+	}
+
+	content, err := markdownToXHTML(notes)
+	if err != nil {
+		return err
+	}
+
+	sb.WriteString(`<ac:structured-macro ac:name="info">`)
+	sb.WriteString(`<ac:parameter ac:name="title">Speaker notes</ac:parameter>`)
+	fmt.Fprintf(sb, "<ac:rich-text-body>%s</ac:rich-text-body>", content)
+	sb.WriteString(`</ac:structured-macro>`)
+
+	return nil
+}
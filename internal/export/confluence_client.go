@@ -0,0 +1,191 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ConfluenceConfig holds the settings for publishing to a Confluence site
+type ConfluenceConfig struct {
+	BaseURL  string // Site URL including the context path, e.g. https://example.atlassian.net/wiki
+	Username string // Account email for Confluence Cloud; leave empty to use Token as a personal access token
+	Token    string // API token (Cloud) or personal access token (Data Center)
+	Space    string // Space key to publish into
+	ParentID string // Optional ID of the page to publish under
+}
+
+// ConfluenceClient publishes pages through the Confluence REST API
+type ConfluenceClient struct {
+	config ConfluenceConfig
+	http   *http.Client
+}
+
+// NewConfluenceClient creates a client for the configured site
+func NewConfluenceClient(config ConfluenceConfig) *ConfluenceClient {
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	return &ConfluenceClient{
+		config: config,
+		http:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// confluenceContent is the subset of the content resource used by pres
+type confluenceContent struct {
+	ID        string               `json:"id,omitempty"`
+	Type      string               `json:"type"`
+	Title     string               `json:"title"`
+	Space     *confluenceSpace     `json:"space,omitempty"`
+	Ancestors []confluenceAncestor `json:"ancestors,omitempty"`
+	Body      *confluenceBody      `json:"body,omitempty"`
+	Version   *confluenceVersion   `json:"version,omitempty"`
+	Links     struct {
+		WebUI string `json:"webui"`
+	} `json:"_links"`
+}
+
+type confluenceSpace struct {
+	Key string `json:"key"`
+}
+
+type confluenceAncestor struct {
+	ID string `json:"id"`
+}
+
+type confluenceBody struct {
+	Storage struct {
+		Value          string `json:"value"`
+		Representation string `json:"representation"`
+	} `json:"storage"`
+}
+
+type confluenceVersion struct {
+	Number int `json:"number"`
+}
+
+// Publish creates or updates page and its children, returning the URL of the
+// top-level page. Pages are matched by title within the space, so publishing
+// the same deck again updates its pages in place.
+func (c *ConfluenceClient) Publish(ctx context.Context, page ConfluencePage) (string, error) {
+	return c.publish(ctx, page, c.config.ParentID)
+}
+
+func (c *ConfluenceClient) publish(ctx context.Context, page ConfluencePage, parentID string) (string, error) {
+	content, err := c.upsertPage(ctx, page, parentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to publish %q: %w", page.Title, err)
+	}
+
+	for _, child := range page.Children {
+		if _, err := c.publish(ctx, child, content.ID); err != nil {
+			return "", err
+		}
+	}
+
+	return c.config.BaseURL + content.Links.WebUI, nil
+}
+
+// upsertPage updates the page with the same title in the space, or creates it
+func (c *ConfluenceClient) upsertPage(ctx context.Context, page ConfluencePage, parentID string) (*confluenceContent, error) {
+	existing, err := c.findPage(ctx, page.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	content := confluenceContent{
+		Type:  "page",
+		Title: page.Title,
+		Space: &confluenceSpace{Key: c.config.Space},
+		Body:  &confluenceBody{},
+	}
+	content.Body.Storage.Value = page.Body
+	content.Body.Storage.Representation = "storage"
+	if parentID != "" {
+		content.Ancestors = []confluenceAncestor{{ID: parentID}}
+	}
+
+	var result confluenceContent
+	if existing == nil {
+		err = c.do(ctx, http.MethodPost, "/rest/api/content", content, &result)
+	} else {
+		content.ID = existing.ID
+		content.Version = &confluenceVersion{Number: existing.Version.Number + 1}
+		err = c.do(ctx, http.MethodPut, "/rest/api/content/"+url.PathEscape(existing.ID), content, &result)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// findPage returns the page with the given title in the space, or nil
+func (c *ConfluenceClient) findPage(ctx context.Context, title string) (*confluenceContent, error) {
+	query := url.Values{}
+	query.Set("spaceKey", c.config.Space)
+	query.Set("title", title)
+	query.Set("expand", "version")
+
+	var result struct {
+		Results []confluenceContent `json:"results"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/rest/api/content?"+query.Encode(), nil, &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Results) == 0 {
+		return nil, nil
+	}
+	return &result.Results[0], nil
+}
+
+// do sends an authenticated JSON request and decodes the response into out
+func (c *ConfluenceClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.config.BaseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.config.Token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.config.Token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
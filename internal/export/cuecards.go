@@ -0,0 +1,180 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// cueCardPoints is the number of key points on a card
+const cueCardPoints = 3
+
+var (
+	// cueListMarker matches the heading, list, or quote marker at the start of a line
+	cueListMarker = regexp.MustCompile(`^\s*(#+|[-*+>]|\d+[.)])\s+`)
+
+	// cueLinkPattern matches a markdown link or image, keeping its text
+	cueLinkPattern = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+
+	// cueEmphasis removes markdown emphasis and code markers
+	cueEmphasis = strings.NewReplacer("**", "", "__", "", "`", "")
+)
+
+// CueCard is a rehearsal card for one slide
+type CueCard struct {
+	Number int
+	Title  string
+	Points []string
+
+	// Start is when the slide should start, counted from the start of the
+	// talk, and Budget how long it should take. Backup slides have no budget.
+	Start  time.Duration
+	Budget time.Duration
+	Backup bool
+}
+
+// CueCards builds one card per slide of a presentation readied with
+// Generator.Prepare. Each slide gets its time budget, or an estimate from the
+// length of its speaker notes; when duration is set the estimates are scaled
+// to fill the time left by the explicit budgets. Hidden slides, which follow
+// the main flow, are backup slides without a budget.
+func CueCards(data *presentation.PresentationData, duration time.Duration) []CueCard {
+	cards := make([]CueCard, 0, len(data.Slides))
+	estimated := make([]bool, len(data.Slides))
+	var fixed, total time.Duration
+	for i, slide := range data.Slides {
+		card := CueCard{
+			Number: i + 1,
+			Title:  slide.Title,
+			Points: keyPoints(slide, cueCardPoints),
+			Backup: slide.Hidden,
+		}
+		if !card.Backup {
+			card.Budget = slide.GetTimeBudget()
+			if slide.TimeBudgetSeconds > 0 {
+				fixed += card.Budget
+			} else {
+				estimated[i] = true
+				total += card.Budget
+			}
+		}
+		cards = append(cards, card)
+	}
+
+	// Scale the estimates to the time left in the slot
+	if duration > fixed && total > 0 {
+		scale := float64(duration-fixed) / float64(total)
+		for i := range cards {
+			if estimated[i] {
+				cards[i].Budget = time.Duration(float64(cards[i].Budget) * scale).Round(time.Second)
+			}
+		}
+	}
+
+	var start time.Duration
+	for i := range cards {
+		if cards[i].Backup {
+			continue
+		}
+		cards[i].Start = start
+		start += cards[i].Budget
+	}
+
+	return cards
+}
+
+// keyPoints returns up to n points from a slide: its list items or
+// paragraphs, falling back to the first sentences of the speaker notes
+func keyPoints(slide presentation.Slide, n int) []string {
+	var points []string
+	inCode := false
+	for _, line := range strings.Split(slide.Content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode || trimmed == "|||" || trimmed == "---" || strings.HasPrefix(trimmed, "|") ||
+			strings.HasPrefix(trimmed, "Table:") {
+			continue
+		}
+		if point := plainText(cueListMarker.ReplaceAllString(line, "")); point != "" {
+			points = append(points, point)
+		}
+		if len(points) == n {
+			return points
+		}
+	}
+
+	for _, sentence := range splitSentences(slide.Notes) {
+		if len(points) == n {
+			break
+		}
+		points = append(points, sentence)
+	}
+
+	return points
+}
+
+// plainText strips markdown formatting and footnote markers from a line
+func plainText(line string) string {
+	line = cueLinkPattern.ReplaceAllString(line, "$1")
+	line = cueEmphasis.Replace(line)
+	line = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.No, r) {
+			return -1
+		}
+		return r
+	}, line)
+	return strings.TrimSpace(line)
+}
+
+// splitSentences splits text into trimmed sentences
+func splitSentences(text string) []string {
+	var sentences []string
+	var sb strings.Builder
+	for _, r := range strings.Join(strings.Fields(text), " ") {
+		sb.WriteRune(r)
+		if r == '.' || r == '!' || r == '?' {
+			if sentence := strings.TrimSpace(sb.String()); sentence != "" {
+				sentences = append(sentences, sentence)
+			}
+			sb.Reset()
+		}
+	}
+	if sentence := strings.TrimSpace(sb.String()); sentence != "" {
+		sentences = append(sentences, sentence)
+	}
+	return sentences
+}
+
+// cardTiming describes the timing of a card, e.g. "1:30 (4:30 - 6:00)"
+func cardTiming(card CueCard) string {
+	if card.Backup {
+		return "backup"
+	}
+	return fmt.Sprintf("%s (%s - %s)", presentation.FormatClock(card.Budget), presentation.FormatClock(card.Start), presentation.FormatClock(card.Start+card.Budget))
+}
+
+// WriteCueCardsText writes cue cards as plain text, separated by rules
+func WriteCueCardsText(w io.Writer, title string, cards []CueCard) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s\n", title)
+	for _, card := range cards {
+		sb.WriteString(strings.Repeat("-", 60) + "\n")
+		fmt.Fprintf(&sb, "Card %d of %d  |  %s\n\n", card.Number, len(cards), cardTiming(card))
+		fmt.Fprintf(&sb, "%s\n", strings.ToUpper(card.Title))
+		for _, point := range card.Points {
+			fmt.Fprintf(&sb, "  * %s\n", point)
+		}
+	}
+	sb.WriteString(strings.Repeat("-", 60) + "\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
@@ -0,0 +1,315 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/text"
+)
+
+const (
+	// flowTitleWidth is the widest a slide title is shown in the tree
+	flowTitleWidth = 44
+
+	// flowMaxSectionSlides is the most slides a section should have before
+	// it likely needs splitting
+	flowMaxSectionSlides = 10
+)
+
+// FlowSlide is a slide in the storyline of a deck
+type FlowSlide struct {
+	Number int
+	Title  string
+	Layout string
+	Budget time.Duration
+	Backup bool
+}
+
+// FlowSection is a run of slides in the main flow started by a title slide,
+// or the slides before the first title slide. Opener is the title slide, if
+// any; Slides are the ones that follow it.
+type FlowSection struct {
+	Title  string
+	Opener *FlowSlide
+	Slides []FlowSlide
+}
+
+// Budget returns the time budgeted for the section, its opener included
+func (s FlowSection) Budget() time.Duration {
+	var total time.Duration
+	if s.Opener != nil {
+		total = s.Opener.Budget
+	}
+	for _, slide := range s.Slides {
+		total += slide.Budget
+	}
+	return total
+}
+
+// Count returns the number of slides in the section, its opener included
+func (s FlowSection) Count() int {
+	if s.Opener != nil {
+		return len(s.Slides) + 1
+	}
+	return len(s.Slides)
+}
+
+// Flow is the narrative structure of a deck: its sections in order, and the
+// hidden (backup) slides that follow the main flow
+type Flow struct {
+	Title    string
+	Sections []FlowSection
+	Backup   []FlowSlide
+}
+
+// Budget returns the time budgeted for the main flow
+func (f Flow) Budget() time.Duration {
+	var total time.Duration
+	for _, section := range f.Sections {
+		total += section.Budget()
+	}
+	return total
+}
+
+// StoryFlow builds the storyline of a presentation with references resolved
+// and variables expanded. Every title slide starts a section; hidden slides
+// are collected as backup slides.
+func StoryFlow(data *presentation.PresentationData) Flow {
+	flow := Flow{Title: data.Metadata.Title}
+	for i := range data.Slides {
+		slide := &data.Slides[i]
+		item := FlowSlide{
+			Number: i + 1,
+			Title:  slide.Title,
+			Layout: slide.Layout,
+			Backup: slide.Hidden,
+		}
+		if item.Backup {
+			flow.Backup = append(flow.Backup, item)
+			continue
+		}
+		item.Budget = slide.GetTimeBudget()
+
+		if slide.Layout == "title" {
+			flow.Sections = append(flow.Sections, FlowSection{Title: slide.Title, Opener: &item})
+			continue
+		}
+		if len(flow.Sections) == 0 {
+			flow.Sections = append(flow.Sections, FlowSection{})
+		}
+		last := &flow.Sections[len(flow.Sections)-1]
+		last.Slides = append(last.Slides, item)
+	}
+	return flow
+}
+
+// Problems lists structural problems worth fixing before polishing content:
+// sections with no slides or too many, sections that take far longer than
+// the others, untitled slides, and runs of slides sharing a title
+func (f Flow) Problems() []string {
+	var problems []string
+
+	average := time.Duration(0)
+	if len(f.Sections) > 0 {
+		average = f.Budget() / time.Duration(len(f.Sections))
+	}
+	for _, section := range f.Sections {
+		name := flowSectionName(section)
+		switch {
+		case section.Opener != nil && len(section.Slides) == 0:
+			problems = append(problems, fmt.Sprintf("%s has no slides after its title slide", name))
+		case len(section.Slides) > flowMaxSectionSlides:
+			problems = append(problems, fmt.Sprintf("%s has %d slides; consider splitting it", name, section.Count()))
+		}
+		if len(f.Sections) > 2 && section.Budget() > 2*average {
+			problems = append(problems, fmt.Sprintf("%s takes %s, more than twice the average section (%s)",
+				name, presentation.FormatClock(section.Budget()), presentation.FormatClock(average)))
+		}
+
+		for i, slide := range section.Slides {
+			if slide.Title == "" && slide.Layout != "blank" {
+				problems = append(problems, fmt.Sprintf("slide %d has no title", slide.Number))
+			}
+			if i > 0 && slide.Title != "" && strings.EqualFold(slide.Title, section.Slides[i-1].Title) {
+				problems = append(problems, fmt.Sprintf("slides %d and %d share the title %q; merge them or retitle one",
+					section.Slides[i-1].Number, slide.Number, slide.Title))
+			}
+		}
+	}
+	return problems
+}
+
+// flowSectionName names a section in problems
+func flowSectionName(section FlowSection) string {
+	if section.Opener == nil {
+		return "the opening (before the first title slide)"
+	}
+	if section.Title == "" {
+		return fmt.Sprintf("the section at slide %d", section.Opener.Number)
+	}
+	return fmt.Sprintf("section %q", section.Title)
+}
+
+// WriteFlowTree writes the storyline as a tree of sections and slides with
+// their time budgets
+func WriteFlowTree(w io.Writer, flow Flow) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s (%s)\n", flow.Title, presentation.FormatClock(flow.Budget()))
+
+	branches := len(flow.Sections)
+	if len(flow.Backup) > 0 {
+		branches++
+	}
+	for i, section := range flow.Sections {
+		label := "(opening)"
+		if section.Opener != nil {
+			label = flowLabel(*section.Opener)
+		}
+		last := i == branches-1
+		writeFlowBranch(&sb, "", last, label, presentation.FormatClock(section.Budget())+" · "+flowCount(section.Count()))
+
+		indent := "│   "
+		if last {
+			indent = "    "
+		}
+		for j, slide := range section.Slides {
+			writeFlowBranch(&sb, indent, j == len(section.Slides)-1, flowLabel(slide), presentation.FormatClock(slide.Budget))
+		}
+	}
+
+	if len(flow.Backup) > 0 {
+		writeFlowBranch(&sb, "", true, "Backup", flowCount(len(flow.Backup)))
+		for j, slide := range flow.Backup {
+			writeFlowBranch(&sb, "    ", j == len(flow.Backup)-1, flowLabel(slide), "")
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writeFlowBranch writes a line of the tree with its detail aligned on the
+// right
+func writeFlowBranch(sb *strings.Builder, indent string, last bool, label, detail string) {
+	branch := "├── "
+	if last {
+		branch = "└── "
+	}
+	line := indent + branch + label
+	if detail != "" {
+		pad := max(flowTitleWidth+8-text.Width(indent+label), 1)
+		line += strings.Repeat(" ", pad) + detail
+	}
+	sb.WriteString(line + "\n")
+}
+
+// flowCount describes a number of slides
+func flowCount(n int) string {
+	if n == 1 {
+		return "1 slide"
+	}
+	return fmt.Sprintf("%d slides", n)
+}
+
+// flowLabel names a slide in the tree: its number and title, cut to fit
+func flowLabel(slide FlowSlide) string {
+	title := slide.Title
+	if title == "" {
+		title = "(untitled)"
+	}
+	return fmt.Sprintf("%d. %s", slide.Number, text.Truncate(title, flowTitleWidth))
+}
+
+// WriteFlowMermaid writes the storyline as a Mermaid flowchart, with a
+// subgraph per section and the main flow linked in order
+func WriteFlowMermaid(w io.Writer, flow Flow) error {
+	var sb strings.Builder
+	sb.WriteString("flowchart TD\n")
+
+	var ids []string
+	for i, section := range flow.Sections {
+		title := "Opening"
+		if section.Opener != nil {
+			title = section.Opener.Title
+		}
+		fmt.Fprintf(&sb, "    subgraph section%d[\"%s (%s)\"]\n", i+1, mermaidText(title), presentation.FormatClock(section.Budget()))
+		for _, slide := range flowSlides(section) {
+			id := fmt.Sprintf("s%d", slide.Number)
+			fmt.Fprintf(&sb, "        %s[\"%s<br/>%s\"]\n", id, mermaidText(flowLabel(slide)), presentation.FormatClock(slide.Budget))
+			ids = append(ids, id)
+		}
+		sb.WriteString("    end\n")
+	}
+	if len(ids) > 1 {
+		fmt.Fprintf(&sb, "    %s\n", strings.Join(ids, " --> "))
+	}
+
+	if len(flow.Backup) > 0 {
+		sb.WriteString("    subgraph backup[\"Backup\"]\n")
+		for _, slide := range flow.Backup {
+			fmt.Fprintf(&sb, "        s%d[\"%s\"]\n", slide.Number, mermaidText(flowLabel(slide)))
+		}
+		sb.WriteString("    end\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// WriteFlowDOT writes the storyline as a Graphviz graph, with a cluster per
+// section and the main flow linked in order
+func WriteFlowDOT(w io.Writer, flow Flow) error {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph flow {\n    label=%s;\n    labelloc=t;\n    node [shape=box, style=rounded];\n", dotText(flow.Title))
+
+	var ids []string
+	for i, section := range flow.Sections {
+		title := "Opening"
+		if section.Opener != nil {
+			title = section.Opener.Title
+		}
+		fmt.Fprintf(&sb, "    subgraph cluster_%d {\n        label=%s;\n", i+1, dotText(title+" ("+presentation.FormatClock(section.Budget())+")"))
+		for _, slide := range flowSlides(section) {
+			id := fmt.Sprintf("s%d", slide.Number)
+			fmt.Fprintf(&sb, "        %s [label=%s];\n", id, dotText(flowLabel(slide)+"\n"+presentation.FormatClock(slide.Budget)))
+			ids = append(ids, id)
+		}
+		sb.WriteString("    }\n")
+	}
+	if len(ids) > 1 {
+		fmt.Fprintf(&sb, "    %s;\n", strings.Join(ids, " -> "))
+	}
+
+	if len(flow.Backup) > 0 {
+		sb.WriteString("    subgraph cluster_backup {\n        label=\"Backup\";\n        style=dashed;\n")
+		for _, slide := range flow.Backup {
+			fmt.Fprintf(&sb, "        s%d [label=%s];\n", slide.Number, dotText(flowLabel(slide)))
+		}
+		sb.WriteString("    }\n")
+	}
+
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// flowSlides returns the slides of a section, its opener first
+func flowSlides(section FlowSection) []FlowSlide {
+	if section.Opener == nil {
+		return section.Slides
+	}
+	return append([]FlowSlide{*section.Opener}, section.Slides...)
+}
+
+// mermaidText escapes text for a quoted Mermaid label
+func mermaidText(text string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace(text)
+}
+
+// dotText quotes text as a Graphviz string
+func dotText(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(text) + `"`
+}
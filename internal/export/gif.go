@@ -0,0 +1,78 @@
+package export
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"io"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// GIFOptions controls how slides are rendered into an animated GIF
+type GIFOptions struct {
+	// Width of the GIF in pixels; the height follows the deck's proportions
+	Width int
+	// Delay is how long each slide is shown
+	Delay time.Duration
+}
+
+// rampSteps is the number of shades between the background and each text
+// color in a frame's palette, used for antialiased text
+const rampSteps = 32
+
+// WriteGIF writes an animated GIF that cycles through the slides at indexes,
+// looping forever
+func WriteGIF(w io.Writer, data *presentation.PresentationData, indexes []int, opts GIFOptions) error {
+	if len(indexes) == 0 {
+		return fmt.Errorf("no slides to export")
+	}
+	if opts.Width <= 0 {
+		opts.Width = presentation.DeckWidth
+	}
+	// GIF delays are in hundredths of a second
+	delay := int(opts.Delay / (10 * time.Millisecond))
+	if delay < 1 {
+		delay = 1
+	}
+
+	anim := &gif.GIF{LoopCount: 0}
+	for _, index := range indexes {
+		slide := data.Slides[index]
+		img, err := RenderSlide(slide, index+1, data.Metadata.Theme, opts.Width)
+		if err != nil {
+			return fmt.Errorf("failed to render slide %d: %w", index+1, err)
+		}
+
+		frame := image.NewPaletted(img.Bounds(), framePalette(ThemeColors(data.Metadata.Theme, slide)))
+		draw.Draw(frame, frame.Bounds(), img, image.Point{}, draw.Src)
+
+		anim.Image = append(anim.Image, frame)
+		anim.Delay = append(anim.Delay, delay)
+	}
+
+	return gif.EncodeAll(w, anim)
+}
+
+// framePalette returns the palette for a slide: its background and shades
+// blending it into each of its text colors
+func framePalette(colors SlideColors) color.Palette {
+	palette := color.Palette{colors.Background}
+	for _, c := range []color.RGBA{colors.Text, colors.Heading, colors.Accent, colors.Muted} {
+		for step := 1; step <= rampSteps; step++ {
+			palette = append(palette, blend(colors.Background, c, float64(step)/rampSteps))
+		}
+	}
+	return palette
+}
+
+// blend mixes a into b by t, from 0 (all a) to 1 (all b)
+func blend(a, b color.RGBA, t float64) color.RGBA {
+	mix := func(x, y uint8) uint8 {
+		return uint8(float64(x) + (float64(y)-float64(x))*t + 0.5)
+	}
+	return color.RGBA{mix(a.R, b.R), mix(a.G, b.G), mix(a.B, b.B), 0xff}
+}
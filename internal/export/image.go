@@ -0,0 +1,314 @@
+package export
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/presentation"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// singleEmphasis matches *emphasized* text, which plainText leaves alone
+var singleEmphasis = regexp.MustCompile(`\*([^*\s][^*]*)\*`)
+
+// SlideColors are the colors a slide is drawn with
+type SlideColors struct {
+	Background color.RGBA
+	Text       color.RGBA
+	Heading    color.RGBA
+	Accent     color.RGBA
+	Muted      color.RGBA
+}
+
+// themeColors approximates the colors of the reveal.js themes
+var themeColors = map[string]SlideColors{
+	"black":     {rgb(0x191919), rgb(0xffffff), rgb(0xffffff), rgb(0x42affa), rgb(0x9a9a9a)},
+	"white":     {rgb(0xffffff), rgb(0x222222), rgb(0x222222), rgb(0x2a76dd), rgb(0x888888)},
+	"league":    {rgb(0x2b2b2b), rgb(0xeeeeee), rgb(0xeeeeee), rgb(0x13daec), rgb(0x999999)},
+	"beige":     {rgb(0xf7f3de), rgb(0x333333), rgb(0x333333), rgb(0x8b743d), rgb(0x8c8672)},
+	"sky":       {rgb(0xdcedf2), rgb(0x333333), rgb(0x333333), rgb(0x3b759e), rgb(0x7a8a90)},
+	"night":     {rgb(0x111111), rgb(0xeeeeee), rgb(0xeeeeee), rgb(0xe7ad52), rgb(0x888888)},
+	"serif":     {rgb(0xf0f1eb), rgb(0x000000), rgb(0x383d3d), rgb(0x51483d), rgb(0x7d7f78)},
+	"simple":    {rgb(0xffffff), rgb(0x000000), rgb(0x000000), rgb(0x00008b), rgb(0x888888)},
+	"solarized": {rgb(0xfdf6e3), rgb(0x657b83), rgb(0x586e75), rgb(0x268bd2), rgb(0x93a1a1)},
+}
+
+func rgb(hex uint32) color.RGBA {
+	return color.RGBA{uint8(hex >> 16), uint8(hex >> 8), uint8(hex), 0xff}
+}
+
+// ThemeColors returns the colors for a slide in a reveal.js theme, honoring
+// the slide's background color when it is a hex color
+func ThemeColors(theme string, slide presentation.Slide) SlideColors {
+	colors, ok := themeColors[theme]
+	if !ok {
+		colors = themeColors["black"]
+	}
+	if bg, ok := parseHexColor(slide.Background_color); ok {
+		colors.Background = bg
+	}
+	return colors
+}
+
+// parseHexColor parses a #rgb or #rrggbb color
+func parseHexColor(value string) (color.RGBA, bool) {
+	hex := strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 || !strings.HasPrefix(strings.TrimSpace(value), "#") {
+		return color.RGBA{}, false
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+	return rgb(uint32(n)), true
+}
+
+// slideFonts are the faces a slide is drawn with, sized for its width
+type slideFonts struct {
+	title, heading, body, bold, code, small font.Face
+}
+
+// newSlideFonts loads the Go fonts scaled from a 960 pixel wide slide
+func newSlideFonts(width int) (*slideFonts, error) {
+	scale := float64(width) / presentation.DeckWidth
+	face := func(ttf []byte, size float64) (font.Face, error) {
+		parsed, err := opentype.Parse(ttf)
+		if err != nil {
+			return nil, err
+		}
+		return opentype.NewFace(parsed, &opentype.FaceOptions{Size: size * scale, DPI: 72, Hinting: font.HintingFull})
+	}
+
+	var fonts slideFonts
+	var err error
+	for _, f := range []struct {
+		dst  *font.Face
+		ttf  []byte
+		size float64
+	}{
+		{&fonts.title, gobold.TTF, 64},
+		{&fonts.heading, gobold.TTF, 46},
+		{&fonts.body, goregular.TTF, 28},
+		{&fonts.bold, gobold.TTF, 28},
+		{&fonts.code, gomono.TTF, 22},
+		{&fonts.small, goregular.TTF, 18},
+	} {
+		if *f.dst, err = face(f.ttf, f.size); err != nil {
+			return nil, err
+		}
+	}
+	return &fonts, nil
+}
+
+// slideLine is a line of slide content ready to be drawn
+type slideLine struct {
+	text   string
+	face   font.Face
+	color  color.RGBA
+	bullet bool
+	gap    bool
+}
+
+// slideText strips markdown list markers and formatting from a line
+func slideText(line string) string {
+	return singleEmphasis.ReplaceAllString(plainText(cueListMarker.ReplaceAllString(line, "")), "$1")
+}
+
+// contentLines turns slide content into lines to draw, dropping markdown
+// formatting
+func contentLines(content string, fonts *slideFonts, colors SlideColors) []slideLine {
+	var lines []slideLine
+	inCode := false
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		switch {
+		case inCode:
+			lines = append(lines, slideLine{text: strings.ReplaceAll(line, "\t", "    "), face: fonts.code, color: colors.Text})
+		case trimmed == "":
+			lines = append(lines, slideLine{gap: true})
+		case strings.HasPrefix(trimmed, "#"):
+			lines = append(lines, slideLine{text: slideText(line), face: fonts.bold, color: colors.Heading})
+		case strings.HasPrefix(trimmed, "|"):
+			// Table rows, without the separator row
+			cells := strings.Split(strings.Trim(trimmed, "|"), "|")
+			if strings.Trim(strings.Join(cells, ""), "-: ") == "" {
+				continue
+			}
+			for i := range cells {
+				cells[i] = slideText(cells[i])
+			}
+			lines = append(lines, slideLine{text: strings.Join(cells, "   "), face: fonts.body, color: colors.Text})
+		case strings.HasPrefix(trimmed, "Table:"), strings.HasPrefix(trimmed, "<!--"):
+			continue
+		case cueListMarker.MatchString(line) && !strings.HasPrefix(trimmed, ">"):
+			lines = append(lines, slideLine{text: slideText(line), face: fonts.body, color: colors.Text, bullet: true})
+		default:
+			text := slideText(line)
+			if text == "" {
+				continue
+			}
+			c := colors.Text
+			if strings.HasPrefix(trimmed, ">") || strings.HasPrefix(trimmed, "![") {
+				c = colors.Muted
+			}
+			lines = append(lines, slideLine{text: text, face: fonts.body, color: c})
+		}
+	}
+
+	// Drop leading, trailing, and repeated gaps
+	var compact []slideLine
+	for _, line := range lines {
+		if line.gap && (len(compact) == 0 || compact[len(compact)-1].gap) {
+			continue
+		}
+		compact = append(compact, line)
+	}
+	for len(compact) > 0 && compact[len(compact)-1].gap {
+		compact = compact[:len(compact)-1]
+	}
+	return compact
+}
+
+// wrapLine splits text into lines no wider than width
+func wrapLine(text string, face font.Face, width int) []string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return nil
+	}
+	var lines []string
+	current := words[0]
+	for _, word := range words[1:] {
+		if font.MeasureString(face, current+" "+word).Ceil() > width {
+			lines = append(lines, current)
+			current = word
+		} else {
+			current += " " + word
+		}
+	}
+	return append(lines, current)
+}
+
+// canvas draws text onto a slide image
+type canvas struct {
+	img *image.RGBA
+}
+
+func (c canvas) text(face font.Face, col color.RGBA, x, y int, text string) {
+	d := font.Drawer{
+		Dst:  c.img,
+		Src:  image.NewUniform(col),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
+
+// lineHeight is the distance between baselines for a face
+func lineHeight(face font.Face) int {
+	return face.Metrics().Height.Ceil() * 5 / 4
+}
+
+// drawLines draws content lines into a column starting at x, y, stopping at
+// bottom with an ellipsis when the content does not fit
+func (c canvas) drawLines(lines []slideLine, x, y, width, bottom int, fonts *slideFonts, colors SlideColors, center bool) {
+	indent := font.MeasureString(fonts.body, "•  ").Ceil()
+	for _, line := range lines {
+		if line.gap {
+			y += lineHeight(fonts.body) / 2
+			continue
+		}
+		left, available := x, width
+		if line.bullet {
+			left, available = x+indent, width-indent
+		}
+		wrapped := []string{line.text}
+		if line.face != fonts.code {
+			wrapped = wrapLine(line.text, line.face, available)
+		}
+		for i, text := range wrapped {
+			y += lineHeight(line.face)
+			if y > bottom {
+				c.text(fonts.body, colors.Muted, left, y-lineHeight(line.face), "…")
+				return
+			}
+			if line.bullet && i == 0 {
+				c.text(line.face, colors.Accent, x, y, "•")
+			}
+			if center {
+				left = x + (width-font.MeasureString(line.face, text).Ceil())/2
+			}
+			c.text(line.face, line.color, left, y, text)
+		}
+	}
+}
+
+// RenderSlide draws a slide as an image of the given width, with the
+// proportions of a reveal.js deck. It approximates the slide's look in the
+// theme: the title, the text of its content, and the slide number.
+func RenderSlide(slide presentation.Slide, number int, theme string, width int) (*image.RGBA, error) {
+	fonts, err := newSlideFonts(width)
+	if err != nil {
+		return nil, err
+	}
+	colors := ThemeColors(theme, slide)
+
+	height := width * presentation.DeckHeight / presentation.DeckWidth
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(colors.Background), image.Point{}, draw.Src)
+	c := canvas{img: img}
+
+	margin := width / 16
+	inner := width - 2*margin
+	bottom := height - margin
+
+	if slide.Layout == "title" {
+		// Title slides are centered vertically and horizontally
+		titleLines := wrapLine(slide.Title, fonts.title, inner)
+		lines := contentLines(slide.Content, fonts, colors)
+		total := len(titleLines)*lineHeight(fonts.title) + len(lines)*lineHeight(fonts.body)
+		y := (height - total) / 2
+		for _, text := range titleLines {
+			y += lineHeight(fonts.title)
+			c.text(fonts.title, colors.Heading, margin+(inner-font.MeasureString(fonts.title, text).Ceil())/2, y, text)
+		}
+		c.drawLines(lines, margin, y+lineHeight(fonts.body)/2, inner, bottom, fonts, colors, true)
+	} else {
+		y := margin
+		for _, text := range wrapLine(slide.Title, fonts.heading, inner) {
+			y += lineHeight(fonts.heading)
+			c.text(fonts.heading, colors.Heading, margin, y, text)
+		}
+		y += lineHeight(fonts.body) / 2
+
+		if slide.Layout == "two-column" {
+			gap := width / 24
+			column := (inner - gap) / 2
+			for i, content := range presentation.SplitColumns(slide.Content) {
+				c.drawLines(contentLines(content, fonts, colors), margin+i*(column+gap), y, column, bottom, fonts, colors, false)
+			}
+		} else {
+			c.drawLines(contentLines(slide.Content, fonts, colors), margin, y, inner, bottom, fonts, colors, false)
+		}
+	}
+
+	label := strconv.Itoa(number)
+	c.text(fonts.small, colors.Muted, width-margin/2-font.MeasureString(fonts.small, label).Ceil(), height-margin/3, label)
+
+	return img, nil
+}
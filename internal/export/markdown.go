@@ -0,0 +1,26 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// xhtml converts slide markdown to XHTML. Raw HTML in the markdown is
+// dropped, so the output is always well-formed.
+var xhtml = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithRendererOptions(html.WithXHTML()),
+)
+
+// markdownToXHTML renders markdown content as an XHTML fragment
+func markdownToXHTML(content string) (string, error) {
+	var buf bytes.Buffer
+	if err := xhtml.Convert([]byte(content), &buf); err != nil {
+		return "", fmt.Errorf("failed to convert markdown: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,202 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A6 landscape, in points
+const (
+	cardWidth  = 419.53
+	cardHeight = 297.64
+	cardMargin = 24.0
+)
+
+// helveticaWidths are the widths of the printable ASCII characters in
+// Helvetica, in thousandths of the font size
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+// winAnsi maps the non-Latin-1 characters common in slide text to their
+// WinAnsiEncoding codes
+var winAnsi = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// pdfFont is one of the two standard fonts used on cards
+type pdfFont struct {
+	name string
+	bold bool
+}
+
+var (
+	fontRegular = pdfFont{name: "F1"}
+	fontBold    = pdfFont{name: "F2", bold: true}
+)
+
+// textWidth estimates the width of text set in the font at size
+func (f pdfFont) textWidth(text string, size float64) float64 {
+	total := 0
+	for _, r := range text {
+		width := 556
+		if r >= ' ' && r <= '~' {
+			width = helveticaWidths[r-' ']
+		}
+		total += width
+	}
+	if f.bold {
+		// Helvetica-Bold is slightly wider on average
+		total = total * 21 / 20
+	}
+	return float64(total) * size / 1000
+}
+
+// wrapText breaks text into lines no wider than width
+func wrapText(text string, font pdfFont, size, width float64) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if line != "" && font.textWidth(candidate, size) > width {
+			lines = append(lines, line)
+			candidate = word
+		}
+		line = candidate
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// pdfString encodes text as a PDF literal string in WinAnsiEncoding
+func pdfString(text string) string {
+	var sb strings.Builder
+	sb.WriteByte('(')
+	for _, r := range text {
+		var b byte
+		switch {
+		case r < 0x80 || (r >= 0xA0 && r <= 0xFF):
+			b = byte(r)
+		case winAnsi[r] != 0:
+			b = winAnsi[r]
+		default:
+			b = '?'
+		}
+		if b == '(' || b == ')' || b == '\\' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(b)
+	}
+	sb.WriteByte(')')
+	return sb.String()
+}
+
+// cardPage lays out the content stream for one card
+type cardPage struct {
+	sb strings.Builder
+	y  float64
+}
+
+// text draws a line of text at x on the current baseline
+func (p *cardPage) text(font pdfFont, size, x float64, text string) {
+	fmt.Fprintf(&p.sb, "BT /%s %.1f Tf %.2f %.2f Td %s Tj ET\n", font.name, size, x, p.y, pdfString(text))
+}
+
+// paragraph draws wrapped text, moving the baseline down for each line
+func (p *cardPage) paragraph(font pdfFont, size, x, width float64, text string) {
+	for _, line := range wrapText(text, font, size, width) {
+		if p.y < cardMargin+size {
+			return
+		}
+		p.text(font, size, x, line)
+		p.y -= size * 1.3
+	}
+}
+
+// WriteCueCardsPDF writes cue cards as a PDF with one A6 card per page
+func WriteCueCardsPDF(w io.Writer, title string, cards []CueCard) error {
+	contentWidth := cardWidth - 2*cardMargin
+
+	var pages []string
+	for _, card := range cards {
+		p := &cardPage{y: cardHeight - cardMargin - 9}
+
+		// Header: card number on the left, timing on the right
+		header := fmt.Sprintf("Card %d of %d", card.Number, len(cards))
+		p.text(fontRegular, 9, cardMargin, header)
+		timing := cardTiming(card)
+		p.text(fontBold, 9, cardWidth-cardMargin-fontBold.textWidth(timing, 9), timing)
+		fmt.Fprintf(&p.sb, "0.6 G 0.5 w %.2f %.2f m %.2f %.2f l S 0 G\n",
+			cardMargin, p.y-6, cardWidth-cardMargin, p.y-6)
+		p.y -= 30
+
+		p.paragraph(fontBold, 16, cardMargin, contentWidth, card.Title)
+		p.y -= 8
+
+		for _, point := range card.Points {
+			p.text(fontRegular, 12, cardMargin, "•")
+			p.paragraph(fontRegular, 12, cardMargin+14, contentWidth-14, point)
+			p.y -= 6
+		}
+
+		// Footer: deck title
+		p.y = cardMargin
+		p.text(fontRegular, 8, cardMargin, title)
+
+		pages = append(pages, p.sb.String())
+	}
+
+	return writePDF(w, pages)
+}
+
+// writePDF writes a PDF document whose pages have the given content streams
+func writePDF(w io.Writer, pages []string) error {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// Objects 1-4 are the catalog, page tree, and fonts; each page then
+	// takes two objects, the page and its content stream
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			cardWidth, cardHeight, 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
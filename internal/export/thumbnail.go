@@ -0,0 +1,61 @@
+package export
+
+import (
+	"fmt"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// ThumbnailWidth is the width of catalog thumbnails in pixels
+const ThumbnailWidth = 320
+
+// Thumbnail returns the catalog thumbnail of the deck at path, a PNG of its
+// title slide. The thumbnail is rendered again when the deck has changed
+// since it was last rendered.
+func Thumbnail(path string, data *presentation.PresentationData) (string, error) {
+	thumb := presentation.ThumbnailPath(path)
+
+	source, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read presentation: %w", err)
+	}
+	if cached, err := os.Stat(thumb); err == nil && !cached.ModTime().Before(source.ModTime()) {
+		return thumb, nil
+	}
+
+	index := data.TitleSlide()
+	if index < 0 {
+		return "", fmt.Errorf("presentation has no slides")
+	}
+
+	// Render the slide as it appears in the deck, falling back to the raw
+	// slide when the deck cannot be prepared
+	slide := data.Slides[index]
+	if prepared, _, err := presentation.NewGenerator().Prepare(data, path); err == nil {
+		if i := prepared.GetSlideIndex(slide.ID); i >= 0 {
+			slide = prepared.Slides[i]
+		}
+	}
+
+	img, err := RenderSlide(slide, index+1, data.Metadata.Theme, ThumbnailWidth)
+	if err != nil {
+		return "", fmt.Errorf("failed to render thumbnail: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(thumb), 0755); err != nil {
+		return "", fmt.Errorf("failed to create thumbnail directory: %w", err)
+	}
+	file, err := os.Create(thumb)
+	if err != nil {
+		return "", fmt.Errorf("failed to write thumbnail: %w", err)
+	}
+	defer file.Close()
+
+	if err := png.Encode(file, img); err != nil {
+		return "", fmt.Errorf("failed to write thumbnail: %w", err)
+	}
+	return thumb, nil
+}
@@ -0,0 +1,128 @@
+package imagegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the OpenAI API, used when no base URL is configured
+const DefaultBaseURL = "https://api.openai.com/v1"
+
+// Config holds the settings for generating images through the OpenAI Images
+// API or a service compatible with it
+type Config struct {
+	BaseURL string // API base URL; defaults to DefaultBaseURL
+	APIKey  string // API key sent as a bearer token
+	Model   string // Image model, e.g. gpt-image-1 or dall-e-3
+	Size    string // Image size, e.g. 1536x1024; the model's default when empty
+}
+
+// Client generates images from text prompts
+type Client struct {
+	config Config
+	http   *http.Client
+}
+
+// NewClient creates a client for the configured service
+func NewClient(config Config) *Client {
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+	return &Client{
+		config: config,
+		// Generating an image takes far longer than an ordinary API call
+		http: &http.Client{Timeout: 3 * time.Minute},
+	}
+}
+
+// generationRequest is the body of an image generation request
+type generationRequest struct {
+	Model  string `json:"model,omitempty"`
+	Prompt string `json:"prompt"`
+	Size   string `json:"size,omitempty"`
+	N      int    `json:"n"`
+}
+
+// generationResponse holds the generated images, returned inline or as
+// short-lived URLs depending on the model
+type generationResponse struct {
+	Data []struct {
+		B64JSON string `json:"b64_json"`
+		URL     string `json:"url"`
+	} `json:"data"`
+}
+
+// Generate creates an image for the prompt and returns its encoded bytes,
+// usually a PNG
+func (c *Client) Generate(ctx context.Context, prompt string) ([]byte, error) {
+	payload, err := json.Marshal(generationRequest{Model: c.config.Model, Prompt: prompt, Size: c.config.Size, N: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.BaseURL+"/images/generations", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("image generation failed: %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	var result generationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(result.Data) == 0 {
+		return nil, fmt.Errorf("image generation returned no image")
+	}
+
+	image := result.Data[0]
+	if image.B64JSON != "" {
+		decoded, err := base64.StdEncoding.DecodeString(image.B64JSON)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode image: %w", err)
+		}
+		return decoded, nil
+	}
+	if image.URL != "" {
+		return c.download(ctx, image.URL)
+	}
+	return nil, fmt.Errorf("image generation returned neither image data nor a URL")
+}
+
+// download fetches an image returned as a URL
+func (c *Client) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("failed to download image: %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
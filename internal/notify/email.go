@@ -0,0 +1,214 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// DefaultEmailSubject is the subject template used when none is given
+const DefaultEmailSubject = "Slides: {{.Title}}"
+
+// DefaultEmailBody is the message template used when none is given
+const DefaultEmailBody = `Hi{{if .Name}} {{.Name}}{{end}},
+
+Thanks for attending "{{.Title}}"{{if .Author}} by {{.Author}}{{end}}. The slides are attached.
+{{if .Subtitle}}
+{{.Subtitle}}
+{{end}}`
+
+// SMTPConfig holds the settings for sending email
+type SMTPConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+// Recipient is a single addressee read from a recipients list
+type Recipient struct {
+	Email string
+	Name  string
+}
+
+// Attachment is a file attached to an email
+type Attachment struct {
+	Name string
+	Data []byte
+}
+
+// EmailFields are the values available to subject and body templates
+type EmailFields struct {
+	Email    string
+	Name     string
+	Title    string
+	Subtitle string
+	Author   string
+	Date     string
+}
+
+// ReadRecipients reads a CSV list of recipients. The first column is the
+// email address and the optional second column the name; a header row whose
+// first column is "email" is skipped.
+func ReadRecipients(path string) ([]Recipient, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recipients: %w", err)
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var recipients []Recipient
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read recipients: %w", err)
+		}
+
+		email := strings.TrimSpace(record[0])
+		if email == "" || (line == 1 && strings.EqualFold(email, "email")) {
+			continue
+		}
+		if _, err := mail.ParseAddress(email); err != nil {
+			return nil, fmt.Errorf("invalid email address %q on line %d", email, line)
+		}
+
+		recipient := Recipient{Email: email}
+		if len(record) > 1 {
+			recipient.Name = strings.TrimSpace(record[1])
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, nil
+}
+
+// NewEmailFields returns the template values for a recipient of a deck
+func NewEmailFields(data *presentation.PresentationData, recipient Recipient) EmailFields {
+	return EmailFields{
+		Email:    recipient.Email,
+		Name:     recipient.Name,
+		Title:    data.Metadata.Title,
+		Subtitle: data.Metadata.Subtitle,
+		Author:   data.Metadata.Author,
+		Date:     data.Metadata.Date,
+	}
+}
+
+// RenderTemplate executes a subject or body template with the given fields
+func RenderTemplate(name, text string, fields EmailFields) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, fields); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	return sb.String(), nil
+}
+
+// BuildEmail composes a MIME message with a plain text body and attachments
+func BuildEmail(from string, to Recipient, subject, body string, attachments []Attachment) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	toAddress := mail.Address{Name: to.Name, Address: to.Email}
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", toAddress.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", writer.Boundary())
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeBase64(part, []byte(body)); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range attachments {
+		contentType := mime.TypeByExtension(filepath.Ext(attachment.Name))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {contentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name})},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBase64(part, attachment.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SendEmail delivers a composed message through the configured SMTP server.
+// The connection is upgraded with STARTTLS when the server supports it.
+func SendEmail(config SMTPConfig, to string, message []byte) error {
+	var auth smtp.Auth
+	if config.Username != "" {
+		auth = smtp.PlainAuth("", config.Username, config.Password, config.Host)
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address %q: %w", config.From, err)
+	}
+
+	addr := config.Host + ":" + config.Port
+	if err := smtp.SendMail(addr, auth, from.Address, []string{to}, message); err != nil {
+		return fmt.Errorf("failed to send to %s: %w", to, err)
+	}
+	return nil
+}
+
+// writeBase64 writes data base64-encoded in 76 character lines
+func writeBase64(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(w, encoded[:76]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err := io.WriteString(w, encoded+"\r\n")
+	return err
+}
@@ -0,0 +1,182 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// maxTakeaways is the number of slides highlighted when none are chosen
+const maxTakeaways = 3
+
+// takeawayWords mark slides that summarize a deck
+var takeawayWords = []string{"takeaway", "summary", "key", "conclusion", "recap", "tl;dr"}
+
+// listMarker matches the heading or list marker at the start of a line
+var listMarker = regexp.MustCompile(`^\s*(#+|[-*+]|\d+[.)])\s+`)
+
+// SlackMessage is an incoming webhook payload using Block Kit
+type SlackMessage struct {
+	Channel string       `json:"channel,omitempty"`
+	Text    string       `json:"text"`
+	Blocks  []SlackBlock `json:"blocks"`
+}
+
+// SlackBlock is a single Block Kit layout block
+type SlackBlock struct {
+	Type     string      `json:"type"`
+	Text     *SlackText  `json:"text,omitempty"`
+	Elements []SlackText `json:"elements,omitempty"`
+}
+
+// SlackText is a Block Kit text object
+type SlackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// KeyTakeaways picks the slides to highlight in an announcement: slides whose
+// titles read like a summary, or else the first content slides
+func KeyTakeaways(data *presentation.PresentationData) []presentation.Slide {
+	var matches, content []presentation.Slide
+	for _, slide := range data.Slides {
+		if slide.Title == "" || slide.Layout == "title" {
+			continue
+		}
+		title := strings.ToLower(slide.Title)
+		for _, word := range takeawayWords {
+			if strings.Contains(title, word) {
+				matches = append(matches, slide)
+				break
+			}
+		}
+		content = append(content, slide)
+	}
+
+	if len(matches) == 0 {
+		matches = content
+	}
+	if len(matches) > maxTakeaways {
+		matches = matches[:maxTakeaways]
+	}
+	return matches
+}
+
+// SlackSummary builds an announcement with the deck's title, the given
+// takeaway slides, and a link to the published deck
+func SlackSummary(data *presentation.PresentationData, takeaways []presentation.Slide, link, channel string) SlackMessage {
+	meta := data.Metadata
+
+	msg := SlackMessage{
+		Channel: channel,
+		Text:    fmt.Sprintf("New deck: %s", meta.Title),
+	}
+
+	msg.Blocks = append(msg.Blocks, SlackBlock{
+		Type: "header",
+		Text: &SlackText{Type: "plain_text", Text: meta.Title},
+	})
+
+	var details []string
+	if meta.Subtitle != "" {
+		details = append(details, "_"+escapeSlack(meta.Subtitle)+"_")
+	}
+	var byline []string
+	if meta.Author != "" {
+		byline = append(byline, escapeSlack(meta.Author))
+	}
+	if meta.Date != "" {
+		byline = append(byline, escapeSlack(meta.Date))
+	}
+	byline = append(byline, fmt.Sprintf("%d slides", len(data.Slides)))
+	details = append(details, strings.Join(byline, " · "))
+	msg.Blocks = append(msg.Blocks, SlackBlock{
+		Type: "section",
+		Text: &SlackText{Type: "mrkdwn", Text: strings.Join(details, "\n")},
+	})
+
+	if len(takeaways) > 0 {
+		var sb strings.Builder
+		sb.WriteString("*Key takeaways*")
+		for _, slide := range takeaways {
+			fmt.Fprintf(&sb, "\n• *%s*", escapeSlack(slide.Title))
+			if point := firstPoint(slide.Content); point != "" {
+				fmt.Fprintf(&sb, " — %s", escapeSlack(point))
+			}
+		}
+		msg.Blocks = append(msg.Blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackText{Type: "mrkdwn", Text: sb.String()},
+		})
+	}
+
+	if link != "" {
+		msg.Text += " " + link
+		msg.Blocks = append(msg.Blocks, SlackBlock{
+			Type: "section",
+			Text: &SlackText{Type: "mrkdwn", Text: fmt.Sprintf("<%s|View the deck →>", link)},
+		})
+	}
+
+	if len(meta.Tags) > 0 {
+		msg.Blocks = append(msg.Blocks, SlackBlock{
+			Type:     "context",
+			Elements: []SlackText{{Type: "mrkdwn", Text: escapeSlack(strings.Join(meta.Tags, ", "))}},
+		})
+	}
+
+	return msg
+}
+
+// PostSlack sends a message to a Slack incoming webhook
+func PostSlack(ctx context.Context, webhookURL string, msg SlackMessage) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to Slack: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("slack webhook returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	return nil
+}
+
+// firstPoint returns the first line of markdown content without list or
+// heading markers
+func firstPoint(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(listMarker.ReplaceAllString(line, ""))
+		if line != "" && !strings.HasPrefix(line, "```") {
+			return line
+		}
+	}
+	return ""
+}
+
+// escapeSlack escapes the characters Slack treats as control sequences
+func escapeSlack(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
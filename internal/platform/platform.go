@@ -0,0 +1,129 @@
+// Package platform hides the differences between the systems pres runs on:
+// macOS, Linux, Windows, and Linux under WSL. It opens files and URLs in the
+// user's default application, finds the user's directories, and converts
+// between the slash-separated paths stored in presentations and the paths of
+// the local system.
+package platform
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+var (
+	wsl     bool
+	wslOnce sync.Once
+)
+
+// IsWSL reports whether pres is running under the Windows Subsystem for
+// Linux, where files are opened with Windows applications
+func IsWSL() bool {
+	wslOnce.Do(func() {
+		if runtime.GOOS != "linux" {
+			return
+		}
+		if os.Getenv("WSL_DISTRO_NAME") != "" {
+			wsl = true
+			return
+		}
+		release, err := os.ReadFile("/proc/sys/kernel/osrelease")
+		wsl = err == nil && strings.Contains(strings.ToLower(string(release)), "microsoft")
+	})
+	return wsl
+}
+
+// openCommand returns the command that opens target in the default
+// application
+func openCommand(target string) (string, []string) {
+	switch {
+	case runtime.GOOS == "darwin":
+		return "open", []string{target}
+	case runtime.GOOS == "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", target}
+	case IsWSL():
+		if _, err := exec.LookPath("wslview"); err == nil {
+			return "wslview", []string{target}
+		}
+		// explorer.exe needs a Windows path for local files
+		if !strings.Contains(target, "://") {
+			if out, err := exec.Command("wslpath", "-w", target).Output(); err == nil {
+				target = strings.TrimSpace(string(out))
+			}
+		}
+		return "explorer.exe", []string{target}
+	default:
+		return "xdg-open", []string{target}
+	}
+}
+
+// Open opens a file or URL in the user's default application, such as a
+// generated deck in the browser. It returns once the application is started.
+func Open(target string) error {
+	name, args := openCommand(target)
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	return cmd.Process.Release()
+}
+
+// OpenHint returns the shell command a user can run to open target, for
+// suggesting next steps
+func OpenHint(target string) string {
+	switch {
+	case runtime.GOOS == "darwin":
+		return "open " + target
+	case runtime.GOOS == "windows":
+		return "start " + target
+	case IsWSL():
+		return "wslview " + target
+	default:
+		return "xdg-open " + target
+	}
+}
+
+// ConfigDir returns the directory user configuration is kept in:
+// $XDG_CONFIG_HOME when set, %AppData% on Windows, and ~/.config elsewhere
+func ConfigDir() (string, error) {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return dir, nil
+	}
+	if runtime.GOOS == "windows" {
+		return os.UserConfigDir()
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config"), nil
+}
+
+// ExpandHome replaces a leading ~ in path with the user's home directory, as
+// a shell would, for paths read from config files and environment variables
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, `~\`) {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, LocalPath(path[1:]))
+}
+
+// SlashPath returns a relative path in the form stored in presentations,
+// separated by forward slashes. Backslashes are converted on every system,
+// so a deck edited by hand on Windows works anywhere.
+func SlashPath(path string) string {
+	return strings.ReplaceAll(filepath.ToSlash(path), `\`, "/")
+}
+
+// LocalPath converts a path stored in a presentation to one for the local
+// system
+func LocalPath(path string) string {
+	return filepath.FromSlash(SlashPath(path))
+}
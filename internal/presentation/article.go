@@ -0,0 +1,295 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/text"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// articleSlideWeight is how much a slide drafted from an article holds:
+	// a bullet weighs one, and a code block or table fills a slide
+	articleSlideWeight = 6
+	// articleBulletWidth is the most columns of a bullet drafted from a
+	// paragraph
+	articleBulletWidth = 120
+)
+
+// articleSlide is a slide being drafted from an article
+type articleSlide struct {
+	title  string
+	lines  []string
+	notes  []string
+	weight int
+}
+
+// articleDraft collects the slides drafted from an article as its content
+// is walked
+type articleDraft struct {
+	title  string
+	slides []*articleSlide
+	images int
+}
+
+// ParseArticle drafts a presentation from the main content of a web page,
+// for AI to refine: level one to three headings start a slide, paragraphs
+// become a bullet of their first sentence with the whole paragraph in the
+// speaker notes, and lists, quotes, code blocks, and tables are kept. Slides
+// that run long continue on another with the same title. The title slide
+// shows the excerpt, and the byline becomes the author. The warnings
+// describe what could not be carried over.
+func ParseArticle(title, byline, excerpt string, content *html.Node) (*PresentationData, []string, error) {
+	draft := &articleDraft{title: title}
+	draft.walk(content)
+
+	pres := types.Presentation{
+		Title:  title,
+		Author: byline,
+		Theme:  "black",
+		Tags:   []string{"imported"},
+	}
+	for _, s := range draft.slides {
+		if len(s.lines) == 0 {
+			continue
+		}
+		pres.Slides = append(pres.Slides, types.Slide{
+			Title:   s.title,
+			Layout:  "content",
+			Content: strings.Join(s.lines, "\n"),
+			Notes:   strings.Join(s.notes, "\n\n"),
+		})
+	}
+	if len(pres.Slides) == 0 {
+		return nil, nil, fmt.Errorf("no text found in the article")
+	}
+	if pres.Title == "" {
+		pres.Title = pres.Slides[0].Title
+	}
+
+	opening := types.Slide{Title: pres.Title, Layout: "title", Content: text.Truncate(excerpt, 200)}
+	if byline != "" {
+		opening.Notes = "By " + byline
+	}
+	pres.Slides = append([]types.Slide{opening}, pres.Slides...)
+
+	var warnings []string
+	if draft.images > 0 {
+		warnings = append(warnings, fmt.Sprintf("%d image(s) were not imported", draft.images))
+	}
+	return NewPresentationData(&pres), warnings, nil
+}
+
+// walk drafts slides from the blocks under n
+func (d *articleDraft) walk(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode {
+			if c.Type == html.TextNode && strings.TrimSpace(c.Data) != "" {
+				d.add(strings.Join(strings.Fields(c.Data), " "), 1)
+			}
+			continue
+		}
+
+		switch c.DataAtom {
+		case atom.H1, atom.H2, atom.H3:
+			heading := inlineText(c)
+			if heading != "" && !(c.DataAtom == atom.H1 && strings.EqualFold(heading, d.title)) {
+				d.slides = append(d.slides, &articleSlide{title: heading})
+			}
+		case atom.H4, atom.H5, atom.H6:
+			if heading := inlineText(c); heading != "" {
+				d.add("**"+heading+"**", 1)
+			}
+		case atom.P:
+			d.paragraph(inlineText(c))
+			d.images += countImages(c)
+		case atom.Ul, atom.Ol:
+			d.list(c, 0)
+		case atom.Blockquote:
+			if quote := inlineText(c); quote != "" {
+				d.add("> "+quote, 2)
+			}
+		case atom.Pre:
+			code := strings.TrimRight(textContent(c), "\n")
+			if code != "" {
+				d.add("```\n"+code+"\n```", articleSlideWeight)
+			}
+		case atom.Table:
+			if table := markdownTable(c); table != "" {
+				d.add(table, articleSlideWeight)
+			}
+		case atom.Img, atom.Picture, atom.Svg, atom.Video:
+			d.images++
+		case atom.Script, atom.Style, atom.Noscript:
+		default:
+			d.walk(c)
+		}
+	}
+}
+
+// paragraph adds a bullet of a paragraph's first sentence, keeping the
+// whole paragraph in the speaker notes
+func (d *articleDraft) paragraph(p string) {
+	if p == "" {
+		return
+	}
+	bullet := text.Truncate(firstSentence(p), articleBulletWidth)
+	d.add("- "+bullet, 1)
+	if bullet != p {
+		s := d.slides[len(d.slides)-1]
+		s.notes = append(s.notes, p)
+	}
+}
+
+// list adds the items of a list as bullets, nested lists indented
+func (d *articleDraft) list(n *html.Node, depth int) {
+	marker := "- "
+	if n.DataAtom == atom.Ol {
+		marker = "1. "
+	}
+	for item := n.FirstChild; item != nil; item = item.NextSibling {
+		if item.DataAtom != atom.Li {
+			continue
+		}
+		var nested []*html.Node
+		var inline strings.Builder
+		for c := item.FirstChild; c != nil; c = c.NextSibling {
+			if c.DataAtom == atom.Ul || c.DataAtom == atom.Ol {
+				nested = append(nested, c)
+				continue
+			}
+			inline.WriteString(" " + inlineText(c))
+		}
+		if line := strings.Join(strings.Fields(inline.String()), " "); line != "" {
+			d.add(strings.Repeat("  ", depth)+marker+text.Truncate(line, articleBulletWidth), 1)
+		}
+		for _, list := range nested {
+			d.list(list, depth+1)
+		}
+		d.images += countImages(item)
+	}
+}
+
+// add puts a line on the current slide, continuing on a new slide with the
+// same title when it would run long
+func (d *articleDraft) add(line string, weight int) {
+	if len(d.slides) == 0 {
+		d.slides = append(d.slides, &articleSlide{title: "Overview"})
+	}
+	s := d.slides[len(d.slides)-1]
+	if s.weight > 0 && s.weight+weight > articleSlideWeight {
+		s = &articleSlide{title: strings.TrimSuffix(s.title, " (continued)") + " (continued)"}
+		d.slides = append(d.slides, s)
+	}
+	s.lines = append(s.lines, line)
+	s.weight += weight
+}
+
+// firstSentence returns the first sentence of a paragraph
+func firstSentence(p string) string {
+	for i, r := range p {
+		if (r == '.' || r == '!' || r == '?') && i+1 < len(p) && p[i+1] == ' ' && i >= 20 {
+			next := []rune(p[i+2:])
+			if len(next) > 0 && unicode.IsUpper(next[0]) {
+				return p[:i+1]
+			}
+		}
+	}
+	return p
+}
+
+// inlineText returns the text under n with whitespace collapsed and inline
+// code in backticks
+func inlineText(n *html.Node) string {
+	var sb strings.Builder
+	var visit func(*html.Node)
+	visit = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			sb.WriteString(n.Data)
+		case n.DataAtom == atom.Code:
+			sb.WriteString("`" + textContent(n) + "`")
+		case n.DataAtom == atom.Br:
+			sb.WriteString(" ")
+		case n.DataAtom == atom.Script || n.DataAtom == atom.Style:
+		default:
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				visit(c)
+			}
+		}
+	}
+	visit(n)
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// textContent returns the text under n as it is, for code
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(textContent(c))
+	}
+	return sb.String()
+}
+
+// countImages counts the images under n
+func countImages(n *html.Node) int {
+	count := 0
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.DataAtom == atom.Img || c.DataAtom == atom.Svg {
+			count++
+		}
+		count += countImages(c)
+	}
+	return count
+}
+
+// markdownTable converts an HTML table to a Markdown table, its first row
+// as the header
+func markdownTable(table *html.Node) string {
+	var rows [][]string
+	var visit func(*html.Node)
+	visit = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.DataAtom != atom.Tr {
+				visit(c)
+				continue
+			}
+			var cells []string
+			for cell := c.FirstChild; cell != nil; cell = cell.NextSibling {
+				if cell.DataAtom == atom.Td || cell.DataAtom == atom.Th {
+					cells = append(cells, strings.ReplaceAll(inlineText(cell), "|", `\|`))
+				}
+			}
+			if len(cells) > 0 {
+				rows = append(rows, cells)
+			}
+		}
+	}
+	visit(table)
+	if len(rows) == 0 {
+		return ""
+	}
+
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	var sb strings.Builder
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		sb.WriteString("| " + strings.Join(row, " | ") + " |\n")
+		if i == 0 {
+			sb.WriteString("|" + strings.Repeat("---|", columns) + "\n")
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,52 @@
+package presentation
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// AssetsDir is the directory, next to a presentation, holding its media
+const AssetsDir = "assets"
+
+// AudioPath returns where the narration for a slide is stored: the path
+// relative to the presentation's directory, as kept in the slide's audio
+// field, and the path on disk
+func AudioPath(path, slideID, ext string) (rel, file string) {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	rel = filepath.ToSlash(filepath.Join(AssetsDir, name, "audio", slideID+ext))
+	return rel, filepath.Join(filepath.Dir(path), filepath.FromSlash(rel))
+}
+
+// SaveSlideAudio stores recorded narration for the slide with the given ID
+// and points the slide's audio field at it, replacing an earlier take
+func (w *Writer) SaveSlideAudio(path, slideID, ext string, audio []byte) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	index := data.GetSlideIndex(slideID)
+	if index < 0 {
+		return nil, fmt.Errorf("no slide with id %s", slideID)
+	}
+
+	rel, file := AudioPath(path, slideID, ext)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create assets directory: %w", err)
+	}
+	if err := os.WriteFile(file, audio, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write audio: %w", err)
+	}
+
+	data.Slides[index].Audio = rel
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,110 @@
+package presentation
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// AuditDir is the directory, next to a deck, that holds its audit log
+const AuditDir = ".pres"
+
+// AuditEntry records a single applied update operation
+type AuditEntry struct {
+	Timestamp time.Time                `json:"timestamp"`
+	Source    string                   `json:"source"`
+	Actor     string                   `json:"actor,omitempty"`
+	Operation types.PresentationUpdate `json:"operation"`
+}
+
+// SetAudit sets the source command and actor recorded in the audit log for
+// operations applied by this writer
+func (w *Writer) SetAudit(source, actor string) {
+	w.auditSource = source
+	w.auditActor = actor
+}
+
+// AuditLogPath returns the audit log path for the deck at path, e.g.
+// presentations/.pres/my-talk.audit.log for presentations/my-talk.json
+func AuditLogPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return filepath.Join(filepath.Dir(path), AuditDir, name+".audit.log")
+}
+
+// appendAudit appends the applied operations to the deck's audit log, one
+// JSON entry per line
+func (w *Writer) appendAudit(path string, updates []types.PresentationUpdate, results []OperationResult) error {
+	logPath := AuditLogPath(path)
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return fmt.Errorf("failed to create audit directory: %w", err)
+	}
+
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	source := w.auditSource
+	if source == "" {
+		source = "unknown"
+	}
+
+	now := time.Now()
+	enc := json.NewEncoder(f)
+	for _, result := range results {
+		if !result.Applied {
+			continue
+		}
+		entry := AuditEntry{
+			Timestamp: now,
+			Source:    source,
+			Actor:     w.auditActor,
+			Operation: updates[result.Index],
+		}
+		if err := enc.Encode(entry); err != nil {
+			return fmt.Errorf("failed to write audit log: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReadAuditLog returns the audit entries for the deck at path, oldest first.
+// A deck without an audit log has no entries.
+func ReadAuditLog(path string) ([]AuditEntry, error) {
+	f, err := os.Open(AuditLogPath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("invalid audit entry on line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	return entries, nil
+}
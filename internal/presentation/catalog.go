@@ -0,0 +1,122 @@
+package presentation
+
+import (
+	"cmp"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// CatalogEntry is a presentation found while scanning a directory
+type CatalogEntry struct {
+	Path string
+	Data *PresentationData
+}
+
+// CatalogSorts are the orders a catalog can be listed in
+var CatalogSorts = []string{"modified", "created", "title", "author", "slides", "path"}
+
+// ThumbnailPath returns where the catalog keeps the thumbnail of the deck at
+// path, e.g. presentations/.pres/my-talk.thumb.png for
+// presentations/my-talk.json
+func ThumbnailPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return filepath.Join(filepath.Dir(path), AuditDir, name+".thumb.png")
+}
+
+// TitleSlide returns the slide that represents the deck in the catalog: the
+// first title slide, or else the first slide. It returns -1 for a deck
+// without slides.
+func (data *PresentationData) TitleSlide() int {
+	for i, slide := range data.Slides {
+		if slide.Layout == "title" {
+			return i
+		}
+	}
+	if len(data.Slides) == 0 {
+		return -1
+	}
+	return 0
+}
+
+// ScanPresentations loads every presentation JSON file below dir. Files that
+// cannot be read or do not look like presentations are skipped.
+func (w *Writer) ScanPresentations(dir string) ([]CatalogEntry, error) {
+	var entries []CatalogEntry
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// Skip hidden directories such as .pres
+			if path != dir && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".json" {
+			return nil
+		}
+
+		data, err := w.LoadPresentation(path)
+		if err != nil || data.Metadata.Title == "" {
+			return nil
+		}
+
+		entries = append(entries, CatalogEntry{Path: path, Data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// SortCatalog orders entries by one of CatalogSorts: most recently modified
+// or created first, most slides first, or alphabetically by title, author,
+// or path. Entries that tie keep their order.
+func SortCatalog(entries []CatalogEntry, by string) error {
+	var compare func(a, b CatalogEntry) int
+	switch by {
+	case "modified":
+		compare = func(a, b CatalogEntry) int { return b.Data.Metadata.Modified.Compare(a.Data.Metadata.Modified) }
+	case "created":
+		compare = func(a, b CatalogEntry) int { return b.Data.Metadata.Created.Compare(a.Data.Metadata.Created) }
+	case "title":
+		compare = func(a, b CatalogEntry) int {
+			return cmp.Compare(strings.ToLower(a.Data.Metadata.Title), strings.ToLower(b.Data.Metadata.Title))
+		}
+	case "author":
+		compare = func(a, b CatalogEntry) int {
+			return cmp.Compare(strings.ToLower(a.Data.Metadata.Author), strings.ToLower(b.Data.Metadata.Author))
+		}
+	case "slides":
+		compare = func(a, b CatalogEntry) int { return cmp.Compare(len(b.Data.Slides), len(a.Data.Slides)) }
+	case "path":
+		compare = func(a, b CatalogEntry) int { return cmp.Compare(a.Path, b.Path) }
+	default:
+		return fmt.Errorf("unknown sort %q (expected one of: %s)", by, strings.Join(CatalogSorts, ", "))
+	}
+
+	slices.SortStableFunc(entries, compare)
+	return nil
+}
+
+// FilterCatalog returns the entries whose deck has at least one of tags,
+// ignoring case
+func FilterCatalog(entries []CatalogEntry, tags []string) []CatalogEntry {
+	if len(tags) == 0 {
+		return entries
+	}
+	var result []CatalogEntry
+	for _, entry := range entries {
+		if slices.ContainsFunc(tags, func(tag string) bool { return containsFold(entry.Data.Metadata.Tags, tag) }) {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package presentation
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrCommentNotFound is returned when no slide holds a comment with the given ID
+var ErrCommentNotFound = errors.New("comment not found")
+
+// Comment is a reviewer remark attached to a slide
+type Comment struct {
+	ID       string    `json:"id"`
+	Author   string    `json:"author,omitempty"`
+	Text     string    `json:"text"`
+	Created  time.Time `json:"created"`
+	Resolved bool      `json:"resolved"`
+}
+
+// SlideComment pairs a comment with the index of the slide it belongs to
+type SlideComment struct {
+	SlideIndex int
+	Comment    Comment
+}
+
+// AddComment attaches a new comment to the slide at index (0-based)
+func (w *Writer) AddComment(path string, index int, author, text string) (*Comment, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 || index >= len(data.Slides) {
+		return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("comment text cannot be empty")
+	}
+
+	comment := Comment{
+		ID:      newCommentID(),
+		Author:  author,
+		Text:    text,
+		Created: time.Now(),
+	}
+	data.Slides[index].Comments = append(data.Slides[index].Comments, comment)
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+// ResolveComment marks the comment with the given ID as resolved
+func (w *Writer) ResolveComment(path string, id string) error {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return err
+	}
+
+	for i := range data.Slides {
+		for j := range data.Slides[i].Comments {
+			if data.Slides[i].Comments[j].ID == id {
+				data.Slides[i].Comments[j].Resolved = true
+				data.Metadata.Modified = time.Now()
+				return w.writeData(path, data)
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrCommentNotFound, id)
+}
+
+// GetComments returns the comments on all slides in slide order. Resolved
+// comments are only included when includeResolved is set.
+func (data *PresentationData) GetComments(includeResolved bool) []SlideComment {
+	var result []SlideComment
+	for i, slide := range data.Slides {
+		for _, comment := range slide.Comments {
+			if comment.Resolved && !includeResolved {
+				continue
+			}
+			result = append(result, SlideComment{SlideIndex: i, Comment: comment})
+		}
+	}
+	return result
+}
+
+// GetCommentSlideIndex returns the index of the slide holding the comment, or -1
+func (data *PresentationData) GetCommentSlideIndex(id string) int {
+	for i, slide := range data.Slides {
+		for _, comment := range slide.Comments {
+			if comment.ID == id {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// newCommentID generates a short random identifier for a comment
+func newCommentID() string {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,283 @@
+package presentation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// SlidePosition is where a required slide belongs in a deck
+type SlidePosition string
+
+const (
+	// PositionFirst requires the slide to open the deck
+	PositionFirst SlidePosition = "first"
+	// PositionLast requires the slide to close the main flow of the deck
+	PositionLast SlidePosition = "last"
+	// PositionAny allows the slide anywhere (the default)
+	PositionAny SlidePosition = "any"
+)
+
+// GetSlidePositions returns the known required slide positions
+func GetSlidePositions() []SlidePosition {
+	return []SlidePosition{
+		PositionFirst,
+		PositionLast,
+		PositionAny,
+	}
+}
+
+// Standard describes what every deck of an organization must have, such as
+// a corporate standard decks are checked against with pres conform
+type Standard struct {
+	Name string `json:"name,omitempty"`
+	// Slides are the required slides, in the order they appear in a deck
+	Slides []RequiredSlide `json:"slides,omitempty"`
+	// Metadata lists the metadata fields that must be set, using
+	// update_metadata keys such as "author" or "custom.department"
+	Metadata []string `json:"metadata,omitempty"`
+	// Themes are the approved reveal.js themes; any theme is allowed when
+	// the list is empty
+	Themes []string `json:"themes,omitempty"`
+}
+
+// RequiredSlide is a slide a standard requires
+type RequiredSlide struct {
+	// Name describes the slide in reports, e.g. "agenda"
+	Name string `json:"name"`
+	// Match is a regular expression a slide title must match. Without it,
+	// titles must contain the name, unless a layout is given.
+	Match string `json:"match,omitempty"`
+	// Layout is the layout the slide must have, e.g. "title"
+	Layout   string        `json:"layout,omitempty"`
+	Position SlidePosition `json:"position,omitempty"`
+	// Slide is the boilerplate inserted when the slide is missing
+	Slide *types.Slide `json:"slide,omitempty"`
+
+	match *regexp.Regexp
+}
+
+// ConformanceIssue is a way a deck falls short of a standard
+type ConformanceIssue struct {
+	Message string
+	// Fixable is set for missing slides the standard has boilerplate for
+	Fixable bool
+}
+
+// LoadStandard reads and checks a standard file
+func LoadStandard(path string) (*Standard, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read standard: %w", err)
+	}
+
+	var standard Standard
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&standard); err != nil {
+		return nil, fmt.Errorf("invalid standard %s: %w", path, err)
+	}
+
+	for i := range standard.Slides {
+		required := &standard.Slides[i]
+		if required.Name == "" {
+			return nil, fmt.Errorf("required slide %d has no name", i+1)
+		}
+		if required.Position == "" {
+			required.Position = PositionAny
+		}
+		if !slices.Contains(GetSlidePositions(), required.Position) {
+			return nil, fmt.Errorf("required slide %q: unknown position %q (expected one of: first, last, any)", required.Name, required.Position)
+		}
+		pattern := required.Match
+		if pattern == "" && required.Layout == "" {
+			pattern = "(?i)" + regexp.QuoteMeta(required.Name)
+		}
+		if pattern != "" {
+			if required.match, err = regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("required slide %q: invalid match: %w", required.Name, err)
+			}
+		}
+	}
+	for _, key := range standard.Metadata {
+		if !isMetadataKey(key) {
+			return nil, fmt.Errorf("unknown metadata key %q", key)
+		}
+	}
+	for _, theme := range standard.Themes {
+		if !slices.Contains(GetRevealJSThemes(), theme) {
+			return nil, fmt.Errorf("unknown theme %q (expected one of: %s)", theme, strings.Join(GetRevealJSThemes(), ", "))
+		}
+	}
+
+	return &standard, nil
+}
+
+// matches reports whether slide satisfies the requirement
+func (r *RequiredSlide) matches(slide Slide) bool {
+	if r.Layout != "" && slide.Layout != r.Layout {
+		return false
+	}
+	return r.match == nil || r.match.MatchString(slide.Title)
+}
+
+// insertion is a boilerplate slide inserted to conform to a standard
+type insertion struct {
+	name  string
+	index int
+	slide Slide
+}
+
+// Check compares a deck with the standard. Slide references should be
+// resolved first, so shared boilerplate slides count.
+func (s *Standard) Check(data *PresentationData) []ConformanceIssue {
+	issues, _ := s.conform(data, false)
+	return issues
+}
+
+// conform checks data against the standard, inserting boilerplate for
+// missing slides when fix is set. It returns the remaining issues and the
+// slides inserted, in order.
+func (s *Standard) conform(data *PresentationData, fix bool) ([]ConformanceIssue, []insertion) {
+	var issues []ConformanceIssue
+	var inserted []insertion
+
+	// Required slides are looked for in order, each after the previous one
+	// found, and missing ones are inserted where they belong
+	after := -1
+	var previous *RequiredSlide
+	for i := range s.Slides {
+		required := &s.Slides[i]
+		index := findRequired(data, required, after+1)
+
+		if index < 0 && previous != nil {
+			// Present, but before a slide the standard puts ahead of it
+			if earlier := findRequired(data, required, 0); earlier >= 0 {
+				issues = append(issues, ConformanceIssue{
+					Message: fmt.Sprintf("%s slide (slide %d) should come after the %s slide", required.Name, earlier+1, previous.Name),
+				})
+				continue
+			}
+		}
+
+		if index < 0 {
+			if fix && required.Slide != nil {
+				at := after + 1
+				switch required.Position {
+				case PositionFirst:
+					at = 0
+				case PositionLast:
+					at = len(data.Slides)
+				}
+				slide := Slide{Slide: *required.Slide, ID: newSlideID()}
+				data.Slides = slices.Insert(data.Slides, at, slide)
+				inserted = append(inserted, insertion{name: required.Name, index: at, slide: slide})
+				after, previous = at, required
+				continue
+			}
+			issues = append(issues, ConformanceIssue{
+				Message: fmt.Sprintf("missing %s slide", required.Name),
+				Fixable: required.Slide != nil,
+			})
+			continue
+		}
+		after, previous = index, required
+
+		switch required.Position {
+		case PositionFirst:
+			if index != firstMainSlide(data) {
+				issues = append(issues, ConformanceIssue{Message: fmt.Sprintf("%s slide (slide %d) should be the first slide", required.Name, index+1)})
+			}
+		case PositionLast:
+			if index != lastMainSlide(data) {
+				issues = append(issues, ConformanceIssue{Message: fmt.Sprintf("%s slide (slide %d) should be the last slide", required.Name, index+1)})
+			}
+		}
+	}
+
+	for _, key := range s.Metadata {
+		if strings.TrimSpace(MetadataValue(data.Metadata, key)) == "" {
+			issues = append(issues, ConformanceIssue{Message: fmt.Sprintf("metadata field %s is not set", key)})
+		}
+	}
+
+	if len(s.Themes) > 0 && !slices.Contains(s.Themes, data.Metadata.Theme) {
+		issues = append(issues, ConformanceIssue{
+			Message: fmt.Sprintf("theme %q is not approved (expected one of: %s)", data.Metadata.Theme, strings.Join(s.Themes, ", ")),
+		})
+	}
+
+	return issues, inserted
+}
+
+// findRequired returns the index of the first slide from start on that
+// satisfies the requirement, or -1. Hidden slides do not count.
+func findRequired(data *PresentationData, required *RequiredSlide, start int) int {
+	for i := start; i < len(data.Slides); i++ {
+		if !data.Slides[i].Hidden && required.matches(data.Slides[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// firstMainSlide returns the index of the first slide that is not hidden
+func firstMainSlide(data *PresentationData) int {
+	for i, slide := range data.Slides {
+		if !slide.Hidden {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastMainSlide returns the index of the last slide that is not hidden
+func lastMainSlide(data *PresentationData) int {
+	for i := len(data.Slides) - 1; i >= 0; i-- {
+		if !data.Slides[i].Hidden {
+			return i
+		}
+	}
+	return -1
+}
+
+// Conform inserts the boilerplate of the standard's missing slides into the
+// presentation at path. It returns the names of the inserted slides and the
+// issues left to fix by hand.
+func (w *Writer) Conform(path string, standard *Standard) ([]string, []ConformanceIssue, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check the resolved slides, so shared boilerplate counts, then make the
+	// same insertions in the stored ones
+	resolved, err := NewGenerator().ResolveReferences(data, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	issues, inserted := standard.conform(resolved, true)
+	if len(inserted) == 0 {
+		return nil, issues, nil
+	}
+
+	names := make([]string, 0, len(inserted))
+	for _, insert := range inserted {
+		data.Slides = slices.Insert(data.Slides, insert.index, insert.slide)
+		names = append(names, insert.name)
+	}
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, nil, err
+	}
+
+	return names, issues, nil
+}
@@ -0,0 +1,257 @@
+package presentation
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultContextBudget is the number of tokens the deck, request, and Q&A
+// may take up in an update prompt, leaving room for the instructions and
+// the generated operations
+const DefaultContextBudget = 60000
+
+// contextNote tells the model how to treat slides left out of the context
+const contextNote = `Note: not every slide is included in full. Slides marked (summarized) show
+only their title. Do not modify them without their content, and keep the slide
+indexes as listed.`
+
+// EstimateTokens approximates the number of model tokens in text, at about
+// four bytes per token
+func EstimateTokens(text string) int {
+	return (len(text) + 3) / 4
+}
+
+// EstimateTokensAll approximates the number of model tokens in texts
+func EstimateTokensAll(texts []string) int {
+	total := 0
+	for _, text := range texts {
+		total += EstimateTokens(text)
+	}
+	return total
+}
+
+// DeckContext is the presentation as sent to the model with a request
+type DeckContext struct {
+	Text   string
+	Tokens int
+	// Full is the number of slides included verbatim
+	Full int
+	// Summarized is the number of slides reduced to their title or folded
+	// into a range of slides
+	Summarized int
+}
+
+// slideDetail is how much of a slide a deck context includes
+type slideDetail int
+
+const (
+	detailFull slideDetail = iota
+	detailTitle
+	detailRange
+)
+
+// BuildContext renders the presentation for a request whose own text takes
+// up reserved tokens, within budget tokens overall. When the whole deck does
+// not fit, the focus slides (0-based indexes) are kept verbatim along with as
+// many of their neighbours as fit, other slides are reduced to their title,
+// and if that is still too much, runs of untouched slides are folded into a
+// single line, earliest first.
+func (data *PresentationData) BuildContext(focus []int, reserved, budget int) DeckContext {
+	details := make([]slideDetail, len(data.Slides))
+	context := data.renderContext(details)
+	if budget <= 0 || EstimateTokens(context.Text)+reserved <= budget {
+		return context
+	}
+	return data.trimContext(focus, reserved, budget, true)
+}
+
+// BuildFocusedContext renders the presentation with only the focus slides
+// verbatim and the others reduced to their title, even when the whole deck
+// would fit the budget. Without focus slides, it is the same as BuildContext.
+func (data *PresentationData) BuildFocusedContext(focus []int, reserved, budget int) DeckContext {
+	if !slices.ContainsFunc(focus, func(index int) bool { return index >= 0 && index < len(data.Slides) }) {
+		return data.BuildContext(focus, reserved, budget)
+	}
+	if budget <= 0 {
+		budget = math.MaxInt - reserved
+	}
+	return data.trimContext(focus, reserved, budget, false)
+}
+
+// trimContext renders the focus slides verbatim and reduces the others to
+// fit the budget. With expand, the slides closest to the focus are brought
+// back in full while there is room.
+func (data *PresentationData) trimContext(focus []int, reserved, budget int, expand bool) DeckContext {
+	focused := make(map[int]bool, len(focus))
+	for _, index := range focus {
+		if index >= 0 && index < len(data.Slides) {
+			focused[index] = true
+		}
+	}
+
+	details := make([]slideDetail, len(data.Slides))
+	var others []int
+	for i := range details {
+		if !focused[i] {
+			details[i] = detailTitle
+			others = append(others, i)
+		}
+	}
+	var context DeckContext
+	fits := func() bool {
+		context = data.renderContext(details)
+		return EstimateTokens(context.Text)+reserved <= budget
+	}
+
+	if fits() {
+		if !expand {
+			return context
+		}
+		sort.SliceStable(others, func(i, j int) bool {
+			return focusDistance(others[i], focused) < focusDistance(others[j], focused)
+		})
+		for _, index := range others {
+			details[index] = detailFull
+			if !fits() {
+				details[index] = detailTitle
+			}
+		}
+		return data.renderContext(details)
+	}
+
+	for _, run := range titleRuns(details) {
+		for _, index := range run {
+			details[index] = detailRange
+		}
+		if fits() {
+			break
+		}
+	}
+	return context
+}
+
+// focusDistance returns how far index is from the nearest focus slide; with
+// no focus, earlier slides come first
+func focusDistance(index int, focused map[int]bool) int {
+	if len(focused) == 0 {
+		return index
+	}
+	distance := -1
+	for f := range focused {
+		d := index - f
+		if d < 0 {
+			d = -d
+		}
+		if distance < 0 || d < distance {
+			distance = d
+		}
+	}
+	return distance
+}
+
+// titleRuns returns the runs of consecutive slides reduced to their title,
+// longest first and earliest first among runs of the same length
+func titleRuns(details []slideDetail) [][]int {
+	var runs [][]int
+	var run []int
+	for i, detail := range details {
+		if detail == detailTitle {
+			run = append(run, i)
+			continue
+		}
+		if len(run) > 0 {
+			runs = append(runs, run)
+			run = nil
+		}
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return len(runs[i]) > len(runs[j])
+	})
+	return runs
+}
+
+// renderContext renders the deck with each slide at the given detail
+func (data *PresentationData) renderContext(details []slideDetail) DeckContext {
+	var context DeckContext
+	var sb strings.Builder
+	sb.WriteString(data.GetSummary())
+	if slices.ContainsFunc(details, func(d slideDetail) bool { return d != detailFull }) {
+		sb.WriteString("\n\n")
+		sb.WriteString(contextNote)
+	}
+
+	for i := 0; i < len(details); i++ {
+		sb.WriteString("\n\n")
+		switch details[i] {
+		case detailFull:
+			sb.WriteString(strings.TrimRight(data.GetSlideSummary(i), "\n"))
+			context.Full++
+		case detailTitle:
+			fmt.Fprintf(&sb, "Slide %d (index %d) (summarized)\nTitle: %s", i+1, i, data.Slides[i].Title)
+			if data.Slides[i].Locked {
+				sb.WriteString("\nLocked: yes")
+			}
+			context.Summarized++
+		case detailRange:
+			end := i
+			for end+1 < len(details) && details[end+1] == detailRange {
+				end++
+			}
+			fmt.Fprintf(&sb, "Slides %d-%d (index %d-%d) (summarized)\n%d slides, from %q to %q",
+				i+1, end+1, i, end, end-i+1, data.Slides[i].Title, data.Slides[end].Title)
+			context.Summarized += end - i + 1
+			i = end
+		}
+	}
+
+	context.Text = sb.String()
+	context.Tokens = EstimateTokens(context.Text)
+	return context
+}
+
+// slideMentionPattern matches slide numbers in a request, such as "slide 3",
+// "slides 4-6", or "slides 2, 5 and 7"
+var slideMentionPattern = regexp.MustCompile(`(?i)\bslides?\s+#?(\d+(?:\s*(?:-|–|to|,|and|&)\s*#?\d+)*)`)
+
+// numberPattern matches the numbers in a slide mention
+var numberPattern = regexp.MustCompile(`\d+`)
+
+// MentionedSlides returns the slides a request refers to by number, as
+// 0-based indexes
+func MentionedSlides(request string) []int {
+	var indexes []int
+	for _, match := range slideMentionPattern.FindAllStringSubmatch(request, -1) {
+		numbers := numberPattern.FindAllStringIndex(match[1], -1)
+		for i, loc := range numbers {
+			number, _ := strconv.Atoi(match[1][loc[0]:loc[1]])
+			if number < 1 {
+				continue
+			}
+			// A range runs from the previous number to this one
+			if i > 0 && rangeSeparator(match[1][numbers[i-1][1]:loc[0]]) {
+				previous, _ := strconv.Atoi(match[1][numbers[i-1][0]:numbers[i-1][1]])
+				for n := previous + 1; n < number; n++ {
+					indexes = append(indexes, n-1)
+				}
+			}
+			indexes = append(indexes, number-1)
+		}
+	}
+	slices.Sort(indexes)
+	return slices.Compact(indexes)
+}
+
+// rangeSeparator reports whether the text between two slide numbers makes
+// them a range
+func rangeSeparator(text string) bool {
+	text = strings.TrimSpace(strings.Trim(strings.TrimSpace(text), "#"))
+	return text == "-" || text == "–" || strings.EqualFold(text, "to")
+}
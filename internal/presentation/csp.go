@@ -0,0 +1,183 @@
+package presentation
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"iter"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// revealCDN is where generated decks load reveal.js from
+const revealCDN = "https://cdn.jsdelivr.net"
+
+// Google Fonts origins, used by the themes in googleFontThemes
+const (
+	googleFontsCSS   = "https://fonts.googleapis.com"
+	googleFontsFiles = "https://fonts.gstatic.com"
+)
+
+// googleFontThemes are the reveal.js themes that import their fonts from
+// Google Fonts
+var googleFontThemes = map[string]bool{
+	"league":    true,
+	"beige":     true,
+	"sky":       true,
+	"night":     true,
+	"simple":    true,
+	"solarized": true,
+}
+
+var (
+	// inlineScriptPattern and inlineStylePattern match the inline script and
+	// style blocks of a generated deck
+	inlineScriptPattern = regexp.MustCompile(`(?s)<script>(.*?)</script>`)
+	inlineStylePattern  = regexp.MustCompile(`(?s)<style>(.*?)</style>`)
+
+	// resourceAttrPattern matches HTML attributes in slide content that load
+	// a resource
+	resourceAttrPattern = regexp.MustCompile(`(?i)\b(?:src|poster|data|data-background-image|data-background-video|data-background-iframe)\s*=\s*["']?([^"'\s>]+)`)
+
+	// stylesheetPattern matches stylesheet links and CSS urls in slide content
+	stylesheetPattern = regexp.MustCompile(`(?i)<link\b[^>]*\bhref\s*=\s*["']?([^"'\s>]+)|url\(\s*["']?([^"')\s]+)`)
+
+	// blockedContentPattern matches inline code that a content security
+	// policy blocks: script tags, event handler attributes, javascript: urls,
+	// and style attributes
+	blockedContentPattern = regexp.MustCompile(`(?i)<script\b|\son[a-z]+\s*=|javascript:|\sstyle\s*=`)
+)
+
+// normalizeOrigin reduces an allowlist entry to the origin it names, e.g.
+// "https://images.example.com/" to "https://images.example.com"
+func normalizeOrigin(origin string) string {
+	u, err := url.Parse(strings.TrimSpace(origin))
+	if err != nil || u.Host == "" {
+		return strings.TrimRight(strings.TrimSpace(origin), "/")
+	}
+	return strings.ToLower(u.Scheme) + "://" + strings.ToLower(u.Host)
+}
+
+// allowedOrigins returns the origins a deck may load resources from besides
+// its own: the reveal.js CDN and the generator's allowlist
+func (g *Generator) allowedOrigins() []string {
+	origins := []string{revealCDN}
+	for _, origin := range g.Allowlist {
+		if origin = normalizeOrigin(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// isAllowedResource reports whether src is local to the deck or served from
+// an allowed origin
+func (g *Generator) isAllowedResource(src string) bool {
+	if strings.HasPrefix(src, "data:") || strings.HasPrefix(src, "#") {
+		return true
+	}
+	u, err := url.Parse(src)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" && u.Host == "" {
+		return true
+	}
+	if u.Scheme == "" {
+		u.Scheme = "https"
+	}
+	origin := strings.ToLower(u.Scheme) + "://" + strings.ToLower(u.Host)
+	for _, allowed := range g.allowedOrigins() {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckResources verifies that everything a deck loads is local or from an
+// allowed origin, and that its slides contain no inline code a content
+// security policy would block. Each problem is returned as a message.
+func (g *Generator) CheckResources(data *PresentationData) []string {
+	var problems []string
+
+	if googleFontThemes[data.Metadata.Theme] && (!g.isAllowedResource(googleFontsCSS) || !g.isAllowedResource(googleFontsFiles)) {
+		problems = append(problems, fmt.Sprintf("theme %q loads fonts from Google Fonts; allow %s and %s, or use a theme with local fonts such as black or white",
+			data.Metadata.Theme, googleFontsCSS, googleFontsFiles))
+	}
+
+	for i, slide := range data.Slides {
+		var sources []string
+		if slide.Audio != "" {
+			sources = append(sources, slide.Audio)
+		}
+		if slide.Image != "" {
+			sources = append(sources, slide.Image)
+		}
+		for _, m := range imagePattern.FindAllStringSubmatch(slide.Content, -1) {
+			sources = append(sources, m[1])
+		}
+		for _, m := range resourceAttrPattern.FindAllStringSubmatch(slide.Content, -1) {
+			sources = append(sources, m[1])
+		}
+		for _, m := range stylesheetPattern.FindAllStringSubmatch(slide.Content, -1) {
+			sources = append(sources, m[1]+m[2])
+		}
+
+		for _, src := range sources {
+			if !g.isAllowedResource(src) {
+				problems = append(problems, fmt.Sprintf("slide %d: %s is not local or allowlisted", i+1, src))
+			}
+		}
+		if m := blockedContentPattern.FindString(slide.Content); m != "" {
+			problems = append(problems, fmt.Sprintf("slide %d: inline code (%s) would be blocked by the content security policy", i+1, strings.TrimSpace(m)))
+		}
+	}
+
+	return problems
+}
+
+// ContentSecurityPolicy returns the policy for a generated deck. Its inline
+// scripts and styles are allowed by hash, so nothing injected later can run.
+func (g *Generator) ContentSecurityPolicy(html string) string {
+	return g.contentSecurityPolicy(slices.Values([]string{html}))
+}
+
+// contentSecurityPolicy returns the policy for a deck generated in parts,
+// such as its page and each of its slides, so the deck never has to be held
+// in memory whole. An inline script or style may not span parts.
+func (g *Generator) contentSecurityPolicy(parts iter.Seq[string]) string {
+	origins := strings.Join(g.allowedOrigins(), " ")
+	extra := strings.Join(g.allowedOrigins()[1:], " ")
+
+	var scripts, styles []string
+	hashes := func(sources []string, pattern *regexp.Regexp, html string) []string {
+		for _, m := range pattern.FindAllStringSubmatch(html, -1) {
+			sum := sha256.Sum256([]byte(m[1]))
+			sources = append(sources, "'sha256-"+base64.StdEncoding.EncodeToString(sum[:])+"'")
+		}
+		return sources
+	}
+	for html := range parts {
+		scripts = hashes(scripts, inlineScriptPattern, html)
+		styles = hashes(styles, inlineStylePattern, html)
+	}
+
+	directives := []string{
+		strings.TrimSpace("default-src 'self' " + extra),
+		"script-src 'self' " + origins + " " + strings.Join(scripts, " "),
+		"style-src 'self' " + origins + " " + strings.Join(styles, " "),
+		strings.TrimSpace("img-src 'self' data: blob: " + extra),
+		strings.TrimSpace("media-src 'self' blob: " + extra),
+		"font-src 'self' data: " + origins,
+		"connect-src 'self' " + origins,
+		"manifest-src 'self'",
+		"worker-src 'self'",
+		"object-src 'none'",
+		"base-uri 'self'",
+		"form-action 'self'",
+	}
+	return strings.Join(directives, "; ")
+}
@@ -0,0 +1,47 @@
+package presentation
+
+import (
+	"sort"
+	"time"
+)
+
+// SetCustom sets a custom metadata field, removing it when value is empty
+func (m *Metadata) SetCustom(key, value string) {
+	if value == "" {
+		delete(m.Custom, key)
+		return
+	}
+	if m.Custom == nil {
+		m.Custom = make(map[string]string)
+	}
+	m.Custom[key] = value
+}
+
+// GetCustomKeys returns the custom metadata keys in sorted order
+func (m *Metadata) GetCustomKeys() []string {
+	keys := make([]string, 0, len(m.Custom))
+	for key := range m.Custom {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SetCustomFields merges custom metadata fields into the presentation at path
+func (w *Writer) SetCustomFields(path string, fields map[string]string) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range fields {
+		data.Metadata.SetCustom(key, value)
+	}
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,211 @@
+package presentation
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"path/filepath"
+	"strings"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// demoRevenue is the quarterly revenue shown by the demo deck's table and
+// chart, in millions
+var demoRevenue = []struct {
+	quarter string
+	revenue float64
+}{
+	{"Q1", 4.2},
+	{"Q2", 5.1},
+	{"Q3", 6.8},
+	{"Q4", 8.3},
+}
+
+// DemoDeck returns a fully populated sample presentation, made without AI,
+// for trying out themes, templates, and exporters: a slide in every layout,
+// speaker notes throughout, a table, a chart and a picture, a footnote,
+// variables, slide tags, and a backup slide. path is where the deck will be
+// saved, which its images are stored next to; write them with DemoImages.
+func DemoDeck(path, theme string) *PresentationData {
+	var table strings.Builder
+	table.WriteString("| Quarter | Revenue |\n|---------|---------|\n")
+	for _, q := range demoRevenue {
+		fmt.Fprintf(&table, "| %s | $%.1fM |\n", q.quarter, q.revenue)
+	}
+
+	chart, _ := demoImagePath(path, "revenue-chart.png")
+	picture, _ := demoImagePath(path, "horizon.png")
+
+	deck := &types.Presentation{
+		Title:    "{{company}} Year in Review",
+		Subtitle: "A sample deck made by pres demo",
+		Author:   "pres",
+		Theme:    theme,
+		Tags:     []string{"demo", "sample"},
+		Slides: []types.Slide{
+			{
+				Title:   "{{company}} Year in Review",
+				Layout:  "title",
+				Content: "A sample deck showing everything a presentation can hold",
+				Notes:   "Welcome. This deck was made by pres demo, without AI, to try out themes, templates, and exporters.",
+			},
+			{
+				Title:   "Agenda",
+				Layout:  "content",
+				Content: "- Where we started\n- What changed\n- The numbers\n- What comes next",
+				Notes:   "A content slide: a title and a few bullet points, the layout most slides use.",
+			},
+			{
+				Title:   "Where We Started",
+				Layout:  "title",
+				Content: "Looking back at the year",
+				Notes:   "A title slide opens each section. This one shows a picture as its background.",
+			},
+			{
+				Title:   "Before and After",
+				Layout:  "two-column",
+				Content: "### Before\n- Manual builds\n- Weekly releases\n- Outages found by customers\n|||\n### After\n- Automated pipeline\n- Daily releases\n- Alerts before customers notice",
+				Notes:   "Two columns, split with three pipes in the content, compare two things side by side.",
+			},
+			{
+				Title:   "Revenue doubled in a year",
+				Layout:  "assertion-evidence",
+				Content: table.String() + "\nFigures are unaudited.[^audit]\n\n[^audit]: Audited results are published in the annual report.",
+				Notes:   "An assertion-evidence slide: the headline states the takeaway and the table backs it up. The footnote is numbered when the deck is generated.",
+			},
+			{
+				Title:   "Revenue by Quarter",
+				Layout:  "content",
+				Content: "Growth picked up every quarter, with Q4 the strongest yet.",
+				Notes:   "A chart image, shown below the slide's content.",
+			},
+			{
+				Title:   "Code Sample",
+				Layout:  "content",
+				Content: "```go\nfunc release(build Build) error {\n    if err := build.Test(); err != nil {\n        return err\n    }\n    return build.Deploy()\n}\n```",
+				Notes:   "Code blocks are highlighted by reveal.js.",
+			},
+			{
+				Layout:  "blank",
+				Content: "> Simplicity is prerequisite for reliability.\n>\n> — Edsger W. Dijkstra",
+				Notes:   "A blank slide for a quote or a full-screen image.",
+			},
+			{
+				Title:   "What Comes Next",
+				Layout:  "content",
+				Content: "1. Expand to two new regions\n2. Ship the public API\n3. Hire **{{hires}}** engineers",
+				Notes:   "Variables such as the number of hires are filled in from the deck's metadata when it is generated.",
+			},
+			{
+				Title:            "Thank You",
+				Layout:           "title",
+				Content:          "Questions?",
+				Notes:            "A closing slide with its own background color.",
+				Background_color: "#1a1a2e",
+			},
+			{
+				Title:   "Detailed Figures",
+				Layout:  "content",
+				Content: "- Revenue: $24.4M\n- Customers: 1,240\n- Churn: 3.1%",
+				Notes:   "A backup slide, hidden from the main flow and moved behind an Appendix divider.",
+			},
+		},
+	}
+
+	data := NewPresentationData(deck)
+	data.Metadata.Variables = map[string]string{"company": "Acme", "hires": "12"}
+	data.Slides[2].Image = picture
+	data.Slides[5].Image = chart
+	data.Slides[10].Hidden = true
+	data.Slides[4].Tags = []string{"finance"}
+	data.Slides[5].Tags = []string{"finance"}
+	data.Slides[10].Tags = []string{"finance", "backup"}
+	return data
+}
+
+// DemoImages draws the images of the demo deck saved at path, returning
+// their contents by their path on disk
+func DemoImages(path string) (map[string][]byte, error) {
+	images := map[string]image.Image{
+		"revenue-chart.png": demoChart(),
+		"horizon.png":       demoPicture(),
+	}
+	files := make(map[string][]byte, len(images))
+	for name, img := range images {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+		_, file := demoImagePath(path, name)
+		files[file] = buf.Bytes()
+	}
+	return files, nil
+}
+
+// demoImagePath returns where a demo image is stored, like ImagePath: the
+// path relative to the presentation's directory and the path on disk
+func demoImagePath(path, name string) (rel, file string) {
+	deck := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	rel = filepath.ToSlash(filepath.Join(AssetsDir, deck, "images", name))
+	return rel, filepath.Join(filepath.Dir(path), filepath.FromSlash(rel))
+}
+
+// demoChart draws a bar chart of the demo revenue
+func demoChart() image.Image {
+	const width, height, margin = 800, 400, 40
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{245, 245, 245, 255}), image.Point{}, draw.Src)
+
+	axis := image.NewUniform(color.RGBA{80, 80, 80, 255})
+	draw.Draw(img, image.Rect(margin, height-margin, width-margin, height-margin+2), axis, image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(margin, margin, margin+2, height-margin), axis, image.Point{}, draw.Src)
+
+	peak := 0.0
+	for _, q := range demoRevenue {
+		peak = max(peak, q.revenue)
+	}
+	slot := (width - 2*margin) / len(demoRevenue)
+	bar := image.NewUniform(color.RGBA{66, 133, 244, 255})
+	for i, q := range demoRevenue {
+		top := height - margin - int(q.revenue/peak*float64(height-3*margin))
+		left := margin + i*slot + slot/4
+		draw.Draw(img, image.Rect(left, top, left+slot/2, height-margin), bar, image.Point{}, draw.Src)
+	}
+	return img
+}
+
+// demoPicture draws a sunset over the sea
+func demoPicture() image.Image {
+	const width, height = 960, 540
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	horizon := height * 2 / 3
+	for y := range height {
+		var c color.RGBA
+		if y < horizon {
+			// Sky, from deep blue to orange at the horizon
+			t := float64(y) / float64(horizon)
+			c = color.RGBA{uint8(30 + t*225), uint8(40 + t*120), uint8(90 - t*40), 255}
+		} else {
+			// Sea, darkening towards the bottom
+			t := float64(y-horizon) / float64(height-horizon)
+			c = color.RGBA{uint8(40 - t*30), uint8(60 - t*40), uint8(110 - t*60), 255}
+		}
+		draw.Draw(img, image.Rect(0, y, width, y+1), image.NewUniform(c), image.Point{}, draw.Src)
+	}
+
+	// The sun, half set
+	sun := color.RGBA{255, 210, 120, 255}
+	cx, cy, r := width/2, horizon, 70
+	for y := cy - r; y < cy; y++ {
+		for x := cx - r; x <= cx+r; x++ {
+			if (x-cx)*(x-cx)+(y-cy)*(y-cy) <= r*r {
+				img.Set(x, y, sun)
+			}
+		}
+	}
+	return img
+}
@@ -0,0 +1,392 @@
+package presentation
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ChangeKind is what happened to a slide between two versions of a deck
+type ChangeKind string
+
+const (
+	// SlideAdded is a slide that is new in the later version
+	SlideAdded ChangeKind = "added"
+	// SlideDeleted is a slide that is gone from the later version
+	SlideDeleted ChangeKind = "deleted"
+	// SlideModified is a slide whose fields changed
+	SlideModified ChangeKind = "modified"
+	// SlideMoved is a slide that only changed position
+	SlideMoved ChangeKind = "moved"
+)
+
+// SlideChange describes how a slide differs between two versions of a deck.
+// Slides are matched by ID.
+type SlideChange struct {
+	Kind ChangeKind
+	// Index is the slide's position in the later version, or in the
+	// earlier version for deleted slides (0-based)
+	Index int
+	// OldIndex is the slide's position in the earlier version, or -1 for
+	// added slides
+	OldIndex int
+	Title    string
+	// Details lists what changed, e.g. "title changed" or "2 bullets added"
+	Details []string
+}
+
+// MetadataChange is a metadata field that differs between two versions of
+// a deck
+type MetadataChange struct {
+	Key string
+	Old string
+	New string
+}
+
+// DeckDiff is the difference between two versions of a deck
+type DeckDiff struct {
+	Slides   []SlideChange
+	Metadata []MetadataChange
+}
+
+// IsEmpty reports whether the two versions are the same
+func (d DeckDiff) IsEmpty() bool {
+	return len(d.Slides) == 0 && len(d.Metadata) == 0
+}
+
+// bulletPattern matches a markdown list item
+var bulletPattern = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+`)
+
+// DiffPresentations compares two versions of a deck, slide by slide and
+// field by field. Changes are ordered by slide position, with deleted slides
+// at their old position.
+func DiffPresentations(before, after *PresentationData) DeckDiff {
+	var diff DeckDiff
+
+	matches := matchSlides(before, after)
+
+	// Slides kept in the same relative order are not moved; the others are
+	kept := make([]int, 0, len(after.Slides))
+	for _, old := range matches {
+		if old >= 0 {
+			kept = append(kept, old)
+		}
+	}
+	inOrder := longestIncreasing(kept)
+
+	present := make(map[int]bool, len(after.Slides))
+	for i, slide := range after.Slides {
+		old := matches[i]
+		if old < 0 {
+			diff.Slides = append(diff.Slides, SlideChange{Kind: SlideAdded, Index: i, OldIndex: -1, Title: slide.Title})
+			continue
+		}
+		present[old] = true
+
+		change := SlideChange{Kind: SlideModified, Index: i, OldIndex: old, Title: slide.Title}
+		change.Details = diffSlide(before.Slides[old], slide)
+		if !inOrder[old] {
+			change.Details = append([]string{fmt.Sprintf("moved from slide %d", old+1)}, change.Details...)
+			if len(change.Details) == 1 {
+				change.Kind = SlideMoved
+			}
+		}
+		if len(change.Details) > 0 {
+			diff.Slides = append(diff.Slides, change)
+		}
+	}
+
+	for i, slide := range before.Slides {
+		if !present[i] {
+			diff.Slides = append(diff.Slides, SlideChange{Kind: SlideDeleted, Index: i, OldIndex: i, Title: slide.Title})
+		}
+	}
+	sort.SliceStable(diff.Slides, func(i, j int) bool {
+		return diff.Slides[i].Index < diff.Slides[j].Index
+	})
+
+	diff.Metadata = diffMetadata(before.Metadata, after.Metadata)
+	return diff
+}
+
+// matchSlides returns, for each slide of after, the index of the same slide
+// in before, or -1 for new slides. Slides are matched by ID. Slides saved
+// before pres assigned IDs are matched by title instead.
+func matchSlides(before, after *PresentationData) []int {
+	oldIndexes := make(map[string]int, len(before.Slides))
+	for i, slide := range before.Slides {
+		if slide.ID != "" {
+			oldIndexes[slide.ID] = i
+		}
+	}
+
+	matches := make([]int, len(after.Slides))
+	matched := make(map[int]bool, len(before.Slides))
+	for i, slide := range after.Slides {
+		matches[i] = -1
+		if old, ok := oldIndexes[slide.ID]; ok && slide.ID != "" {
+			matches[i] = old
+			matched[old] = true
+		}
+	}
+
+	for i, slide := range after.Slides {
+		if matches[i] >= 0 {
+			continue
+		}
+		for old, candidate := range before.Slides {
+			if candidate.ID == "" && !matched[old] && candidate.Title == slide.Title {
+				matches[i] = old
+				matched[old] = true
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// diffSlide lists what changed between two versions of a slide
+func diffSlide(before, after Slide) []string {
+	var details []string
+	if before.Title != after.Title {
+		details = append(details, "title changed")
+	}
+	if before.Layout != after.Layout {
+		details = append(details, fmt.Sprintf("layout %s → %s", before.Layout, after.Layout))
+	}
+	details = append(details, diffContent(before.Content, after.Content)...)
+	if before.Notes != after.Notes {
+		details = append(details, "notes changed")
+	}
+	if before.Background_color != after.Background_color {
+		details = append(details, "background changed")
+	}
+	if before.Image_prompt != after.Image_prompt {
+		details = append(details, "image prompt changed")
+	}
+	if before.Ref != after.Ref {
+		if after.Ref == "" {
+			details = append(details, "detached from "+before.Ref)
+		} else {
+			details = append(details, "reference changed")
+		}
+	}
+	if before.Hidden != after.Hidden {
+		if after.Hidden {
+			details = append(details, "hidden")
+		} else {
+			details = append(details, "shown")
+		}
+	}
+	if before.Locked != after.Locked {
+		if after.Locked {
+			details = append(details, "locked")
+		} else {
+			details = append(details, "unlocked")
+		}
+	}
+	if !slices.Equal(before.Tags, after.Tags) {
+		details = append(details, "tags changed")
+	}
+	return details
+}
+
+// diffContent describes a content change in terms of bullets added and
+// removed, and whether the other text changed
+func diffContent(before, after string) []string {
+	if before == after {
+		return nil
+	}
+
+	oldBullets, oldText := splitBullets(before)
+	newBullets, newText := splitBullets(after)
+
+	var details []string
+	added, removed := 0, 0
+	remaining := make(map[string]int, len(oldBullets))
+	for _, bullet := range oldBullets {
+		remaining[bullet]++
+	}
+	for _, bullet := range newBullets {
+		if remaining[bullet] > 0 {
+			remaining[bullet]--
+			continue
+		}
+		added++
+	}
+	for _, count := range remaining {
+		removed += count
+	}
+
+	// A bullet rewritten in place is one edit, not an addition and a removal
+	edited := min(added, removed)
+	added -= edited
+	removed -= edited
+	if edited > 0 {
+		details = append(details, countNoun(edited, "bullet")+" edited")
+	}
+	if added > 0 {
+		details = append(details, countNoun(added, "bullet")+" added")
+	}
+	if removed > 0 {
+		details = append(details, countNoun(removed, "bullet")+" removed")
+	}
+	if oldText != newText {
+		details = append(details, "content changed")
+	}
+	if len(details) == 0 {
+		if slices.Equal(oldBullets, newBullets) {
+			details = append(details, "content reformatted")
+		} else {
+			details = append(details, "bullets reordered")
+		}
+	}
+	return details
+}
+
+// splitBullets returns the list items of markdown content, and the rest of
+// its text
+func splitBullets(content string) ([]string, string) {
+	var bullets []string
+	var text []string
+	for _, line := range strings.Split(content, "\n") {
+		if bulletPattern.MatchString(line) {
+			bullets = append(bullets, strings.TrimSpace(bulletPattern.ReplaceAllString(line, "")))
+			continue
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			text = append(text, line)
+		}
+	}
+	return bullets, strings.Join(text, "\n")
+}
+
+// countNoun renders a count with a noun, e.g. "1 bullet" or "2 bullets"
+func countNoun(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
+
+// diffMetadata lists the update_metadata fields that differ between two
+// versions of the metadata
+func diffMetadata(before, after Metadata) []MetadataChange {
+	keys := slices.Clone(metadataKeys)
+	custom := maps.Clone(before.Custom)
+	if custom == nil {
+		custom = make(map[string]string)
+	}
+	maps.Copy(custom, after.Custom)
+	for _, name := range slices.Sorted(maps.Keys(custom)) {
+		keys = append(keys, "custom."+name)
+	}
+
+	var changes []MetadataChange
+	for _, key := range keys {
+		old, updated := MetadataValue(before, key), MetadataValue(after, key)
+		if old != updated {
+			changes = append(changes, MetadataChange{Key: key, Old: old, New: updated})
+		}
+	}
+	return changes
+}
+
+// longestIncreasing returns the values of the longest increasing
+// subsequence of values, which are distinct
+func longestIncreasing(values []int) map[int]bool {
+	// tails[k] is the position in values of the smallest tail of an
+	// increasing subsequence of length k+1
+	var tails []int
+	previous := make([]int, len(values))
+	for i, value := range values {
+		k := sort.Search(len(tails), func(j int) bool { return values[tails[j]] >= value })
+		if k > 0 {
+			previous[i] = tails[k-1]
+		} else {
+			previous[i] = -1
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	result := make(map[int]bool, len(tails))
+	if len(tails) == 0 {
+		return result
+	}
+	for i := tails[len(tails)-1]; i >= 0; i = previous[i] {
+		result[values[i]] = true
+	}
+	return result
+}
+
+// LineOp is what happened to a line between two versions of a text
+type LineOp byte
+
+const (
+	// LineKept is a line in both versions
+	LineKept LineOp = ' '
+	// LineAdded is a line only in the later version
+	LineAdded LineOp = '+'
+	// LineRemoved is a line only in the earlier version
+	LineRemoved LineOp = '-'
+)
+
+// LineChange is a line of a text diff
+type LineChange struct {
+	Op   LineOp
+	Text string
+}
+
+// DiffLines compares two versions of a text line by line, keeping the
+// longest run of common lines. Removed lines come before the lines added in
+// their place.
+func DiffLines(before, after string) []LineChange {
+	var old, updated []string
+	if before != "" {
+		old = strings.Split(before, "\n")
+	}
+	if after != "" {
+		updated = strings.Split(after, "\n")
+	}
+
+	// common[i][j] is the length of the longest common subsequence of
+	// old[i:] and updated[j:]
+	common := make([][]int, len(old)+1)
+	for i := range common {
+		common[i] = make([]int, len(updated)+1)
+	}
+	for i := len(old) - 1; i >= 0; i-- {
+		for j := len(updated) - 1; j >= 0; j-- {
+			if old[i] == updated[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	changes := make([]LineChange, 0, max(len(old), len(updated)))
+	i, j := 0, 0
+	for i < len(old) || j < len(updated) {
+		switch {
+		case i < len(old) && j < len(updated) && old[i] == updated[j]:
+			changes = append(changes, LineChange{Op: LineKept, Text: old[i]})
+			i++
+			j++
+		case i < len(old) && (j == len(updated) || common[i+1][j] >= common[i][j+1]):
+			changes = append(changes, LineChange{Op: LineRemoved, Text: old[i]})
+			i++
+		default:
+			changes = append(changes, LineChange{Op: LineAdded, Text: updated[j]})
+			j++
+		}
+	}
+	return changes
+}
@@ -0,0 +1,93 @@
+package presentation
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"os"
+	"strings"
+)
+
+//go:embed document.html
+var defaultDocument string
+
+// defaultTemplate is the document template used unless one is loaded
+var defaultTemplate = template.Must(template.New("document.html").Parse(defaultDocument))
+
+// Document is what a document template is executed with: the deck's metadata,
+// and the parts of the page that pres generates, ready to be placed
+type Document struct {
+	Title    string
+	Subtitle string
+	Author   string
+	Date     string
+	Theme    string
+	// Custom holds the deck's custom metadata fields, e.g. {{.Custom.team}}
+	Custom map[string]string
+
+	// Head holds the tags the deck needs in <head>: the reveal.js and theme
+	// stylesheets, the layout styles, and the content security policy and
+	// offline manifest when enabled
+	Head template.HTML
+	// Slides holds the slide sections, to be placed in
+	// <div class="reveal"><div class="slides">
+	Slides template.HTML
+	// Scripts loads and starts reveal.js, with the footer and the webcam,
+	// narration, and offline scripts when enabled; it belongs at the end of
+	// <body>
+	Scripts template.HTML
+}
+
+// slidesMarker stands in for the slides when the document template is
+// rendered; the slides are written in its place one at a time
+const slidesMarker = "<!--pres:Slides-->"
+
+// documentParts are the fields a document template must place for the deck
+// to work
+var documentParts = []string{"Head", "Slides", "Scripts"}
+
+// LoadTemplate reads a custom document template, an html/template file
+// executed with a Document, for decks with their own head tags, company
+// wrapper, or analytics. The template must place the deck's Head, Slides,
+// and Scripts.
+func (g *Generator) LoadTemplate(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read template: %w", err)
+	}
+	tmpl, err := template.New(path).Parse(string(content))
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	// Execute the template with a marker in each part, so one that is left
+	// out or misspelled fails now rather than producing a broken deck
+	sample := Document{Title: "Sample", Theme: "black", Custom: map[string]string{}}
+	sample.Head, sample.Slides, sample.Scripts = "<!--pres:Head-->", "<!--pres:Slides-->", "<!--pres:Scripts-->"
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, sample); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	for _, part := range documentParts {
+		if !strings.Contains(sb.String(), "<!--pres:"+part+"-->") {
+			return fmt.Errorf("invalid template %s: it must place {{.%s}}", path, part)
+		}
+	}
+
+	g.document = tmpl
+	return nil
+}
+
+// executeDocument renders the document template with doc
+func (g *Generator) executeDocument(doc Document) (string, error) {
+	tmpl := g.document
+	if tmpl == nil {
+		tmpl = defaultTemplate
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, doc); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,79 @@
+package presentation
+
+import (
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// EditOperations returns the update operations that turn the deck's slides
+// into slides, an edited copy of them: slides are matched by ID, and slides
+// without one are new. Deleted slides are removed and changed slides
+// modified by ID, new slides are added at the end, and the slides are then
+// reordered to match, so the operations apply in sequence like any other
+// update and are audited and kept in each slide's history the same way. No
+// operations means nothing changed.
+func (data *PresentationData) EditOperations(slides []Slide) []types.PresentationUpdate {
+	edited := make(map[string]Slide, len(slides))
+	for _, slide := range slides {
+		if slide.ID != "" {
+			edited[slide.ID] = slide
+		}
+	}
+
+	var updates []types.PresentationUpdate
+	var kept []string
+	for _, slide := range data.Slides {
+		changed, ok := edited[slide.ID]
+		switch {
+		case !ok:
+			updates = append(updates, types.PresentationUpdate{
+				Operation: "delete_slide",
+				Slide_id:  slide.ID,
+				Rationale: "Deleted in the editor",
+			})
+			continue
+		case changed.Slide != slide.Slide:
+			updates = append(updates, types.PresentationUpdate{
+				Operation: "modify_slide",
+				Slide_id:  slide.ID,
+				New_slide: changed.Slide,
+				Rationale: "Edited in the editor",
+			})
+		}
+		kept = append(kept, slide.ID)
+	}
+
+	// After the deletes, the kept slides come first in their old order, then
+	// the new slides in the order they were added
+	order := make(map[string]int64, len(kept))
+	for i, id := range kept {
+		order[id] = int64(i)
+	}
+	next := int64(len(kept))
+	newOrder := make([]int64, 0, len(slides))
+	for _, slide := range slides {
+		if slide.ID != "" {
+			newOrder = append(newOrder, order[slide.ID])
+			continue
+		}
+		updates = append(updates, types.PresentationUpdate{
+			Operation:   "add_slide",
+			Slide_index: next,
+			New_slide:   slide.Slide,
+			Rationale:   "Added in the editor",
+		})
+		newOrder = append(newOrder, next)
+		next++
+	}
+
+	for i, index := range newOrder {
+		if index != int64(i) {
+			updates = append(updates, types.PresentationUpdate{
+				Operation: "reorder_slides",
+				New_order: newOrder,
+				Rationale: "Reordered in the editor",
+			})
+			break
+		}
+	}
+	return updates
+}
@@ -0,0 +1,20 @@
+package presentation
+
+import (
+	"fmt"
+	"html"
+)
+
+// The size reveal.js lays slides out at before scaling them to fit the
+// window, which sets the aspect ratio of embedded decks
+const (
+	DeckWidth  = 960
+	DeckHeight = 700
+)
+
+// EmbedSnippet returns an iframe that embeds the deck at url, filling the
+// width of its container while keeping the deck's aspect ratio
+func EmbedSnippet(url, title string) string {
+	return fmt.Sprintf(`<iframe src="%s" title="%s" style="width: 100%%; aspect-ratio: %d / %d; border: 0;" allow="fullscreen" allowfullscreen loading="lazy"></iframe>`,
+		html.EscapeString(url), html.EscapeString(title), DeckWidth, DeckHeight)
+}
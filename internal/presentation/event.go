@@ -0,0 +1,76 @@
+package presentation
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// EventDateFormat is the layout used for stored event dates
+const EventDateFormat = "2006-01-02"
+
+// UpcomingEntry is a presentation with a scheduled event
+type UpcomingEntry struct {
+	CatalogEntry
+	EventDate time.Time
+	DaysLeft  int
+}
+
+// GetEventDate parses the event date, reporting false when none is set or it is invalid
+func (m *Metadata) GetEventDate() (time.Time, bool) {
+	if m.EventDate == "" {
+		return time.Time{}, false
+	}
+	date, err := time.ParseInLocation(EventDateFormat, m.EventDate, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// GetUpcoming returns the entries with an event date no more than days away,
+// most urgent first. Delivered presentations are left out; ones whose event
+// has already passed are included with a negative DaysLeft.
+func GetUpcoming(entries []CatalogEntry, now time.Time, days int) []UpcomingEntry {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	var result []UpcomingEntry
+	for _, entry := range entries {
+		if entry.Data.Metadata.GetStatus() == StatusDelivered {
+			continue
+		}
+		date, ok := entry.Data.Metadata.GetEventDate()
+		if !ok {
+			continue
+		}
+		left := int(math.Round(date.Sub(today).Hours() / 24))
+		if left > days {
+			continue
+		}
+		result = append(result, UpcomingEntry{CatalogEntry: entry, EventDate: date, DaysLeft: left})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].EventDate.Before(result[j].EventDate)
+	})
+
+	return result
+}
+
+// SetEvent sets the event date and venue of the presentation at path
+func (w *Writer) SetEvent(path, eventDate, venue string) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Metadata.EventDate = eventDate
+	data.Metadata.Venue = venue
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,113 @@
+package presentation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// FactCheckAuthor is the author of the review comments pres factcheck adds
+const FactCheckAuthor = "pres factcheck"
+
+// ClaimVerdict is why a factual claim was flagged
+type ClaimVerdict string
+
+const (
+	// ClaimUnverifiable is a specific claim given without a source
+	ClaimUnverifiable ClaimVerdict = "unverifiable"
+	// ClaimLikelyWrong is a claim that is outdated, inconsistent, or false
+	ClaimLikelyWrong ClaimVerdict = "likely_wrong"
+)
+
+// GetClaimVerdicts returns the known claim verdicts
+func GetClaimVerdicts() []ClaimVerdict {
+	return []ClaimVerdict{
+		ClaimUnverifiable,
+		ClaimLikelyWrong,
+	}
+}
+
+// GetFactCheckSummary renders the presentation summary followed by every
+// slide, for checking the claims made on them
+func (data *PresentationData) GetFactCheckSummary() string {
+	var sb strings.Builder
+	sb.WriteString(data.GetSummary())
+	for i := range data.Slides {
+		sb.WriteString("\n\n")
+		sb.WriteString(strings.TrimRight(data.GetSlideSummary(i), "\n"))
+	}
+	return sb.String()
+}
+
+// CheckClaim reports why a flagged claim cannot be attached to a slide
+func (data *PresentationData) CheckClaim(claim types.FlaggedClaim) error {
+	if claim.Slide_index < 0 || claim.Slide_index >= int64(len(data.Slides)) {
+		return fmt.Errorf("slide %d does not exist (presentation has %d slides)", claim.Slide_index+1, len(data.Slides))
+	}
+	if !slices.Contains(GetClaimVerdicts(), ClaimVerdict(claim.Verdict)) {
+		return fmt.Errorf("unknown verdict %q (expected one of: unverifiable, likely_wrong)", claim.Verdict)
+	}
+	if strings.TrimSpace(claim.Claim) == "" {
+		return fmt.Errorf("claim on slide %d is empty", claim.Slide_index+1)
+	}
+	return nil
+}
+
+// FactCheckComment renders the review comment for a flagged claim
+func FactCheckComment(claim types.FlaggedClaim) string {
+	verdict := strings.ReplaceAll(claim.Verdict, "_", " ")
+	text := fmt.Sprintf("Fact check (%s): %q", verdict, strings.TrimSpace(claim.Claim))
+	if reason := strings.TrimSpace(claim.Reason); reason != "" {
+		text += " " + reason
+	}
+	return text
+}
+
+// AnnotateClaims adds a review comment for each flagged claim to the slide
+// making it, and returns the comments added. Claims that already have an
+// unresolved comment are skipped, so checking a deck again does not repeat
+// them.
+func (w *Writer) AnnotateClaims(path string, claims []types.FlaggedClaim) ([]SlideComment, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var added []SlideComment
+	for _, claim := range claims {
+		if err := data.CheckClaim(claim); err != nil {
+			return nil, err
+		}
+
+		index := int(claim.Slide_index)
+		text := FactCheckComment(claim)
+		if slices.ContainsFunc(data.Slides[index].Comments, func(c Comment) bool {
+			return !c.Resolved && c.Text == text
+		}) {
+			continue
+		}
+
+		comment := Comment{
+			ID:      newCommentID(),
+			Author:  FactCheckAuthor,
+			Text:    text,
+			Created: time.Now(),
+		}
+		data.Slides[index].Comments = append(data.Slides[index].Comments, comment)
+		added = append(added, SlideComment{SlideIndex: index, Comment: comment})
+	}
+
+	if len(added) == 0 {
+		return nil, nil
+	}
+
+	data.Metadata.Modified = time.Now()
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
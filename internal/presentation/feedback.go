@@ -0,0 +1,127 @@
+package presentation
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// MaxRating is the highest rating the audience can give a talk
+const MaxRating = 5
+
+// Feedback is one audience response collected at the end of a talk
+type Feedback struct {
+	Timestamp time.Time `json:"timestamp"`
+	Rating    int       `json:"rating"`
+	Comment   string    `json:"comment,omitempty"`
+}
+
+// FeedbackSummary aggregates the feedback on a talk
+type FeedbackSummary struct {
+	Responses int
+	Average   float64
+	// Ratings counts the responses per rating; Ratings[0] holds ones
+	Ratings  [MaxRating]int
+	Comments []Feedback
+	First    time.Time
+	Last     time.Time
+}
+
+// FeedbackPath returns the feedback log for the deck at path, e.g.
+// presentations/.pres/my-talk.feedback.log for presentations/my-talk.json
+func FeedbackPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return filepath.Join(filepath.Dir(path), AuditDir, name+".feedback.log")
+}
+
+// AppendFeedback records an audience response for the deck at path
+func AppendFeedback(path string, rating int, comment string) (*Feedback, error) {
+	if rating < 1 || rating > MaxRating {
+		return nil, fmt.Errorf("rating must be between 1 and %d", MaxRating)
+	}
+
+	logPath := FeedbackPath(path)
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create feedback directory: %w", err)
+	}
+
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feedback log: %w", err)
+	}
+	defer f.Close()
+
+	feedback := Feedback{
+		Timestamp: time.Now(),
+		Rating:    rating,
+		Comment:   strings.TrimSpace(comment),
+	}
+	if err := json.NewEncoder(f).Encode(feedback); err != nil {
+		return nil, fmt.Errorf("failed to write feedback: %w", err)
+	}
+
+	return &feedback, nil
+}
+
+// ReadFeedback returns the responses collected for the deck at path, oldest
+// first. A deck without a feedback log has no responses.
+func ReadFeedback(path string) ([]Feedback, error) {
+	f, err := os.Open(FeedbackPath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open feedback log: %w", err)
+	}
+	defer f.Close()
+
+	var responses []Feedback
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var feedback Feedback
+		if err := json.Unmarshal(scanner.Bytes(), &feedback); err != nil {
+			return nil, fmt.Errorf("invalid feedback on line %d: %w", line, err)
+		}
+		responses = append(responses, feedback)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read feedback log: %w", err)
+	}
+
+	return responses, nil
+}
+
+// SummarizeFeedback aggregates responses into a summary
+func SummarizeFeedback(responses []Feedback) FeedbackSummary {
+	var summary FeedbackSummary
+	total := 0
+	for _, feedback := range responses {
+		if feedback.Rating < 1 || feedback.Rating > MaxRating {
+			continue
+		}
+		summary.Responses++
+		summary.Ratings[feedback.Rating-1]++
+		total += feedback.Rating
+		if feedback.Comment != "" {
+			summary.Comments = append(summary.Comments, feedback)
+		}
+		if summary.First.IsZero() || feedback.Timestamp.Before(summary.First) {
+			summary.First = feedback.Timestamp
+		}
+		if feedback.Timestamp.After(summary.Last) {
+			summary.Last = feedback.Timestamp
+		}
+	}
+	if summary.Responses > 0 {
+		summary.Average = float64(total) / float64(summary.Responses)
+	}
+	return summary
+}
@@ -0,0 +1,91 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimingReport lists how long each slide takes to present and the total for
+// the main flow, as context for fitting the deck to a time slot
+func (data *PresentationData) TimingReport() string {
+	var sb strings.Builder
+	for i, slide := range data.Slides {
+		fmt.Fprintf(&sb, "Slide %d (%s): %s - ", i+1, slide.ID, slide.Title)
+		switch {
+		case slide.Hidden:
+			sb.WriteString("backup slide, not presented")
+		case slide.TimeBudgetSeconds > 0:
+			fmt.Fprintf(&sb, "%s (time budget)", FormatClock(slide.GetTimeBudget()))
+		default:
+			sb.WriteString(FormatClock(slide.EstimateTime()))
+		}
+		if slide.Locked {
+			sb.WriteString(", locked")
+		}
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "Total: %s\n", FormatClock(data.TotalTimeBudget()))
+	return sb.String()
+}
+
+// FitChangeKind is what fitting a deck to a time slot did to a slide
+type FitChangeKind string
+
+const (
+	FitCut       FitChangeKind = "cut"
+	FitCondensed FitChangeKind = "condensed"
+	FitAdded     FitChangeKind = "added"
+)
+
+// FitChange is how fitting a deck to a time slot changed one slide. Index is
+// the slide's position before the change, or after it for added slides.
+type FitChange struct {
+	Kind   FitChangeKind
+	Index  int
+	Title  string
+	Before time.Duration
+	After  time.Duration
+}
+
+// Saved returns the presenting time the change saved, which is negative for
+// added slides
+func (c FitChange) Saved() time.Duration {
+	return c.Before - c.After
+}
+
+// FitChanges compares a deck before and after it was fitted to a time slot,
+// matching slides by ID, and returns the slides that were cut, condensed, or
+// added. Times only count slides in the main flow.
+func FitChanges(before, after *PresentationData) []FitChange {
+	presented := func(slide Slide) time.Duration {
+		if slide.Hidden {
+			return 0
+		}
+		return slide.GetTimeBudget()
+	}
+
+	remaining := make(map[string]Slide, len(after.Slides))
+	for _, slide := range after.Slides {
+		remaining[slide.ID] = slide
+	}
+
+	var changes []FitChange
+	original := make(map[string]bool, len(before.Slides))
+	for i, slide := range before.Slides {
+		original[slide.ID] = true
+		fitted, ok := remaining[slide.ID]
+		switch {
+		case !ok:
+			changes = append(changes, FitChange{Kind: FitCut, Index: i, Title: slide.Title, Before: presented(slide)})
+		case fitted.Slide != slide.Slide:
+			changes = append(changes, FitChange{Kind: FitCondensed, Index: i, Title: fitted.Title, Before: presented(slide), After: presented(fitted)})
+		}
+	}
+	for i, slide := range after.Slides {
+		if !original[slide.ID] {
+			changes = append(changes, FitChange{Kind: FitAdded, Index: i, Title: slide.Title, After: presented(slide)})
+		}
+	}
+	return changes
+}
@@ -0,0 +1,99 @@
+package presentation
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// footnoteDefPattern matches a footnote definition line: [^key]: text
+	footnoteDefPattern = regexp.MustCompile(`(?m)^[ \t]*\[\^([^\]\s]+)\]:[ \t]*(.+?)[ \t]*$\n?`)
+
+	// footnoteRefPattern matches a footnote marker: [^key]
+	footnoteRefPattern = regexp.MustCompile(`\[\^([^\]\s]+)\]`)
+)
+
+// superscriptDigits are used for footnote markers so they render in any output
+var superscriptDigits = strings.NewReplacer(
+	"0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴",
+	"5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹",
+)
+
+// Footnote is a numbered note shown at the foot of a slide
+type Footnote struct {
+	Number int
+	Text   string
+}
+
+// CollectFootnotes returns a copy of the presentation in which footnote
+// definitions ([^key]: text) are moved out of slide content into each slide's
+// Footnotes, and markers ([^key]) become superscript numbers. Footnotes are
+// numbered across the deck, with identical citations sharing a number, and
+// listed on a References slide at the end of the main flow. Markers without a
+// definition on the same slide are reported as warnings.
+func (data *PresentationData) CollectFootnotes() (*PresentationData, []string) {
+	if !strings.Contains(joinContent(data), "[^") {
+		return data, nil
+	}
+
+	collected := data.Clone()
+	numbers := map[string]int{}
+	var references []string
+	var warnings []string
+
+	for i := range collected.Slides {
+		slide := &collected.Slides[i]
+
+		// Number this slide's definitions, reusing numbers for repeated citations
+		keys := map[string]int{}
+		for _, m := range footnoteDefPattern.FindAllStringSubmatch(slide.Content, -1) {
+			number, ok := numbers[m[2]]
+			if !ok {
+				references = append(references, m[2])
+				number = len(references)
+				numbers[m[2]] = number
+			}
+			keys[m[1]] = number
+			slide.Footnotes = append(slide.Footnotes, Footnote{Number: number, Text: m[2]})
+		}
+		slide.Content = strings.TrimRight(footnoteDefPattern.ReplaceAllString(slide.Content, ""), "\n")
+
+		slide.Content = footnoteRefPattern.ReplaceAllStringFunc(slide.Content, func(match string) string {
+			key := footnoteRefPattern.FindStringSubmatch(match)[1]
+			number, ok := keys[key]
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("slide %d: footnote [^%s] has no definition", i+1, key))
+				return match
+			}
+			return superscriptDigits.Replace(strconv.Itoa(number))
+		})
+	}
+
+	if len(references) > 0 {
+		var sb strings.Builder
+		for i, text := range references {
+			fmt.Fprintf(&sb, "%d. %s\n", i+1, text)
+		}
+		references := Slide{}
+		references.Title = "References"
+		references.Layout = "content"
+		references.Content = strings.TrimRight(sb.String(), "\n")
+		end := collected.mainFlowEnd()
+		collected.Slides = slices.Insert(collected.Slides, end, references)
+	}
+
+	return collected, warnings
+}
+
+// joinContent returns the content of every slide, for quick checks
+func joinContent(data *PresentationData) string {
+	var sb strings.Builder
+	for _, slide := range data.Slides {
+		sb.WriteString(slide.Content)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -1,18 +1,55 @@
 package presentation
 
 import (
+	"bufio"
+	"crypto/sha256"
 	"fmt"
 	"html/template"
+	"io"
+	"iter"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
-
-	"github.com/geoffjay/pres/baml_client/types"
 )
 
 // Generator handles generating HTML output from presentations
 type Generator struct {
-	templatePath string
+	// document is the template of the HTML document; see LoadTemplate
+	document *template.Template
+
+	// Webcam adds a self-view webcam bubble to the generated deck, for
+	// recording walkthrough videos
+	Webcam bool
+
+	// Offline writes a web app manifest and service worker next to the
+	// generated deck, so it can be installed and viewed without a network
+	Offline bool
+
+	// CSP adds a Content-Security-Policy to the generated deck, for hosting
+	// that requires one. Resources must be local or from the reveal.js CDN
+	// or an origin in Allowlist.
+	CSP       bool
+	Allowlist []string
+
+	// IncludeTags and ExcludeTags select the slides to output by tag; see
+	// PresentationData.FilterByTags
+	IncludeTags []string
+	ExcludeTags []string
+
+	// Width and Height are the size slides are laid out at before reveal.js
+	// scales them to the window; zero keeps reveal.js's default. See
+	// AspectSize.
+	Width  int
+	Height int
+
+	// Footer is text shown at the bottom of every slide, such as a
+	// confidentiality notice
+	Footer string
+
+	// OmitNotes leaves the speaker notes out of the generated deck, for
+	// copies the audience sees
+	OmitNotes bool
 }
 
 // NewGenerator creates a new HTML generator
@@ -20,7 +57,9 @@ func NewGenerator() *Generator {
 	return &Generator{}
 }
 
-// GenerateHTML generates a reveal.js HTML file from presentation data
+// GenerateHTML generates a reveal.js HTML file from presentation data. The
+// page is streamed to the file a slide at a time, so a large deck is never
+// held in memory whole, and the file is only replaced once it is complete.
 func (g *Generator) GenerateHTML(data *PresentationData, outputPath string) error {
 	// Create output directory if it doesn't exist
 	dir := filepath.Dir(outputPath)
@@ -28,37 +67,209 @@ func (g *Generator) GenerateHTML(data *PresentationData, outputPath string) erro
 		return fmt.Errorf("failed to create output directory: %w", err)
 	}
 
+	if g.CSP {
+		if problems := g.CheckResources(data); len(problems) > 0 {
+			return fmt.Errorf("presentation does not meet the content security policy:\n  %s", strings.Join(problems, "\n  "))
+		}
+	}
+
 	// Generate HTML content
-	html := g.buildHTML(data)
+	name := ""
+	if g.Offline {
+		name = strings.TrimSuffix(filepath.Base(outputPath), filepath.Ext(outputPath))
+	}
+	file, err := os.CreateTemp(dir, ".pres-*.html")
+	if err != nil {
+		return fmt.Errorf("failed to create HTML file: %w", err)
+	}
+	defer os.Remove(file.Name())
 
-	// Write to file
-	if err := os.WriteFile(outputPath, []byte(html), 0644); err != nil {
+	// The offline cache is named after a hash of the page
+	digest := sha256.New()
+	out := bufio.NewWriter(io.MultiWriter(file, digest))
+	err = g.writeHTML(out, data, name)
+	if err == nil {
+		err = out.Flush()
+	}
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write HTML file: %w", closeErr)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Keep the permissions of the file being replaced
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(outputPath); err == nil {
+		mode = info.Mode().Perm()
+	}
+	if err := os.Chmod(file.Name(), mode); err != nil {
 		return fmt.Errorf("failed to write HTML file: %w", err)
 	}
+	if err := os.Rename(file.Name(), outputPath); err != nil {
+		return fmt.Errorf("failed to write HTML file: %w", err)
+	}
+
+	if g.Offline {
+		return writeOfflineFiles(data, outputPath, digest.Sum(nil))
+	}
 
 	return nil
 }
 
-// buildHTML constructs the complete HTML document
-func (g *Generator) buildHTML(data *PresentationData) string {
+// Prepare readies presentation data for output: slide references are
+// resolved, variables expanded, slides whose when condition is false or that
+// don't match the tag filters dropped, hidden slides moved to an appendix, figures and tables numbered, links between
+// slides pointed at their anchors, and footnotes collected into a references
+// slide. sourcePath is
+// the file data was loaded from. Problems that do not prevent output, such as
+// unknown cross-references, are returned as warnings.
+func (g *Generator) Prepare(data *PresentationData, sourcePath string) (*PresentationData, []string, error) {
+	data, err := g.ResolveReferences(data, sourcePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve slide references: %w", err)
+	}
+
+	data, err = data.ExpandVariables().FilterSlides()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data = data.FilterByTags(g.IncludeTags, g.ExcludeTags).MoveHiddenSlides()
+	data, warnings := data.NumberFigures()
+	data, linkWarnings := data.ResolveSlideLinks()
+	data, footnoteWarnings := data.CollectFootnotes()
+
+	warnings = append(warnings, linkWarnings...)
+	warnings = append(warnings, footnoteWarnings...)
+	return data, warnings, nil
+}
+
+// RenderHTML returns the reveal.js HTML for presentation data without
+// writing it to disk
+func (g *Generator) RenderHTML(data *PresentationData) (string, error) {
 	var sb strings.Builder
+	if err := g.writeHTML(&sb, data, ""); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// writeHTML writes the complete HTML document, built from the document
+// template, to w. The template is rendered with a marker in place of the
+// slides, which are then rendered one at a time and written where it placed
+// them. An offline deck is given the name its manifest and service worker are
+// written under.
+func (g *Generator) writeHTML(w io.Writer, data *PresentationData, offlineName string) error {
+	doc := Document{
+		Title:    data.Metadata.Title,
+		Subtitle: data.Metadata.Subtitle,
+		Author:   data.Metadata.Author,
+		Date:     data.Metadata.Date,
+		Theme:    data.Metadata.Theme,
+		Custom:   data.Metadata.Custom,
+	}
 
-	// HTML header
-	sb.WriteString(`<!DOCTYPE html>
-<html lang="en">
-<head>
-    <meta charset="utf-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>`)
-	sb.WriteString(template.HTMLEscapeString(data.Metadata.Title))
-	sb.WriteString(`</title>
-    <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reset.css">
+	var head strings.Builder
+	head.WriteString(`    <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reset.css">
     <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reveal.css">
     <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/theme/`)
-	sb.WriteString(data.Metadata.Theme)
-	sb.WriteString(`.css">
+	head.WriteString(data.Metadata.Theme)
+	head.WriteString(`.css">
     <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/highlight/monokai.css">
-    <style>
+`)
+	if offlineName != "" {
+		head.WriteString(offlineHead(offlineName))
+	}
+	head.WriteString(deckStyle)
+	doc.Head = template.HTML(head.String())
+
+	doc.Slides = slidesMarker
+
+	var scripts strings.Builder
+	scripts.WriteString(`    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reveal.js"></script>
+    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/notes/notes.js"></script>
+    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/markdown/markdown.js"></script>
+    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/highlight/highlight.js"></script>
+    <script>
+        Reveal.initialize({
+            hash: true,
+            slideNumber: true,
+`)
+	if g.Width > 0 && g.Height > 0 {
+		fmt.Fprintf(&scripts, "            width: %d,\n            height: %d,\n", g.Width, g.Height)
+	}
+	scripts.WriteString(`            plugins: [ RevealMarkdown, RevealHighlight, RevealNotes ]
+        });
+    </script>
+`)
+	if g.Footer != "" {
+		scripts.WriteString(footerStyle)
+		fmt.Fprintf(&scripts, "    <div class=\"deck-footer\">%s</div>\n", template.HTMLEscapeString(g.Footer))
+	}
+	if g.Webcam {
+		scripts.WriteString(webcamScript)
+	}
+	if hasNarration(data) {
+		scripts.WriteString(narrationScript)
+	}
+	if offlineName != "" {
+		scripts.WriteString(offlineScript(offlineName))
+	}
+	doc.Scripts = template.HTML(scripts.String())
+
+	if g.CSP {
+		page, err := g.executeDocument(doc)
+		if err != nil {
+			return err
+		}
+		policy := g.contentSecurityPolicy(func(yield func(string) bool) {
+			if yield(page) {
+				g.slideHTML(data)(yield)
+			}
+		})
+
+		// The policy covers the template's own inline scripts and styles
+		// too. It must come before them, so it starts the head.
+		meta := fmt.Sprintf(`    <meta http-equiv="Content-Security-Policy" content="%s">
+`, template.HTMLEscapeString(policy))
+		doc.Head = template.HTML(meta) + doc.Head
+	}
+
+	page, err := g.executeDocument(doc)
+	if err != nil {
+		return err
+	}
+	for i, part := range strings.Split(page, slidesMarker) {
+		if i > 0 {
+			for html := range g.slideHTML(data) {
+				if _, err := io.WriteString(w, html); err != nil {
+					return fmt.Errorf("failed to write HTML file: %w", err)
+				}
+			}
+		}
+		if _, err := io.WriteString(w, part); err != nil {
+			return fmt.Errorf("failed to write HTML file: %w", err)
+		}
+	}
+	return nil
+}
+
+// slideHTML renders the slide sections of data one at a time
+func (g *Generator) slideHTML(data *PresentationData) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, slide := range data.Slides {
+			var sb strings.Builder
+			g.writeSlide(&sb, slide)
+			if !yield(sb.String()) {
+				return
+			}
+		}
+	}
+}
+
+// deckStyle lays out the slides and their printouts
+const deckStyle = `    <style>
         .reveal .slides section {
             text-align: left;
         }
@@ -70,48 +281,330 @@ func (g *Generator) buildHTML(data *PresentationData) string {
             grid-template-columns: 1fr 1fr;
             gap: 2rem;
         }
+        .reveal h2.assertion {
+            font-size: 1.2em;
+            line-height: 1.3;
+        }
+        .reveal .evidence {
+            text-align: center;
+        }
+        .reveal .evidence img {
+            max-height: 60vh;
+        }
+        .reveal .slide-image {
+            display: block;
+            max-height: 45vh;
+            margin: 0.5em auto 0;
+        }
+        .reveal .footnotes {
+            position: absolute;
+            bottom: 0;
+            left: 0;
+            right: 0;
+            font-size: 0.4em;
+            line-height: 1.4;
+            opacity: 0.8;
+        }
+        .reveal .footnotes p {
+            margin: 0;
+        }
+        /* Browser printing lays the slides out one per page; reveal.js's own
+           ?print-pdf view is left untouched */
+        @page {
+            size: landscape;
+            margin: 1.5cm;
+        }
+        @media print {
+            html:not(.print-pdf), html:not(.print-pdf) body {
+                height: auto !important;
+                overflow: visible !important;
+                background: #fff !important;
+            }
+            html:not(.print-pdf) .reveal {
+                --r-background-color: #fff;
+                --r-main-color: #222;
+                --r-heading-color: #222;
+                --r-link-color: #2a76dd;
+                height: auto !important;
+                overflow: visible !important;
+                font-size: 24px;
+            }
+            html:not(.print-pdf) .reveal .slides {
+                position: static !important;
+                width: auto !important;
+                height: auto !important;
+                margin: 0 !important;
+                transform: none !important;
+                zoom: 1 !important;
+                overflow: visible !important;
+            }
+            html:not(.print-pdf) .reveal .slides section {
+                display: block !important;
+                position: relative !important;
+                top: auto !important;
+                left: auto !important;
+                width: auto !important;
+                height: auto !important;
+                min-height: 0 !important;
+                padding: 0 !important;
+                opacity: 1 !important;
+                visibility: visible !important;
+                transform: none !important;
+                break-inside: avoid;
+                page-break-inside: avoid;
+            }
+            html:not(.print-pdf) .reveal .slides section + section {
+                break-before: page;
+                page-break-before: always;
+            }
+            html:not(.print-pdf) .reveal .slides section.print-continue {
+                break-before: auto;
+                page-break-before: auto;
+                margin-top: 2em;
+            }
+            html:not(.print-pdf) .reveal .slides section.print-skip {
+                display: none !important;
+            }
+            html:not(.print-pdf) .reveal .fragment {
+                opacity: 1 !important;
+                visibility: visible !important;
+                transform: none !important;
+            }
+            html:not(.print-pdf) .reveal .footnotes {
+                position: static;
+                margin-top: 1.5em;
+            }
+            html:not(.print-pdf) .reveal .controls,
+            html:not(.print-pdf) .reveal .progress,
+            html:not(.print-pdf) .reveal .slide-number,
+            html:not(.print-pdf) .reveal .backgrounds,
+            html:not(.print-pdf) .reveal aside.notes {
+                display: none !important;
+            }
+        }
     </style>
-</head>
-<body>
-    <div class="reveal">
-        <div class="slides">
-`)
+`
 
-	// Generate slides
+// hasNarration reports whether any slide has recorded narration
+func hasNarration(data *PresentationData) bool {
 	for _, slide := range data.Slides {
-		g.writeSlide(&sb, slide)
+		if slide.Audio != "" {
+			return true
+		}
 	}
+	return false
+}
 
-	// HTML footer
-	sb.WriteString(`        </div>
-    </div>
-    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reveal.js"></script>
-    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/notes/notes.js"></script>
-    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/markdown/markdown.js"></script>
-    <script src="https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/highlight/highlight.js"></script>
-    <script>
-        Reveal.initialize({
-            hash: true,
-            slideNumber: true,
-            plugins: [ RevealMarkdown, RevealHighlight, RevealNotes ]
-        });
+// narrationScript plays each slide's recorded narration and advances when it
+// ends; slides without narration advance after their time budget. Narration
+// starts with N, or on load when the URL contains ?narrate.
+const narrationScript = `    <script>
+        (function () {
+            if (/receiver/.test(location.search)) return;
+
+            var narrating = /narrate/.test(location.search);
+            var audio = new Audio();
+            var timer = null;
+
+            function play() {
+                audio.pause();
+                clearTimeout(timer);
+                if (!narrating) return;
+
+                var slide = Reveal.getCurrentSlide();
+                var src = slide.getAttribute('data-audio');
+                if (!src) {
+                    var seconds = Number(slide.getAttribute('data-timing'));
+                    if (seconds > 0) timer = setTimeout(function () { Reveal.next(); }, seconds * 1000);
+                    return;
+                }
+                audio.src = src;
+                audio.play().catch(function (err) {
+                    console.warn('Narration blocked until the page is interacted with:', err);
+                });
+            }
+
+            audio.addEventListener('ended', function () {
+                if (narrating) Reveal.next();
+            });
+            Reveal.on('ready', play);
+            Reveal.on('slidechanged', play);
+            Reveal.addKeyBinding({ keyCode: 78, key: 'N', description: 'Toggle narration' }, function () {
+                narrating = !narrating;
+                if (narrating) {
+                    play();
+                } else {
+                    audio.pause();
+                    clearTimeout(timer);
+                }
+            });
+        })();
     </script>
-</body>
-</html>
-`)
+`
+
+// footerStyle places the deck footer along the bottom of the window, clear
+// of the slide number; it is left out of printouts
+const footerStyle = `    <style>
+        .deck-footer {
+            position: fixed;
+            left: 24px;
+            right: 120px;
+            bottom: 12px;
+            z-index: 20;
+            color: var(--r-main-color);
+            font-family: var(--r-main-font);
+            font-size: 14px;
+            opacity: 0.7;
+            pointer-events: none;
+            white-space: nowrap;
+            overflow: hidden;
+            text-overflow: ellipsis;
+        }
+        @media print {
+            .deck-footer {
+                display: none;
+            }
+        }
+    </style>
+`
 
-	return sb.String()
+// AspectSize returns the slide size for an aspect ratio such as "16:9",
+// 720 pixels high like reveal.js's default
+func AspectSize(aspect string) (width, height int, err error) {
+	w, h, ok := strings.Cut(aspect, ":")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid aspect ratio %q, expected width:height such as 16:9", aspect)
+	}
+	ratioW, errW := strconv.Atoi(strings.TrimSpace(w))
+	ratioH, errH := strconv.Atoi(strings.TrimSpace(h))
+	if errW != nil || errH != nil || ratioW <= 0 || ratioH <= 0 {
+		return 0, 0, fmt.Errorf("invalid aspect ratio %q, expected width:height such as 16:9", aspect)
+	}
+	return 720 * ratioW / ratioH, 720, nil
 }
 
+// webcamScript shows the presenter's webcam in a round bubble in the corner
+// of the deck. The bubble can be dragged, double-clicked to change size, and
+// toggled with C; it is left out of the speaker view and printouts.
+const webcamScript = `    <style>
+        .webcam-bubble {
+            position: fixed;
+            right: 24px;
+            bottom: 24px;
+            width: 180px;
+            height: 180px;
+            border-radius: 50%;
+            overflow: hidden;
+            z-index: 30;
+            cursor: move;
+            box-shadow: 0 4px 16px rgba(0, 0, 0, 0.4);
+            touch-action: none;
+        }
+        .webcam-bubble.large {
+            width: 280px;
+            height: 280px;
+        }
+        .webcam-bubble video {
+            width: 100%;
+            height: 100%;
+            object-fit: cover;
+            transform: scaleX(-1);
+        }
+        @media print {
+            .webcam-bubble {
+                display: none;
+            }
+        }
+    </style>
+    <script>
+        (function () {
+            if (/receiver/.test(location.search) || !navigator.mediaDevices) return;
+
+            var bubble = document.createElement('div');
+            bubble.className = 'webcam-bubble';
+            var video = document.createElement('video');
+            video.autoplay = true;
+            video.muted = true;
+            video.playsInline = true;
+            bubble.appendChild(video);
+
+            navigator.mediaDevices.getUserMedia({ video: true, audio: false }).then(function (stream) {
+                video.srcObject = stream;
+                document.body.appendChild(bubble);
+            }).catch(function (err) {
+                console.warn('Webcam unavailable:', err);
+            });
+
+            bubble.addEventListener('pointerdown', function (e) {
+                var rect = bubble.getBoundingClientRect();
+                var dx = e.clientX - rect.left, dy = e.clientY - rect.top;
+                bubble.setPointerCapture(e.pointerId);
+                function move(e) {
+                    bubble.style.left = (e.clientX - dx) + 'px';
+                    bubble.style.top = (e.clientY - dy) + 'px';
+                    bubble.style.right = 'auto';
+                    bubble.style.bottom = 'auto';
+                }
+                function up() {
+                    bubble.removeEventListener('pointermove', move);
+                    bubble.removeEventListener('pointerup', up);
+                }
+                bubble.addEventListener('pointermove', move);
+                bubble.addEventListener('pointerup', up);
+            });
+            bubble.addEventListener('dblclick', function () {
+                bubble.classList.toggle('large');
+            });
+
+            Reveal.addKeyBinding({ keyCode: 67, key: 'C', description: 'Toggle webcam' }, function () {
+                bubble.style.display = bubble.style.display === 'none' ? '' : 'none';
+            });
+        })();
+    </script>
+`
+
 // writeSlide writes a single slide to the HTML
-func (g *Generator) writeSlide(sb *strings.Builder, slide types.Slide) {
-	// Start section with optional background color
+func (g *Generator) writeSlide(sb *strings.Builder, slide Slide) {
+	// Start section with an anchor for links and optional background color
 	sb.WriteString("            <section")
+	if anchor := SlideAnchor(slide); anchor != "" {
+		sb.WriteString(` id="`)
+		sb.WriteString(template.HTMLEscapeString(anchor))
+		sb.WriteString(`"`)
+	}
+	if slide.Hidden {
+		// Backup slides don't count towards the slide number or progress
+		sb.WriteString(` data-visibility="uncounted"`)
+	} else {
+		// Pacing in the speaker view compares elapsed time to these budgets
+		fmt.Fprintf(sb, ` data-timing="%d"`, int(slide.GetTimeBudget().Seconds()))
+	}
+	switch slide.GetPageBreak() {
+	case PageBreakContinue:
+		sb.WriteString(` class="print-continue"`)
+	case PageBreakSkip:
+		sb.WriteString(` class="print-skip"`)
+	}
+	if slide.Audio != "" {
+		sb.WriteString(` data-audio="`)
+		sb.WriteString(template.HTMLEscapeString(slide.Audio))
+		sb.WriteString(`"`)
+	}
 	if slide.Background_color != "" {
 		sb.WriteString(` data-background-color="`)
 		sb.WriteString(template.HTMLEscapeString(slide.Background_color))
 		sb.WriteString(`"`)
 	}
+	if slide.Image != "" && (slide.Layout == "title" || slide.Layout == "blank") {
+		// Title and blank slides show their image full-bleed, dimmed on
+		// title slides so the title stays readable
+		sb.WriteString(` data-background-image="`)
+		sb.WriteString(template.HTMLEscapeString(slide.Image))
+		sb.WriteString(`"`)
+		if slide.Layout == "title" {
+			sb.WriteString(` data-background-opacity="0.35"`)
+		}
+	}
 	sb.WriteString(">\n")
 
 	// Add slide title if present
@@ -124,6 +617,10 @@ func (g *Generator) writeSlide(sb *strings.Builder, slide types.Slide) {
 
 		sb.WriteString("                <")
 		sb.WriteString(headingLevel)
+		if slide.Layout == "assertion-evidence" {
+			// The headline is a full sentence, set smaller than a topic title
+			sb.WriteString(` class="assertion"`)
+		}
 		sb.WriteString(">")
 		sb.WriteString(template.HTMLEscapeString(slide.Title))
 		sb.WriteString("</")
@@ -135,6 +632,14 @@ func (g *Generator) writeSlide(sb *strings.Builder, slide types.Slide) {
 	switch slide.Layout {
 	case "two-column":
 		g.writeTwoColumnContent(sb, slide.Content)
+	case "assertion-evidence":
+		if slide.Content != "" {
+			sb.WriteString("                <div class=\"evidence\" data-markdown>\n")
+			sb.WriteString("                    <textarea data-template>\n")
+			sb.WriteString(slide.Content)
+			sb.WriteString("\n                    </textarea>\n")
+			sb.WriteString("                </div>\n")
+		}
 	default:
 		// Standard content or blank slide
 		if slide.Content != "" {
@@ -146,8 +651,26 @@ func (g *Generator) writeSlide(sb *strings.Builder, slide types.Slide) {
 		}
 	}
 
+	// Other layouts show their image below the content
+	if slide.Image != "" && slide.Layout != "title" && slide.Layout != "blank" {
+		sb.WriteString(`                <img class="slide-image" src="`)
+		sb.WriteString(template.HTMLEscapeString(slide.Image))
+		sb.WriteString(`" alt="`)
+		sb.WriteString(template.HTMLEscapeString(slide.Image_prompt))
+		sb.WriteString("\">\n")
+	}
+
+	// Add footnotes as small print at the foot of the slide
+	if len(slide.Footnotes) > 0 {
+		sb.WriteString("                <footer class=\"footnotes\">\n")
+		for _, note := range slide.Footnotes {
+			fmt.Fprintf(sb, "                    <p>%d. %s</p>\n", note.Number, template.HTMLEscapeString(note.Text))
+		}
+		sb.WriteString("                </footer>\n")
+	}
+
 	// Add speaker notes if present
-	if slide.Notes != "" {
+	if slide.Notes != "" && !g.OmitNotes {
 		sb.WriteString("                <aside class=\"notes\">\n")
 		sb.WriteString("                    ")
 		sb.WriteString(template.HTMLEscapeString(slide.Notes))
@@ -160,18 +683,9 @@ func (g *Generator) writeSlide(sb *strings.Builder, slide types.Slide) {
 
 // writeTwoColumnContent writes content in a two-column layout
 func (g *Generator) writeTwoColumnContent(sb *strings.Builder, content string) {
-	// Split content by a delimiter (e.g., "---" or "|||")
-	columns := strings.Split(content, "|||")
-	if len(columns) < 2 {
-		columns = strings.Split(content, "---")
-	}
-
 	sb.WriteString("                <div class=\"two-column\">\n")
 
-	for i, col := range columns {
-		if i >= 2 {
-			break // Only support two columns
-		}
+	for _, col := range SplitColumns(content) {
 		sb.WriteString("                    <div data-markdown>\n")
 		sb.WriteString("                        <textarea data-template>\n")
 		sb.WriteString(strings.TrimSpace(col))
@@ -182,6 +696,19 @@ func (g *Generator) writeTwoColumnContent(sb *strings.Builder, content string) {
 	sb.WriteString("                </div>\n")
 }
 
+// SplitColumns splits two-column content on its delimiter ("|||", or "---"
+// as a fallback), returning at most two columns
+func SplitColumns(content string) []string {
+	columns := strings.Split(content, "|||")
+	if len(columns) < 2 {
+		columns = strings.Split(content, "---")
+	}
+	if len(columns) > 2 {
+		columns = columns[:2] // Only support two columns
+	}
+	return columns
+}
+
 // GetRevealJSThemes returns the list of available reveal.js themes
 func GetRevealJSThemes() []string {
 	return []string{
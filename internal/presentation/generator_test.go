@@ -0,0 +1,34 @@
+package presentation
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// BenchmarkGenerateHTML measures generating a large deck, which is streamed
+// to the output file a slide at a time
+func BenchmarkGenerateHTML(b *testing.B) {
+	pres := types.Presentation{Title: "Benchmark", Theme: "black"}
+	for i := range 600 {
+		pres.Slides = append(pres.Slides, types.Slide{
+			Title:   fmt.Sprintf("Slide %d", i+1),
+			Content: strings.Repeat("- A point worth making about the topic at hand\n", 6),
+			Notes:   strings.Repeat("Speaker notes for the slide. ", 20),
+			Layout:  "content",
+		})
+	}
+	data := NewPresentationData(&pres)
+	output := filepath.Join(b.TempDir(), "deck.html")
+	generator := NewGenerator()
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := generator.GenerateHTML(data, output); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// SetGitCommit makes the writer commit each deck it creates or updates to
+// the git repository the deck is in. Only the deck file is committed; other
+// staged changes are left as they are.
+func (w *Writer) SetGitCommit(commit bool) {
+	w.gitCommit = commit
+}
+
+// commitDeck commits the deck file at path with message, when the writer
+// commits saves. A deck saved unchanged makes no commit.
+func (w *Writer) commitDeck(path, message string) error {
+	if !w.gitCommit {
+		return nil
+	}
+	if err := gitCommitFile(path, w.commitMessage(message)); err != nil {
+		return fmt.Errorf("saved %s but failed to commit it: %w", path, err)
+	}
+	return nil
+}
+
+// commitMessage adds the command and person that made a save to its commit
+// message
+func (w *Writer) commitMessage(message string) string {
+	if w.auditSource == "" {
+		return message
+	}
+	trailer := "Saved by " + w.auditSource
+	if w.auditActor != "" {
+		trailer += " (" + w.auditActor + ")"
+	}
+	return message + "\n\n" + trailer
+}
+
+// gitCommitFile stages the file at path and commits it, and only it, to the
+// git repository it is in
+func gitCommitFile(path, message string) error {
+	dir, name := filepath.Dir(path), filepath.Base(path)
+	if out, err := git(dir, "rev-parse", "--is-inside-work-tree"); err != nil || out != "true" {
+		return fmt.Errorf("%s is not in a git repository", dir)
+	}
+	if _, err := git(dir, "add", "--", name); err != nil {
+		return err
+	}
+	// Nothing to commit when the file is as the last commit has it
+	if _, err := git(dir, "diff", "--cached", "--quiet", "--", name); err == nil {
+		return nil
+	}
+	_, err := git(dir, "commit", "--quiet", "--message", message, "--", name)
+	return err
+}
+
+// git runs a git command in dir and returns its trimmed output
+func git(dir string, args ...string) (string, error) {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	output := strings.TrimSpace(string(out))
+	if err != nil {
+		var exit *exec.ExitError
+		if errors.As(err, &exit) && output != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], output)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return output, nil
+}
+
+// createCommitMessage describes a new deck for its commit
+func createCommitMessage(path string, data *PresentationData) string {
+	return fmt.Sprintf("Add %s: %s (%s)", filepath.Base(path), data.Metadata.Title, countNoun(len(data.Slides), "slide"))
+}
+
+// updateCommitMessage summarizes the applied operations of an update for
+// its commit: a subject counting them by kind, and a line for each with
+// its rationale
+func updateCommitMessage(path string, updates []types.PresentationUpdate, results []OperationResult) string {
+	counts := map[string]int{}
+	var lines []string
+	for _, result := range results {
+		if !result.Applied {
+			continue
+		}
+		update := updates[result.Index]
+		counts[update.Operation]++
+
+		line := "- " + describeOperation(update)
+		if update.Rationale != "" {
+			line += ": " + update.Rationale
+		}
+		lines = append(lines, line)
+	}
+
+	var parts []string
+	if n := counts["add_slide"]; n > 0 {
+		parts = append(parts, "add "+countNoun(n, "slide"))
+	}
+	if n := counts["modify_slide"]; n > 0 {
+		parts = append(parts, "modify "+countNoun(n, "slide"))
+	}
+	if n := counts["delete_slide"]; n > 0 {
+		parts = append(parts, "delete "+countNoun(n, "slide"))
+	}
+	if counts["reorder_slides"] > 0 {
+		parts = append(parts, "reorder slides")
+	}
+	if counts["update_metadata"] > 0 {
+		parts = append(parts, "update metadata")
+	}
+
+	subject := fmt.Sprintf("Update %s: %s", filepath.Base(path), strings.Join(parts, ", "))
+	return subject + "\n\n" + strings.Join(lines, "\n")
+}
+
+// describeOperation names what an update operation does, e.g. add slide
+// "Agenda". Slides are named by ID when the operation addresses them by ID,
+// since its slide index is then unset.
+func describeOperation(update types.PresentationUpdate) string {
+	slide := fmt.Sprint(update.Slide_index + 1)
+	if update.Slide_id != "" {
+		slide = update.Slide_id
+	}
+	switch update.Operation {
+	case "add_slide":
+		return fmt.Sprintf("add slide %q", update.New_slide.Title)
+	case "modify_slide":
+		return fmt.Sprintf("modify slide %s %q", slide, update.New_slide.Title)
+	case "delete_slide":
+		return "delete slide " + slide
+	case "update_metadata":
+		return "update " + strings.Join(slices.Sorted(maps.Keys(update.Metadata_updates)), ", ")
+	}
+	return strings.ReplaceAll(update.Operation, "_", " ")
+}
@@ -0,0 +1,307 @@
+package presentation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Names of the best-practice rules a deck is graded on
+const (
+	// RuleSixBySix limits slides to six bullets of about six words each
+	RuleSixBySix = "6x6"
+	// RuleOneIdea asks for one idea per slide, judged by the amount of text
+	// and the number of subheadings
+	RuleOneIdea = "one-idea"
+	// RuleAssertionTitle asks for titles that state the slide's takeaway as
+	// a sentence rather than name its topic
+	RuleAssertionTitle = "assertion-title"
+)
+
+// GetGradeRules returns the names of the rules a deck is graded on
+func GetGradeRules() []string {
+	return []string{
+		RuleSixBySix,
+		RuleOneIdea,
+		RuleAssertionTitle,
+	}
+}
+
+var (
+	// gradeBullet matches a list item
+	gradeBullet = regexp.MustCompile(`^\s*([-*+]|\d+[.)])\s+`)
+
+	// gradeHeading matches a heading within slide content
+	gradeHeading = regexp.MustCompile(`^\s*#{1,6}\s+`)
+
+	// gradeMarkup matches markdown that is not words: emphasis, code
+	// markers, and the targets of links and images
+	gradeMarkup = regexp.MustCompile("[*_`]|!?\\[([^\\]]*)\\]\\([^)]*\\)")
+)
+
+// GradeRules are the thresholds a deck is graded against. Zero values take
+// the defaults.
+type GradeRules struct {
+	// MaxBullets is the most bullets a slide should have
+	MaxBullets int `json:"max_bullets,omitempty"`
+	// MaxBulletWords is the most words a bullet should have
+	MaxBulletWords int `json:"max_bullet_words,omitempty"`
+	// MaxWords is the most words a slide should have, beyond which it
+	// likely covers more than one idea
+	MaxWords int `json:"max_words,omitempty"`
+	// MinTitleWords is the fewest words a title needs to read as an
+	// assertion rather than a topic
+	MinTitleWords int `json:"min_title_words,omitempty"`
+	// Skip lists rules not to grade on
+	Skip []string `json:"skip,omitempty"`
+}
+
+// DefaultGradeRules returns the thresholds of the common rules of thumb
+func DefaultGradeRules() GradeRules {
+	return GradeRules{
+		MaxBullets:     6,
+		MaxBulletWords: 6,
+		MaxWords:       40,
+		MinTitleWords:  4,
+	}
+}
+
+// LoadGradeRules reads grading thresholds from a JSON file, keeping the
+// defaults for the ones it leaves out
+func LoadGradeRules(path string) (GradeRules, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return GradeRules{}, fmt.Errorf("failed to read grading rules: %w", err)
+	}
+
+	var rules GradeRules
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&rules); err != nil {
+		return GradeRules{}, fmt.Errorf("invalid grading rules: %w", err)
+	}
+	for _, name := range rules.Skip {
+		if !slices.Contains(GetGradeRules(), name) {
+			return GradeRules{}, fmt.Errorf("unknown rule %q in skip (expected one of: %s)", name, strings.Join(GetGradeRules(), ", "))
+		}
+	}
+	return rules.withDefaults(), nil
+}
+
+// withDefaults fills in the thresholds that are not set
+func (r GradeRules) withDefaults() GradeRules {
+	defaults := DefaultGradeRules()
+	if r.MaxBullets <= 0 {
+		r.MaxBullets = defaults.MaxBullets
+	}
+	if r.MaxBulletWords <= 0 {
+		r.MaxBulletWords = defaults.MaxBulletWords
+	}
+	if r.MaxWords <= 0 {
+		r.MaxWords = defaults.MaxWords
+	}
+	if r.MinTitleWords <= 0 {
+		r.MinTitleWords = defaults.MinTitleWords
+	}
+	return r
+}
+
+// GradeIssue is a way a slide breaks a rule, costing it Penalty points
+type GradeIssue struct {
+	Rule    string
+	Message string
+	Penalty int
+}
+
+// SlideGrade is the score of a slide out of 100 and what it lost points for
+type SlideGrade struct {
+	Index  int
+	Title  string
+	Score  int
+	Issues []GradeIssue
+}
+
+// DeckGrade is the grade of a deck: the mean score of its graded slides
+type DeckGrade struct {
+	Score  float64
+	Letter string
+	Slides []SlideGrade
+}
+
+// Grade scores the content slides of the main flow against the rules. Title
+// and blank slides, and hidden (backup) slides, are not graded.
+func (data *PresentationData) Grade(rules GradeRules) DeckGrade {
+	rules = rules.withDefaults()
+
+	var grade DeckGrade
+	total := 0
+	for i, slide := range data.Slides {
+		if slide.Hidden || slide.Layout == "title" || slide.Layout == "blank" {
+			continue
+		}
+
+		slideGrade := SlideGrade{Index: i, Title: slide.Title, Score: 100}
+		slideGrade.Issues = rules.check(slide)
+		for _, issue := range slideGrade.Issues {
+			slideGrade.Score -= issue.Penalty
+		}
+		slideGrade.Score = max(slideGrade.Score, 0)
+
+		total += slideGrade.Score
+		grade.Slides = append(grade.Slides, slideGrade)
+	}
+
+	grade.Score = 100
+	if len(grade.Slides) > 0 {
+		grade.Score = float64(total) / float64(len(grade.Slides))
+	}
+	grade.Letter = GradeLetter(grade.Score)
+	return grade
+}
+
+// Worst returns up to n of the graded slides that lost points, lowest score
+// first
+func (g DeckGrade) Worst(n int) []SlideGrade {
+	var worst []SlideGrade
+	for _, slide := range g.Slides {
+		if slide.Score < 100 {
+			worst = append(worst, slide)
+		}
+	}
+	slices.SortStableFunc(worst, func(a, b SlideGrade) int { return a.Score - b.Score })
+	if len(worst) > n {
+		worst = worst[:n]
+	}
+	return worst
+}
+
+// GradeLetter converts a score out of 100 to a letter grade
+func GradeLetter(score float64) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 80:
+		return "B"
+	case score >= 70:
+		return "C"
+	case score >= 60:
+		return "D"
+	default:
+		return "F"
+	}
+}
+
+// check returns the issues a slide has with the rules
+func (r GradeRules) check(slide Slide) []GradeIssue {
+	var issues []GradeIssue
+	lines := contentLines(slide.Content)
+
+	if !slices.Contains(r.Skip, RuleSixBySix) {
+		bullets, long := 0, 0
+		for _, line := range lines {
+			if gradeBullet.MatchString(line) {
+				bullets++
+				if countWords(gradeBullet.ReplaceAllString(line, "")) > r.MaxBulletWords {
+					long++
+				}
+			}
+		}
+		if extra := bullets - r.MaxBullets; extra > 0 {
+			issues = append(issues, GradeIssue{
+				Rule:    RuleSixBySix,
+				Message: fmt.Sprintf("%d bullets (at most %d)", bullets, r.MaxBullets),
+				Penalty: min(extra*10, 30),
+			})
+		}
+		if long > 0 {
+			issues = append(issues, GradeIssue{
+				Rule:    RuleSixBySix,
+				Message: fmt.Sprintf("%d bullet(s) over %d words", long, r.MaxBulletWords),
+				Penalty: min(long*5, 20),
+			})
+		}
+	}
+
+	if !slices.Contains(r.Skip, RuleOneIdea) {
+		words, headings := 0, 0
+		for _, line := range lines {
+			words += countWords(line)
+			if gradeHeading.MatchString(line) {
+				headings++
+			}
+		}
+		if over := words - r.MaxWords; over > 0 {
+			issues = append(issues, GradeIssue{
+				Rule:    RuleOneIdea,
+				Message: fmt.Sprintf("%d words (at most %d); split it or move detail to the notes", words, r.MaxWords),
+				Penalty: min(over, 30),
+			})
+		}
+		if headings > 1 {
+			issues = append(issues, GradeIssue{
+				Rule:    RuleOneIdea,
+				Message: fmt.Sprintf("%d subheadings suggest %d ideas on one slide", headings, headings),
+				Penalty: min((headings-1)*15, 30),
+			})
+		}
+	}
+
+	if !slices.Contains(r.Skip, RuleAssertionTitle) {
+		if words := countWords(slide.Title); words < r.MinTitleWords {
+			message := "no title; state the slide's takeaway as a sentence"
+			if words > 0 {
+				message = fmt.Sprintf("title %q names a topic; state the slide's takeaway as a sentence", slide.Title)
+			}
+			issues = append(issues, GradeIssue{Rule: RuleAssertionTitle, Message: message, Penalty: 20})
+		}
+	}
+
+	return issues
+}
+
+// CountBullets returns the number of list items in slide content, outside
+// code blocks
+func CountBullets(content string) int {
+	count := 0
+	for _, line := range contentLines(content) {
+		if gradeBullet.MatchString(line) {
+			count++
+		}
+	}
+	return count
+}
+
+// contentLines returns the lines of slide content outside code blocks, which
+// are not prose and are left out of the word counts
+func contentLines(content string) []string {
+	var lines []string
+	fenced := false
+	for _, line := range strings.Split(content, "\n") {
+		if markdownFence.MatchString(line) {
+			fenced = !fenced
+			continue
+		}
+		if !fenced && strings.TrimSpace(line) != "|||" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// countWords counts the words of a line of markdown, ignoring its markup
+func countWords(line string) int {
+	line = gradeHeading.ReplaceAllString(line, "")
+	line = gradeMarkup.ReplaceAllString(line, "$1")
+	count := 0
+	for _, field := range strings.Fields(line) {
+		if strings.ContainsFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,64 @@
+package presentation
+
+import (
+	"fmt"
+	"time"
+)
+
+// MoveHiddenSlides returns a copy of the presentation with hidden (backup)
+// slides moved after the main flow, behind an "Appendix" divider. They stay
+// reachable by navigating past the last slide or through links.
+func (data *PresentationData) MoveHiddenSlides() *PresentationData {
+	var main, hidden []Slide
+	for _, slide := range data.Slides {
+		if slide.Hidden {
+			hidden = append(hidden, slide)
+		} else {
+			main = append(main, slide)
+		}
+	}
+	if len(hidden) == 0 {
+		return data
+	}
+
+	divider := Slide{Hidden: true}
+	divider.Title = "Appendix"
+	divider.Layout = "title"
+
+	moved := data.Clone()
+	moved.Slides = append(main, divider)
+	moved.Slides = append(moved.Slides, hidden...)
+	return moved
+}
+
+// mainFlowEnd returns the index just past the last slide in the main flow
+func (data *PresentationData) mainFlowEnd() int {
+	for i, slide := range data.Slides {
+		if slide.Hidden {
+			return i
+		}
+	}
+	return len(data.Slides)
+}
+
+// SetHidden hides or shows the slides at the given indexes (0-based)
+func (w *Writer) SetHidden(path string, indexes []int, hidden bool) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		if index < 0 || index >= len(data.Slides) {
+			return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+		data.Slides[index].Hidden = hidden
+	}
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
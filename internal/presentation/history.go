@@ -0,0 +1,178 @@
+package presentation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HistoryDir is the directory in AuditDir that holds earlier versions of
+// decks
+const HistoryDir = "history"
+
+// historyManifest is the file in a deck's history directory listing its
+// revisions
+const historyManifest = "revisions.json"
+
+// maxDeckRevisions is how many earlier versions of a deck are kept
+const maxDeckRevisions = 50
+
+// DeckRevision is an earlier version of a deck, kept when a save replaced
+// it. Revisions are numbered from 1 and keep their number as old ones are
+// dropped.
+type DeckRevision struct {
+	Revision int `json:"revision"`
+	// Replaced is when the revision was replaced, and Source and Actor the
+	// command and person that replaced it
+	Replaced time.Time `json:"replaced"`
+	Source   string    `json:"source,omitempty"`
+	Actor    string    `json:"actor,omitempty"`
+	Title    string    `json:"title"`
+	Slides   int       `json:"slides"`
+}
+
+// HistoryPath returns the directory holding the earlier versions of the
+// deck at path, e.g. presentations/.pres/history/my-talk for
+// presentations/my-talk.json
+func HistoryPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return filepath.Join(filepath.Dir(path), AuditDir, HistoryDir, name)
+}
+
+// revisionPath returns the file holding a revision of the deck at path
+func revisionPath(path string, revision int) string {
+	return filepath.Join(HistoryPath(path), strconv.Itoa(revision)+".json")
+}
+
+// ListRevisions returns the earlier versions of the deck at path, oldest
+// first. A deck that was never saved over has none.
+func ListRevisions(path string) ([]DeckRevision, error) {
+	raw, err := os.ReadFile(filepath.Join(HistoryPath(path), historyManifest))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revision history: %w", err)
+	}
+	var revisions []DeckRevision
+	if err := json.Unmarshal(raw, &revisions); err != nil {
+		return nil, fmt.Errorf("invalid revision history for %s: %w", path, err)
+	}
+	return revisions, nil
+}
+
+// writeRevisions saves the list of revisions of the deck at path
+func writeRevisions(path string, revisions []DeckRevision) error {
+	manifest, err := json.MarshalIndent(revisions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(HistoryPath(path), historyManifest), manifest, 0644); err != nil {
+		return fmt.Errorf("failed to write revision history: %w", err)
+	}
+	return nil
+}
+
+// saveRevision keeps the deck file at path as a revision before a save
+// replaces it with content. A deck not saved yet, or saved unchanged, has
+// nothing to keep. The oldest revisions are dropped beyond
+// maxDeckRevisions.
+func (w *Writer) saveRevision(path string, content []byte) error {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	if string(raw) == string(content) {
+		return nil
+	}
+
+	revisions, err := ListRevisions(path)
+	if err != nil {
+		return err
+	}
+
+	revision := DeckRevision{
+		Revision: 1,
+		Replaced: time.Now(),
+		Source:   w.auditSource,
+		Actor:    w.auditActor,
+	}
+	if len(revisions) > 0 {
+		revision.Revision = revisions[len(revisions)-1].Revision + 1
+	}
+	// A deck edited by hand into invalid JSON is still kept, untitled
+	var previous PresentationData
+	if json.Unmarshal(raw, &previous) == nil {
+		revision.Title, revision.Slides = previous.Metadata.Title, len(previous.Slides)
+	}
+
+	if err := os.MkdirAll(HistoryPath(path), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	if err := os.WriteFile(revisionPath(path, revision.Revision), raw, 0644); err != nil {
+		return fmt.Errorf("failed to save revision: %w", err)
+	}
+
+	revisions = append(revisions, revision)
+	if extra := len(revisions) - maxDeckRevisions; extra > 0 {
+		for _, dropped := range revisions[:extra] {
+			os.Remove(revisionPath(path, dropped.Revision))
+		}
+		revisions = append([]DeckRevision(nil), revisions[extra:]...)
+	}
+	return writeRevisions(path, revisions)
+}
+
+// UndoPresentation restores the deck at path to an earlier version: the
+// given revision, or the most recent one when revision is 0. The restored
+// revision and any later ones are removed from the history, so undoing
+// again goes further back.
+func UndoPresentation(path string, revision int) (*DeckRevision, error) {
+	revisions, err := ListRevisions(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("%s has no earlier versions to restore", path)
+	}
+
+	index := len(revisions) - 1
+	if revision != 0 {
+		index = -1
+		numbers := make([]string, 0, len(revisions))
+		for i, candidate := range revisions {
+			if candidate.Revision == revision {
+				index = i
+			}
+			numbers = append(numbers, strconv.Itoa(candidate.Revision))
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("no revision %d of %s (revisions: %s)", revision, path, strings.Join(numbers, ", "))
+		}
+	}
+	restored := revisions[index]
+
+	raw, err := os.ReadFile(revisionPath(path, restored.Revision))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revision %d: %w", restored.Revision, err)
+	}
+	if err := os.WriteFile(path, raw, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	for _, dropped := range revisions[index:] {
+		os.Remove(revisionPath(path, dropped.Revision))
+	}
+	if err := writeRevisions(path, revisions[:index]); err != nil {
+		return nil, err
+	}
+	return &restored, nil
+}
@@ -0,0 +1,78 @@
+package presentation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ImagePath returns where the image generated for a slide's prompt is
+// stored: the path relative to the presentation's directory, as kept in the
+// slide's image field, and the path on disk. The file is named after the
+// prompt, so a changed prompt gets a new image and an earlier prompt finds
+// the image already generated for it.
+func ImagePath(path, slideID, prompt string) (rel, file string) {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	sum := sha256.Sum256([]byte(strings.TrimSpace(prompt)))
+	image := fmt.Sprintf("%s-%s.png", slideID, hex.EncodeToString(sum[:])[:12])
+	rel = filepath.ToSlash(filepath.Join(AssetsDir, name, "images", image))
+	return rel, filepath.Join(filepath.Dir(path), filepath.FromSlash(rel))
+}
+
+// PendingImages returns the indexes of the slides of the deck at path with
+// an image prompt but no image generated for it, because the prompt is new
+// or has changed or the image is missing. Shared slides are left to the deck
+// they come from.
+func (data *PresentationData) PendingImages(path string) []int {
+	var pending []int
+	for i, slide := range data.Slides {
+		if strings.TrimSpace(slide.Image_prompt) == "" || slide.Ref != "" {
+			continue
+		}
+		rel, file := ImagePath(path, slide.ID, slide.Image_prompt)
+		if slide.Image != rel {
+			pending = append(pending, i)
+		} else if _, err := os.Stat(file); err != nil {
+			pending = append(pending, i)
+		}
+	}
+	return pending
+}
+
+// SaveSlideImage stores the image generated for the slide with the given ID
+// and points the slide's image field at it. A nil image keeps the file
+// already generated for the prompt.
+func (w *Writer) SaveSlideImage(path, slideID, prompt string, image []byte) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	index := data.GetSlideIndex(slideID)
+	if index < 0 {
+		return nil, fmt.Errorf("no slide with id %s", slideID)
+	}
+
+	rel, file := ImagePath(path, slideID, prompt)
+	if image != nil {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create assets directory: %w", err)
+		}
+		if err := os.WriteFile(file, image, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write image: %w", err)
+		}
+	}
+
+	data.Slides[index].Image = rel
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
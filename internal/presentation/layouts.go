@@ -0,0 +1,87 @@
+package presentation
+
+import (
+	"fmt"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// Layout is a slide layout the generator supports
+type Layout struct {
+	Name        string
+	Description string
+	// Sample is a slide showing the layout in use
+	Sample types.Slide
+}
+
+// Layouts lists the supported slide layouts, with a sample slide for each
+func Layouts() []Layout {
+	return []Layout{
+		{
+			Name:        "title",
+			Description: "Large centered text for section introductions",
+			Sample: types.Slide{
+				Title:   "Section Title",
+				Layout:  "title",
+				Content: "A short subtitle introducing the section",
+			},
+		},
+		{
+			Name:        "content",
+			Description: "Standard content slide with title and bullet points",
+			Sample: types.Slide{
+				Title:   "A Content Slide",
+				Layout:  "content",
+				Content: "- The most common layout, for a few related points\n- Markdown is supported: **bold**, *italic*, and `code`\n  - Nested points for detail\n- Keep to five or six bullets",
+			},
+		},
+		{
+			Name:        "two-column",
+			Description: "Split content into two columns (use ||| to separate)",
+			Sample: types.Slide{
+				Title:   "Two Columns",
+				Layout:  "two-column",
+				Content: "### Before\n- Manual builds\n- Weekly releases\n- Outages found by customers\n|||\n### After\n- Automated pipeline\n- Daily releases\n- Alerts before customers notice",
+			},
+		},
+		{
+			Name:        "assertion-evidence",
+			Description: "Full-sentence headline stating the takeaway, above a single piece of visual evidence",
+			Sample: types.Slide{
+				Title:   "Automated releases cut deployment time from two days to twenty minutes",
+				Layout:  "assertion-evidence",
+				Content: "| Step | Before | After |\n|------|--------|-------|\n| Build | 4 h | 8 min |\n| Test | 1 day | 10 min |\n| Deploy | 6 h | 2 min |",
+			},
+		},
+		{
+			Name:        "blank",
+			Description: "Minimal slide for images or quotes",
+			Sample: types.Slide{
+				Layout:  "blank",
+				Content: "> Simplicity is prerequisite for reliability.\n>\n> — Edsger W. Dijkstra",
+			},
+		},
+	}
+}
+
+// LayoutGallery returns a deck demonstrating every slide layout in the given
+// theme. Each sample's speaker notes name and describe its layout.
+func LayoutGallery(theme string) *PresentationData {
+	gallery := &types.Presentation{
+		Title:    "Slide Layouts",
+		Subtitle: fmt.Sprintf("Every layout in the %s theme", theme),
+		Author:   "pres",
+		Theme:    theme,
+		Slides: []types.Slide{{
+			Title:   "Slide Layouts",
+			Layout:  "title",
+			Content: fmt.Sprintf("Every layout pres generates, in the %s theme", theme),
+		}},
+	}
+	for _, layout := range Layouts() {
+		sample := layout.Sample
+		sample.Notes = fmt.Sprintf("Layout: %s. %s.", layout.Name, layout.Description)
+		gallery.Slides = append(gallery.Slides, sample)
+	}
+	return NewPresentationData(gallery)
+}
@@ -0,0 +1,92 @@
+package presentation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// minIDPrefix is the shortest slide ID prefix accepted as a link target
+const minIDPrefix = 4
+
+var (
+	// slideLinkPattern matches the target of a markdown link to another slide:
+	// [text](#slide:target)
+	slideLinkPattern = regexp.MustCompile(`\]\(#slide:([A-Za-z0-9_-]+)\)`)
+
+	// slugPattern matches runs of characters that are not allowed in a slug
+	slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// SlideAnchor returns the HTML id of a slide's section in generated output
+func SlideAnchor(slide Slide) string {
+	if slide.ID == "" {
+		return ""
+	}
+	return "slide-" + slide.ID
+}
+
+// ResolveSlideLinks returns a copy of the presentation in which links to
+// other slides, written as [text](#slide:target), point at the slide's anchor
+// in the generated deck. The target is a slide ID, a unique prefix of one, or
+// the slide's title in lowercase with dashes (e.g. "q3-results"). Links that
+// match no slide are reported as warnings.
+func (data *PresentationData) ResolveSlideLinks() (*PresentationData, []string) {
+	if !strings.Contains(joinContent(data), "(#slide:") {
+		return data, nil
+	}
+
+	resolved := data.Clone()
+	var warnings []string
+	for i := range resolved.Slides {
+		slide := &resolved.Slides[i]
+		slide.Content = slideLinkPattern.ReplaceAllStringFunc(slide.Content, func(match string) string {
+			target := slideLinkPattern.FindStringSubmatch(match)[1]
+			index, err := data.findLinkTarget(target)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("slide %d: %v", i+1, err))
+				return match
+			}
+			return "](#/" + SlideAnchor(data.Slides[index]) + ")"
+		})
+	}
+
+	return resolved, warnings
+}
+
+// findLinkTarget returns the index of the slide a link target names, trying
+// exact IDs, then titles, then ID prefixes
+func (data *PresentationData) findLinkTarget(target string) (int, error) {
+	if index := data.GetSlideIndex(target); index >= 0 {
+		return index, nil
+	}
+
+	matchers := []func(Slide) bool{
+		func(slide Slide) bool { return Slugify(slide.Title) == target },
+		func(slide Slide) bool { return len(target) >= minIDPrefix && strings.HasPrefix(slide.ID, target) },
+	}
+
+	for _, matches := range matchers {
+		var found []int
+		for i, slide := range data.Slides {
+			if slide.ID != "" && matches(slide) {
+				found = append(found, i)
+			}
+		}
+		switch len(found) {
+		case 0:
+			continue
+		case 1:
+			return found[0], nil
+		default:
+			return -1, fmt.Errorf("link target %q matches %d slides", target, len(found))
+		}
+	}
+
+	return -1, fmt.Errorf("no slide matches link target %q", target)
+}
+
+// Slugify converts text to lowercase words joined by dashes
+func Slugify(text string) string {
+	return strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(text), "-"), "-")
+}
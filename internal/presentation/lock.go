@@ -0,0 +1,30 @@
+package presentation
+
+import (
+	"fmt"
+	"time"
+)
+
+// SetLocked locks or unlocks the slides at the given indexes (0-based).
+// Updates may not modify or delete locked slides, which protects slides such
+// as legal disclaimers from being rewritten.
+func (w *Writer) SetLocked(path string, indexes []int, locked bool) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		if index < 0 || index >= len(data.Slides) {
+			return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+		data.Slides[index].Locked = locked
+	}
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
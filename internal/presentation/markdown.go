@@ -0,0 +1,407 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+var (
+	// markdownRule matches a line that Marp and reveal-md read as a slide
+	// separator: "---", or "----" which reveal-md uses between vertical slides
+	markdownRule = regexp.MustCompile(`^[ \t]*----?[ \t]*$`)
+
+	// yamlPlain matches strings that can be written in YAML without quotes
+	yamlPlain = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 ._/()-]*$`)
+)
+
+// Markdown renders the presentation as a single Markdown file that Marp and
+// reveal-md can present: metadata in the front matter, with the reveal.js
+// theme under "pres-theme", which Marp would reject as its theme, and custom
+// fields under "custom"; slides separated by "---"; and speaker notes in HTML
+// comments. Title slides get a level one
+// heading and Marp's "lead" class, other slides a level two heading; the
+// columns of two-column slides are separated by "|||".
+//
+// Fields pres manages itself, such as slide IDs, tags, and comments, have no
+// Markdown form and are left out.
+func (data *PresentationData) Markdown() string {
+	var sb strings.Builder
+
+	meta := data.Metadata
+	sb.WriteString("---\n")
+	sb.WriteString("marp: true\n")
+	writeYAMLField(&sb, "title", meta.Title)
+	writeYAMLField(&sb, "description", meta.Subtitle)
+	writeYAMLField(&sb, "author", meta.Author)
+	writeYAMLField(&sb, "date", meta.Date)
+	writeYAMLField(&sb, "pres-theme", meta.Theme)
+	if len(meta.Custom) > 0 {
+		sb.WriteString("custom:\n")
+		for _, key := range meta.GetCustomKeys() {
+			writeYAMLField(&sb, "  "+yamlScalar(key), meta.Custom[key])
+		}
+	}
+	sb.WriteString("paginate: true\n")
+	sb.WriteString("---\n")
+
+	for i, slide := range data.Slides {
+		if i > 0 {
+			sb.WriteString("\n---\n")
+		}
+		sb.WriteString("\n")
+		writeMarkdownSlide(&sb, slide)
+	}
+
+	return sb.String()
+}
+
+// writeMarkdownSlide writes a slide between separators
+func writeMarkdownSlide(sb *strings.Builder, slide Slide) {
+	if slide.Layout == "title" {
+		sb.WriteString("<!-- _class: lead -->\n")
+	}
+	if slide.Background_color != "" {
+		fmt.Fprintf(sb, "<!-- _backgroundColor: %s -->\n", slide.Background_color)
+	}
+	if slide.Layout == "title" || slide.Background_color != "" {
+		sb.WriteString("\n")
+	}
+
+	if slide.Title != "" {
+		heading := "##"
+		if slide.Layout == "title" {
+			heading = "#"
+		}
+		fmt.Fprintf(sb, "%s %s\n", heading, slide.Title)
+	}
+
+	content := slide.Content
+	if slide.Layout == "two-column" {
+		columns := SplitColumns(content)
+		for i := range columns {
+			columns[i] = strings.TrimSpace(columns[i])
+		}
+		content = strings.Join(columns, "\n\n|||\n\n")
+	}
+	content = strings.TrimSpace(escapeSeparators(content))
+	if content != "" {
+		if slide.Title != "" {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(content)
+		sb.WriteString("\n")
+	}
+
+	if notes := strings.TrimSpace(slide.Notes); notes != "" {
+		sb.WriteString("\n<!--\n")
+		sb.WriteString(strings.ReplaceAll(notes, "-->", "-- >"))
+		sb.WriteString("\n-->\n")
+	}
+}
+
+// escapeSeparators rewrites the rules in content that would end the slide as
+// "***", the same rule in Markdown, leaving code blocks alone
+func escapeSeparators(content string) string {
+	lines := strings.Split(content, "\n")
+	fenced := false
+	for i, line := range lines {
+		if markdownFence.MatchString(line) {
+			fenced = !fenced
+		}
+		if !fenced && markdownRule.MatchString(line) {
+			lines[i] = "***"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// writeYAMLField writes a front matter field, quoting the value when YAML
+// would otherwise misread it. Empty values are left out.
+func writeYAMLField(sb *strings.Builder, key, value string) {
+	if value == "" {
+		return
+	}
+	fmt.Fprintf(sb, "%s: %s\n", key, yamlScalar(value))
+}
+
+// yamlScalar returns value as a YAML scalar, quoted when YAML would
+// otherwise misread it
+func yamlScalar(value string) string {
+	if !yamlPlain.MatchString(value) || yamlKeyword(value) {
+		quoted, _ := json.Marshal(value)
+		return string(quoted)
+	}
+	return value
+}
+
+// yamlKeyword reports whether YAML reads a plain value as a boolean or null
+func yamlKeyword(value string) bool {
+	switch strings.ToLower(value) {
+	case "true", "false", "yes", "no", "on", "off", "null":
+		return true
+	}
+	return false
+}
+
+var (
+	// markdownFence matches the start or end of a fenced code block
+	markdownFence = regexp.MustCompile("^\\s*(```|~~~)")
+
+	// markdownHeading matches an ATX heading
+	markdownHeading = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+
+	// markdownComment matches an HTML comment, across lines
+	markdownComment = regexp.MustCompile(`(?s)<!--(.*?)-->`)
+
+	// markdownDirective matches a Marp directive, e.g. "_class: lead"
+	markdownDirective = regexp.MustCompile(`^\s*(_?[A-Za-z]+)\s*:\s*(.*?)\s*$`)
+
+	// revealSlideAttrs matches reveal-md's slide attributes comment, e.g.
+	// ".slide: data-background="#ff0000""
+	revealSlideAttrs = regexp.MustCompile(`^\s*\.slide:(.*)$`)
+
+	// revealBackground matches a background color in slide attributes
+	revealBackground = regexp.MustCompile(`data-background(?:-color)?="([^"]*)"`)
+
+	// revealNotes matches the line that starts reveal-md's speaker notes
+	revealNotes = regexp.MustCompile(`(?m)^Notes?:\s*`)
+)
+
+// marpDirectives are the Marp directive names, which are kept out of the
+// speaker notes
+var marpDirectives = map[string]bool{
+	"theme": true, "style": true, "headingDivider": true, "size": true, "math": true,
+	"title": true, "author": true, "description": true, "image": true, "url": true,
+	"paginate": true, "header": true, "footer": true, "class": true,
+	"backgroundColor": true, "backgroundImage": true, "backgroundPosition": true,
+	"backgroundRepeat": true, "backgroundSize": true, "color": true,
+}
+
+// ParseMarkdown reads a Marp or reveal-md style Markdown deck, such as one
+// written by PresentationData.Markdown, as a new draft presentation. Front
+// matter sets the metadata, with the theme read from "pres-theme" or else
+// "theme", and custom fields from the keys under "custom"; "---" separates
+// slides. A slide's first heading
+// becomes its title, with a level one heading or Marp's "lead" class making
+// it a title slide; slides with a "|||" column separator become two-column
+// slides.
+// Speaker notes are read from HTML comments (Marp) or after a "Note:" line
+// (reveal-md). The warnings describe what could not be carried over.
+func ParseMarkdown(text string) (*PresentationData, []string, error) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	front, body := splitFrontMatter(text)
+
+	var warnings []string
+	pres := types.Presentation{
+		Title:    front["title"],
+		Subtitle: front["description"],
+		Author:   front["author"],
+		Date:     front["date"],
+		Theme:    front["pres-theme"],
+	}
+	if pres.Subtitle == "" {
+		pres.Subtitle = front["subtitle"]
+	}
+	if pres.Theme == "" {
+		pres.Theme = front["theme"]
+	}
+	if !slices.Contains(GetRevealJSThemes(), pres.Theme) {
+		if pres.Theme != "" {
+			warnings = append(warnings, fmt.Sprintf("theme %q is not a reveal.js theme; using black", pres.Theme))
+		}
+		pres.Theme = "black"
+	}
+
+	for _, section := range splitMarkdownSlides(body) {
+		slide, ok := parseMarkdownSlide(section)
+		if ok {
+			pres.Slides = append(pres.Slides, slide)
+		}
+	}
+	if len(pres.Slides) == 0 {
+		return nil, nil, fmt.Errorf("no slides found")
+	}
+	if pres.Title == "" {
+		pres.Title = pres.Slides[0].Title
+	}
+
+	data := NewPresentationData(&pres)
+	for key, value := range front {
+		if name, ok := strings.CutPrefix(key, "custom."); ok {
+			data.Metadata.SetCustom(name, value)
+		}
+	}
+	return data, warnings, nil
+}
+
+// splitFrontMatter separates the YAML front matter of a Markdown file from
+// its body. Only "key: value" lines are read, which covers the metadata a
+// deck's front matter holds; the lines indented under a key without a value
+// are read as "key.name".
+func splitFrontMatter(text string) (map[string]string, string) {
+	front := map[string]string{}
+	if !strings.HasPrefix(text, "---\n") {
+		return front, text
+	}
+
+	lines := strings.Split(text, "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			// parent is the key without a value the indented lines belong to
+			parent := ""
+			for _, line := range lines[1:i] {
+				key, value, ok := cutYAMLField(line)
+				if !ok || strings.HasPrefix(strings.TrimSpace(line), "#") {
+					continue
+				}
+				if strings.HasPrefix(line, " ") {
+					if parent != "" {
+						front[parent+"."+key] = value
+					}
+					continue
+				}
+				if value == "" {
+					parent = key
+					continue
+				}
+				parent = ""
+				front[key] = value
+			}
+			return front, strings.Join(lines[i+1:], "\n")
+		}
+	}
+	return front, text
+}
+
+// cutYAMLField splits a "key: value" line, unquoting both, even when a
+// quoted key holds a colon
+func cutYAMLField(line string) (key, value string, ok bool) {
+	line = strings.TrimSpace(line)
+	rest := line
+	if strings.HasPrefix(line, `"`) {
+		// Find the quote closing the key, skipping escaped ones
+		end := 1
+		for end < len(line) && line[end] != '"' {
+			if line[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(line) {
+			return "", "", false
+		}
+		rest = line[end+1:]
+		key = line[:end+1]
+	}
+	k, value, ok := strings.Cut(rest, ":")
+	if !ok {
+		return "", "", false
+	}
+	if key == "" {
+		key = k
+	}
+	return yamlValue(strings.TrimSpace(key)), yamlValue(strings.TrimSpace(value)), true
+}
+
+// yamlValue unquotes a scalar YAML value
+func yamlValue(value string) string {
+	if strings.HasPrefix(value, `"`) {
+		var unquoted string
+		if err := json.Unmarshal([]byte(value), &unquoted); err == nil {
+			return unquoted
+		}
+	}
+	if len(value) >= 2 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") {
+		return strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+	}
+	return value
+}
+
+// splitMarkdownSlides splits a deck's body on slide separators outside code
+// blocks
+func splitMarkdownSlides(body string) []string {
+	var slides []string
+	var current []string
+	fenced := false
+	for _, line := range strings.Split(body, "\n") {
+		if markdownFence.MatchString(line) {
+			fenced = !fenced
+		}
+		if !fenced && markdownRule.MatchString(line) {
+			slides = append(slides, strings.Join(current, "\n"))
+			current = nil
+			continue
+		}
+		current = append(current, line)
+	}
+	return append(slides, strings.Join(current, "\n"))
+}
+
+// parseMarkdownSlide reads a slide from the text between two separators,
+// reporting false for an empty section
+func parseMarkdownSlide(section string) (types.Slide, bool) {
+	var slide types.Slide
+	var notes []string
+	lead := false
+
+	// Comments are Marp directives, reveal-md slide attributes, or notes
+	section = markdownComment.ReplaceAllStringFunc(section, func(comment string) string {
+		inner := strings.TrimSpace(markdownComment.FindStringSubmatch(comment)[1])
+		if m := revealSlideAttrs.FindStringSubmatch(inner); m != nil {
+			if bg := revealBackground.FindStringSubmatch(m[1]); bg != nil {
+				slide.Background_color = bg[1]
+			}
+			return ""
+		}
+		if m := markdownDirective.FindStringSubmatch(inner); m != nil && !strings.Contains(inner, "\n") &&
+			marpDirectives[strings.TrimPrefix(m[1], "_")] {
+			switch strings.TrimPrefix(m[1], "_") {
+			case "class":
+				lead = slices.Contains(strings.Fields(m[2]), "lead")
+			case "backgroundColor":
+				slide.Background_color = yamlValue(m[2])
+			}
+			return ""
+		}
+		if inner != "" {
+			notes = append(notes, inner)
+		}
+		return ""
+	})
+
+	if loc := revealNotes.FindStringIndex(section); loc != nil {
+		notes = append(notes, strings.TrimSpace(section[loc[1]:]))
+		section = section[:loc[0]]
+	}
+	slide.Notes = strings.Join(notes, "\n\n")
+
+	// The first line of the slide is its title when it is a heading
+	content := strings.TrimSpace(section)
+	level := 0
+	first, rest, _ := strings.Cut(content, "\n")
+	if m := markdownHeading.FindStringSubmatch(first); m != nil {
+		level = len(m[1])
+		slide.Title = m[2]
+		content = strings.TrimSpace(rest)
+	}
+	slide.Content = content
+
+	switch {
+	case slide.Title == "" && slide.Content == "" && slide.Notes == "":
+		return slide, false
+	case lead || level == 1:
+		slide.Layout = "title"
+	case slices.Contains(strings.Split(content, "\n"), "|||"):
+		slide.Layout = "two-column"
+	case slide.Title == "" && slide.Content == "":
+		slide.Layout = "blank"
+	default:
+		slide.Layout = "content"
+	}
+	return slide, true
+}
@@ -0,0 +1,124 @@
+package presentation
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// MetadataEdit is a metadata change applied to many presentations at once
+type MetadataEdit struct {
+	// Fields maps update_metadata keys, such as "author" or "custom.team", to
+	// their new values
+	Fields map[string]string
+	// AddTags and RemoveTags change the deck tags, keeping the other tags
+	AddTags    []string
+	RemoveTags []string
+}
+
+// IsEmpty reports whether the edit changes nothing
+func (e MetadataEdit) IsEmpty() bool {
+	return len(e.Fields) == 0 && len(e.AddTags) == 0 && len(e.RemoveTags) == 0
+}
+
+// Validate checks that every field of the edit can be updated
+func (e MetadataEdit) Validate() error {
+	for key := range e.Fields {
+		if !isMetadataKey(key) || key == "tags" {
+			return fmt.Errorf("unknown metadata key %q", key)
+		}
+	}
+	return nil
+}
+
+// Changes returns the update_metadata changes the edit makes to meta,
+// leaving out fields that already have the requested value. Tag changes are
+// returned as the complete new "tags" list.
+func (e MetadataEdit) Changes(meta Metadata) map[string]string {
+	changes := make(map[string]string)
+	for key, value := range e.Fields {
+		if MetadataValue(meta, key) != value {
+			changes[key] = value
+		}
+	}
+
+	tags := slices.DeleteFunc(slices.Clone(meta.Tags), func(tag string) bool {
+		return containsFold(e.RemoveTags, tag)
+	})
+	for _, tag := range e.AddTags {
+		if !containsFold(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if !slices.Equal(tags, meta.Tags) && (len(tags) > 0 || len(meta.Tags) > 0) {
+		changes["tags"] = strings.Join(tags, ",")
+	}
+
+	return changes
+}
+
+// MetadataValue returns the current value of an update_metadata key
+func MetadataValue(meta Metadata, key string) string {
+	switch key {
+	case "title":
+		return meta.Title
+	case "subtitle":
+		return meta.Subtitle
+	case "author":
+		return meta.Author
+	case "date":
+		return meta.Date
+	case "theme":
+		return meta.Theme
+	case "event_date":
+		return meta.EventDate
+	case "venue":
+		return meta.Venue
+	case "tags":
+		return strings.Join(meta.Tags, ",")
+	}
+	if name, ok := strings.CutPrefix(key, "custom."); ok {
+		return meta.Custom[name]
+	}
+	return ""
+}
+
+// splitMetadataTags splits a comma-separated "tags" value, dropping empty tags
+func splitMetadataTags(value string) []string {
+	var tags []string
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" && !containsFold(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// containsFold reports whether tags contains tag, ignoring case
+func containsFold(tags []string, tag string) bool {
+	return slices.ContainsFunc(tags, func(t string) bool {
+		return strings.EqualFold(t, tag)
+	})
+}
+
+// MatchPresentations expands glob patterns, such as
+// "presentations/q3/*.json", into the sorted list of matching files. Each
+// file is listed once, even when several patterns match it.
+func MatchPresentations(patterns []string) ([]string, error) {
+	var paths []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		for _, match := range matches {
+			if !slices.Contains(paths, match) {
+				paths = append(paths, match)
+			}
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
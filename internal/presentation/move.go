@@ -0,0 +1,228 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/platform"
+)
+
+// MoveResult reports what moving a presentation changed
+type MoveResult struct {
+	// Path is where the presentation was moved to
+	Path string
+	// Moved lists the local data and assets moved along with the deck, at
+	// their new paths
+	Moved []string
+	// Rewritten lists the other decks whose slide references were updated
+	Rewritten []string
+}
+
+// localDataPaths returns the files and directories in AuditDir that belong
+// to the deck at path, including the progress files of its passes
+func localDataPaths(path string) []string {
+	paths := []string{AuditLogPath(path), QuestionsPath(path), FeedbackPath(path), ThumbnailPath(path), HistoryPath(path)}
+	passes, _ := filepath.Glob(PassStatePath(path, "*"))
+	return append(paths, passes...)
+}
+
+// movedLocalDataPath returns where a file of the deck at oldPath's local
+// data goes when the deck moves to newPath
+func movedLocalDataPath(file, oldPath, newPath string) string {
+	oldName := strings.TrimSuffix(filepath.Base(oldPath), filepath.Ext(oldPath))
+	newName := strings.TrimSuffix(filepath.Base(newPath), filepath.Ext(newPath))
+	rel, err := filepath.Rel(filepath.Join(filepath.Dir(oldPath), AuditDir), file)
+	if err != nil {
+		rel = filepath.Base(file)
+	}
+	return filepath.Join(filepath.Dir(newPath), AuditDir, filepath.Dir(rel), newName+strings.TrimPrefix(filepath.Base(rel), oldName))
+}
+
+// assetsPath returns the directory holding the media of the deck at path
+func assetsPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return filepath.Join(filepath.Dir(path), AssetsDir, name)
+}
+
+// isLocalPath reports whether a path found in a deck refers to a file
+// relative to the deck, rather than a URL, an absolute path, or an anchor
+func isLocalPath(p string) bool {
+	if p == "" || strings.HasPrefix(p, "#") || strings.HasPrefix(p, "/") || filepath.IsAbs(p) {
+		return false
+	}
+	return !strings.Contains(p, ":")
+}
+
+// MovePresentation moves the deck at oldPath to newPath and keeps everything
+// that refers to it working. Its local data in AuditDir and its assets
+// directory move along with it, paths in the deck that are relative to its
+// directory (narration, images, data sources, and slide references) are
+// rewritten for the new location, and slide references to it from the decks
+// below root are pointed at the new path. When newPath is a directory, the
+// deck keeps its file name.
+func (w *Writer) MovePresentation(oldPath, newPath, root string) (*MoveResult, error) {
+	if info, err := os.Stat(newPath); err == nil && info.IsDir() {
+		newPath = filepath.Join(newPath, filepath.Base(oldPath))
+	}
+
+	oldAbs, err := filepath.Abs(oldPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path %s: %w", oldPath, err)
+	}
+	newAbs, err := filepath.Abs(newPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path %s: %w", newPath, err)
+	}
+	if oldAbs == newAbs {
+		return nil, fmt.Errorf("%s and %s are the same file", oldPath, newPath)
+	}
+	if _, err := os.Stat(newPath); err == nil {
+		return nil, fmt.Errorf("%s already exists", newPath)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	data, err := w.LoadPresentation(oldPath)
+	if err != nil {
+		return nil, err
+	}
+
+	oldAssets, newAssets := assetsPath(oldPath), assetsPath(newPath)
+	if _, err := os.Stat(oldAssets); err == nil {
+		if _, err := os.Stat(newAssets); err == nil {
+			return nil, fmt.Errorf("assets directory %s already exists", newAssets)
+		}
+	}
+
+	// Find the decks referring to this one before anything changes
+	var referrers []CatalogEntry
+	if root != "" {
+		entries, err := w.ScanPresentations(root)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan presentations: %w", err)
+		}
+		for _, entry := range entries {
+			if abs, err := filepath.Abs(entry.Path); err == nil && abs != oldAbs && moveReferences(entry.Data, abs, abs, oldAbs, newAbs) {
+				referrers = append(referrers, entry)
+			}
+		}
+	}
+
+	relocateDeck(data, oldAbs, newAbs)
+	data.Metadata.Modified = time.Now()
+
+	if err := os.MkdirAll(filepath.Dir(newPath), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := w.writeData(newPath, data); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(oldPath); err != nil {
+		return nil, fmt.Errorf("failed to remove %s: %w", oldPath, err)
+	}
+
+	result := &MoveResult{Path: newPath}
+
+	moves := [][2]string{{oldAssets, newAssets}}
+	for _, file := range localDataPaths(oldPath) {
+		moves = append(moves, [2]string{file, movedLocalDataPath(file, oldPath, newPath)})
+	}
+	for _, move := range moves {
+		if _, err := os.Stat(move[0]); err != nil {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(move[1]), 0755); err != nil {
+			return result, fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.Rename(move[0], move[1]); err != nil {
+			return result, fmt.Errorf("failed to move %s: %w", move[0], err)
+		}
+		result.Moved = append(result.Moved, move[1])
+	}
+
+	for _, entry := range referrers {
+		entry.Data.Metadata.Modified = time.Now()
+		if err := w.writeData(entry.Path, entry.Data); err != nil {
+			return result, fmt.Errorf("failed to update %s: %w", entry.Path, err)
+		}
+		result.Rewritten = append(result.Rewritten, entry.Path)
+	}
+
+	return result, nil
+}
+
+// moveReferences points the slide references in data, a deck that used to
+// live at from and now lives at to, that target the deck moved from oldAbs
+// to newAbs at its new location. It reports whether any reference changed.
+func moveReferences(data *PresentationData, from, to, oldAbs, newAbs string) bool {
+	changed := false
+	for i, slide := range data.Slides {
+		if slide.Ref == "" {
+			continue
+		}
+		// References within the same deck move along with it
+		deckPath, slideID, err := ParseReference(slide.Ref)
+		if err != nil || deckPath == "" {
+			continue
+		}
+
+		target := filepath.Join(filepath.Dir(from), platform.LocalPath(deckPath))
+		if target == oldAbs {
+			target = newAbs
+		} else if from == to {
+			continue
+		}
+
+		rel, err := filepath.Rel(filepath.Dir(to), target)
+		if err != nil {
+			continue
+		}
+		if ref := filepath.ToSlash(rel) + "#" + slideID; ref != slide.Ref {
+			data.Slides[i].Ref = ref
+			changed = true
+		}
+	}
+	return changed
+}
+
+// relocateDeck rewrites the paths in the deck moved from oldAbs to newAbs
+// that are relative to its directory, so they keep pointing at the same
+// files. Its own assets are expected under the new assets directory.
+func relocateDeck(data *PresentationData, oldAbs, newAbs string) {
+	oldDir, newDir := filepath.Dir(oldAbs), filepath.Dir(newAbs)
+	oldAssets, newAssets := assetsPath(oldAbs), assetsPath(newAbs)
+
+	relocate := func(p string) string {
+		if !isLocalPath(p) {
+			return p
+		}
+		abs := filepath.Join(oldDir, platform.LocalPath(p))
+		if rest, err := filepath.Rel(oldAssets, abs); err == nil && !strings.HasPrefix(rest, "..") {
+			abs = filepath.Join(newAssets, rest)
+		}
+		rel, err := filepath.Rel(newDir, abs)
+		if err != nil {
+			return p
+		}
+		return filepath.ToSlash(rel)
+	}
+
+	for i := range data.Slides {
+		slide := &data.Slides[i]
+		slide.Audio = relocate(slide.Audio)
+		slide.Image = relocate(slide.Image)
+		slide.Content = imagePattern.ReplaceAllStringFunc(slide.Content, func(image string) string {
+			src := imagePattern.FindStringSubmatch(image)[1]
+			return strings.TrimSuffix(image, src) + relocate(src)
+		})
+	}
+	for i := range data.Metadata.Sources {
+		data.Metadata.Sources[i].Path = relocate(data.Metadata.Sources[i].Path)
+	}
+
+	moveReferences(data, oldAbs, newAbs, oldAbs, newAbs)
+}
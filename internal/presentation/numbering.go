@@ -0,0 +1,127 @@
+package presentation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// figurePattern matches a labelled image: ![caption](src){#fig:label}
+	figurePattern = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)\)\{#(fig:[A-Za-z0-9_-]+)\}`)
+
+	// tableCaptionPattern matches a labelled table caption line:
+	// Table: caption {#tbl:label}
+	tableCaptionPattern = regexp.MustCompile(`(?m)^Table:[ \t]*(.*?)[ \t]*\{#(tbl:[A-Za-z0-9_-]+)\}[ \t]*$`)
+
+	// crossRefPattern matches a cross-reference such as [fig:arch]
+	crossRefPattern = regexp.MustCompile(`\[((?:fig|tbl):[A-Za-z0-9_-]+)\]`)
+)
+
+// NumberFigures returns a copy of the presentation in which labelled figures
+// and tables are numbered in slide order, their captions prefixed with
+// "Figure N" or "Table N", and cross-references like [fig:arch] replaced by
+// the same text. Unknown and duplicate labels are reported as warnings.
+func (data *PresentationData) NumberFigures() (*PresentationData, []string) {
+	numbers := map[string]string{}
+	var warnings []string
+	figures, tables := 0, 0
+
+	// First pass: number every label in slide order
+	for i, slide := range data.Slides {
+		for _, m := range figurePattern.FindAllStringSubmatch(slide.Content, -1) {
+			if _, dup := numbers[m[3]]; dup {
+				warnings = append(warnings, fmt.Sprintf("slide %d: duplicate label %s", i+1, m[3]))
+				continue
+			}
+			figures++
+			numbers[m[3]] = fmt.Sprintf("Figure %d", figures)
+		}
+		for _, m := range tableCaptionPattern.FindAllStringSubmatch(slide.Content, -1) {
+			if _, dup := numbers[m[2]]; dup {
+				warnings = append(warnings, fmt.Sprintf("slide %d: duplicate label %s", i+1, m[2]))
+				continue
+			}
+			tables++
+			numbers[m[2]] = fmt.Sprintf("Table %d", tables)
+		}
+	}
+
+	if len(numbers) == 0 && !crossRefsIn(data) {
+		return data, warnings
+	}
+
+	// Second pass: rewrite captions and references
+	numbered := data.Clone()
+	for i := range numbered.Slides {
+		slide := &numbered.Slides[i]
+
+		slide.Content = figurePattern.ReplaceAllStringFunc(slide.Content, func(match string) string {
+			m := figurePattern.FindStringSubmatch(match)
+			caption := numbers[m[3]]
+			if m[1] != "" {
+				caption += ": " + m[1]
+			}
+			return fmt.Sprintf("![%s](%s)\n\n*%s*", caption, m[2], caption)
+		})
+
+		slide.Content = tableCaptionPattern.ReplaceAllStringFunc(slide.Content, func(match string) string {
+			m := tableCaptionPattern.FindStringSubmatch(match)
+			caption := numbers[m[2]]
+			if m[1] != "" {
+				caption += ": " + m[1]
+			}
+			return "*" + caption + "*"
+		})
+
+		for _, text := range []*string{&slide.Content, &slide.Notes} {
+			var unknown []string
+			*text, unknown = replaceCrossRefs(*text, numbers)
+			for _, label := range unknown {
+				warnings = append(warnings, fmt.Sprintf("slide %d: unknown reference [%s]", i+1, label))
+			}
+		}
+	}
+
+	return numbered, warnings
+}
+
+// crossRefsIn reports whether any slide contains a cross-reference
+func crossRefsIn(data *PresentationData) bool {
+	for _, slide := range data.Slides {
+		if strings.Contains(slide.Content, "[fig:") || strings.Contains(slide.Content, "[tbl:") ||
+			strings.Contains(slide.Notes, "[fig:") || strings.Contains(slide.Notes, "[tbl:") {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceCrossRefs replaces the cross-references in text with their numbers,
+// returning the labels that have none. Markdown links and reference
+// definitions that happen to use the same text, like [fig:a](url) or
+// [fig:a]: url, are left alone.
+func replaceCrossRefs(text string, numbers map[string]string) (string, []string) {
+	var sb strings.Builder
+	var unknown []string
+	last := 0
+	for _, m := range crossRefPattern.FindAllStringSubmatchIndex(text, -1) {
+		end := m[1]
+		if end < len(text) && (text[end] == '(' || text[end] == ':') {
+			continue
+		}
+
+		label := text[m[2]:m[3]]
+		number, ok := numbers[label]
+		if !ok {
+			unknown = append(unknown, label)
+			continue
+		}
+
+		sb.WriteString(text[last:m[0]])
+		sb.WriteString(number)
+		last = end
+	}
+	sb.WriteString(text[last:])
+	return sb.String(), unknown
+}
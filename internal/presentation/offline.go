@@ -0,0 +1,195 @@
+package presentation
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/text"
+)
+
+// imagePattern matches the source of a markdown image: ![alt](src)
+var imagePattern = regexp.MustCompile(`!\[[^\]]*\]\(([^)\s]+)`)
+
+// offlineFiles returns the names of the web app manifest, service worker, and
+// icon written next to an offline deck named name
+func offlineFiles(name string) (manifest, worker, icon string) {
+	return name + ".webmanifest", name + ".sw.js", name + ".icon.svg"
+}
+
+// offlineHead links the web app manifest from the deck's head
+func offlineHead(name string) string {
+	manifest, _, icon := offlineFiles(name)
+	return fmt.Sprintf(`    <link rel="manifest" href="%s">
+    <link rel="icon" href="%s">
+    <meta name="theme-color" content="#191919">
+    <meta name="apple-mobile-web-app-capable" content="yes">
+`, template.HTMLEscapeString(manifest), template.HTMLEscapeString(icon))
+}
+
+// offlineScript registers the deck's service worker. Browsers only allow
+// service workers on https:// and localhost, not on file:// pages.
+func offlineScript(name string) string {
+	_, worker, _ := offlineFiles(name)
+	return fmt.Sprintf(`    <script>
+        if ('serviceWorker' in navigator && location.protocol !== 'file:') {
+            navigator.serviceWorker.register(%q).catch(function (err) {
+                console.warn('Offline support unavailable:', err);
+            });
+        }
+    </script>
+`, worker)
+}
+
+// offlineResources returns the files an offline deck needs: the page itself,
+// reveal.js, and the local images and narration its slides refer to
+func offlineResources(data *PresentationData, page string) []string {
+	resources := []string{
+		page,
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reset.css",
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reveal.css",
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/theme/" + data.Metadata.Theme + ".css",
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/highlight/monokai.css",
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/dist/reveal.js",
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/notes/notes.js",
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/markdown/markdown.js",
+		"https://cdn.jsdelivr.net/npm/reveal.js@5.1.0/plugin/highlight/highlight.js",
+	}
+
+	seen := map[string]bool{}
+	for _, r := range resources {
+		seen[r] = true
+	}
+	add := func(src string) {
+		if src == "" || seen[src] || strings.HasPrefix(src, "data:") || strings.HasPrefix(src, "#") {
+			return
+		}
+		seen[src] = true
+		resources = append(resources, src)
+	}
+
+	for _, slide := range data.Slides {
+		add(slide.Audio)
+		add(slide.Image)
+		for _, m := range imagePattern.FindAllStringSubmatch(slide.Content, -1) {
+			add(m[1])
+		}
+	}
+
+	return resources
+}
+
+// writeOfflineFiles writes the web app manifest, service worker, and icon
+// that make the deck at outputPath installable and viewable offline. digest
+// is the SHA-256 hash of the page, which names its cache.
+func writeOfflineFiles(data *PresentationData, outputPath string, digest []byte) error {
+	dir := filepath.Dir(outputPath)
+	page := filepath.Base(outputPath)
+	name := strings.TrimSuffix(page, filepath.Ext(page))
+	manifestFile, workerFile, iconFile := offlineFiles(name)
+
+	shortName := text.Truncate(data.Metadata.Title, 12)
+	manifest, err := json.MarshalIndent(map[string]any{
+		"name":             data.Metadata.Title,
+		"short_name":       shortName,
+		"start_url":        page,
+		"scope":            "./",
+		"display":          "fullscreen",
+		"orientation":      "landscape",
+		"background_color": "#191919",
+		"theme_color":      "#191919",
+		"icons": []map[string]string{
+			{"src": iconFile, "sizes": "any", "type": "image/svg+xml"},
+		},
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	// A new cache name for every build makes installed decks pick up changes
+	cache := "pres-" + name + "-" + hex.EncodeToString(digest[:6])
+	resources, err := json.Marshal(offlineResources(data, page))
+	if err != nil {
+		return fmt.Errorf("failed to encode resources: %w", err)
+	}
+	worker := fmt.Sprintf(serviceWorkerScript, cache, resources, "pres-"+name+"-")
+
+	initial := "P"
+	if first := text.First(strings.TrimSpace(data.Metadata.Title)); first != "" {
+		initial = strings.ToUpper(first)
+	}
+	icon := fmt.Sprintf(iconSVG, template.HTMLEscapeString(initial))
+
+	files := map[string]string{
+		manifestFile: string(manifest) + "\n",
+		workerFile:   worker,
+		iconFile:     icon,
+	}
+	for file, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, file), []byte(content), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+// serviceWorkerScript caches the deck and everything it needs when it is
+// installed, then serves from the cache first so the deck keeps working
+// without a network. Resources missed at install time are cached the first
+// time they load.
+const serviceWorkerScript = `var CACHE = %q;
+var RESOURCES = %s;
+
+self.addEventListener('install', function (event) {
+    event.waitUntil(caches.open(CACHE).then(function (cache) {
+        // One missing file shouldn't keep the rest of the deck from caching
+        return Promise.all(RESOURCES.map(function (url) {
+            return cache.add(url).catch(function (err) {
+                console.warn('Could not cache ' + url + ':', err);
+            });
+        }));
+    }).then(function () {
+        return self.skipWaiting();
+    }));
+});
+
+self.addEventListener('activate', function (event) {
+    event.waitUntil(caches.keys().then(function (keys) {
+        return Promise.all(keys.filter(function (key) {
+            return key.indexOf(%q) === 0 && key !== CACHE;
+        }).map(function (key) {
+            return caches.delete(key);
+        }));
+    }).then(function () {
+        return self.clients.claim();
+    }));
+});
+
+self.addEventListener('fetch', function (event) {
+    if (event.request.method !== 'GET') return;
+    event.respondWith(caches.match(event.request, { ignoreSearch: true }).then(function (cached) {
+        if (cached) return cached;
+        return fetch(event.request).then(function (response) {
+            if (response.ok || response.type === 'opaque') {
+                var copy = response.clone();
+                caches.open(CACHE).then(function (cache) { cache.put(event.request, copy); });
+            }
+            return response;
+        });
+    }));
+});
+`
+
+// iconSVG is the home screen icon of an installed deck: the first letter of
+// its title
+const iconSVG = `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 512 512">
+  <rect width="512" height="512" rx="96" fill="#191919"/>
+  <text x="256" y="256" dy="0.35em" text-anchor="middle" font-family="system-ui, sans-serif" font-size="300" font-weight="bold" fill="#ffffff">%s</text>
+</svg>
+`
@@ -0,0 +1,111 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// ErrUpdateRejected is returned when an update contains invalid operations and
+// is therefore not saved
+var ErrUpdateRejected = errors.New("update rejected")
+
+// metadataKeys are the metadata fields an update_metadata operation may change,
+// in addition to custom fields addressed as "custom.<key>". Tags are given as
+// a comma-separated list.
+var metadataKeys = []string{"title", "subtitle", "author", "date", "theme", "tags", "event_date", "venue"}
+
+// OperationResult reports what happened to a single update operation
+type OperationResult struct {
+	Index     int    // Position of the operation in the update list (0-based)
+	Operation string // Operation type, e.g. "add_slide"
+	Applied   bool   // Whether the operation was applied
+	Reason    string // Why the operation was skipped
+}
+
+// CountApplied returns how many operations were applied
+func CountApplied(results []OperationResult) int {
+	count := 0
+	for _, result := range results {
+		if result.Applied {
+			count++
+		}
+	}
+	return count
+}
+
+// validateOperation checks that an update operation can be applied to data
+func (w *Writer) validateOperation(data *PresentationData, update types.PresentationUpdate) error {
+	count := int64(len(data.Slides))
+
+	switch update.Operation {
+	case "add_slide":
+		if update.Slide_index < 0 || update.Slide_index > count {
+			return fmt.Errorf("insert index %d is out of range (0-%d)", update.Slide_index, count)
+		}
+	case "modify_slide", "delete_slide":
+		if update.Slide_index < 0 || update.Slide_index >= count {
+			return fmt.Errorf("slide index %d is out of range (presentation has %d slides)", update.Slide_index, count)
+		}
+		if data.Slides[update.Slide_index].Locked {
+			return fmt.Errorf("slide %d is locked", update.Slide_index+1)
+		}
+	case "reorder_slides":
+		if int64(len(update.New_order)) != count {
+			return fmt.Errorf("new order has %d entries but presentation has %d slides", len(update.New_order), count)
+		}
+		seen := make(map[int64]bool, len(update.New_order))
+		for _, idx := range update.New_order {
+			if idx < 0 || idx >= count {
+				return fmt.Errorf("new order index %d is out of range", idx)
+			}
+			if seen[idx] {
+				return fmt.Errorf("new order lists slide %d more than once", idx)
+			}
+			seen[idx] = true
+		}
+	case "update_metadata":
+		if len(update.Metadata_updates) == 0 {
+			return fmt.Errorf("no metadata updates given")
+		}
+		for key := range update.Metadata_updates {
+			if !isMetadataKey(key) {
+				return fmt.Errorf("unknown metadata key %q", key)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown operation")
+	}
+
+	return nil
+}
+
+// ResolveSlideID points a modify_slide or delete_slide operation that names
+// its slide by ID at that slide's current index, which stays right when
+// earlier operations in the same update shift the slides
+func (data *PresentationData) ResolveSlideID(update types.PresentationUpdate) (types.PresentationUpdate, error) {
+	if update.Slide_id == "" || (update.Operation != "modify_slide" && update.Operation != "delete_slide") {
+		return update, nil
+	}
+	index := data.GetSlideIndex(update.Slide_id)
+	if index < 0 {
+		return update, fmt.Errorf("no slide has ID %q", update.Slide_id)
+	}
+	update.Slide_index = int64(index)
+	return update, nil
+}
+
+// isMetadataKey reports whether key names a metadata field that can be updated
+func isMetadataKey(key string) bool {
+	if name, ok := strings.CutPrefix(key, "custom."); ok {
+		return name != ""
+	}
+	for _, known := range metadataKeys {
+		if key == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+package presentation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// outlineItem matches a bullet or numbered item of an outline, capturing its
+// indent and text
+var outlineItem = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+(.*?)\s*$`)
+
+// OutlineSlide is a slide of a hand-written outline: a section title slide,
+// or a content slide with the points it should make
+type OutlineSlide struct {
+	Title   string
+	Section bool
+	// Points is the nested list of points under the slide, as Markdown
+	Points string
+}
+
+// Outline is the structure of a deck as written by hand. Its slides are
+// created exactly as listed; only their content and notes are written.
+type Outline struct {
+	Title    string
+	Subtitle string
+	Author   string
+	Date     string
+	Theme    string
+	Slides   []OutlineSlide
+}
+
+// ParseOutline reads a nested Markdown outline:
+//
+//	# Deck title
+//	Subtitle
+//
+//	## Section
+//	- Slide title
+//	  - A point to make
+//	    - A detail of the point
+//
+// A level one heading starts the deck with a title slide; every level two
+// (or deeper) heading is a section title slide, and a paragraph after a
+// heading is its subtitle. Every top-level list item is a slide, and the
+// items nested under it are its points. Front matter may set the title,
+// subtitle (description), author, date, and theme.
+func ParseOutline(text string) (*Outline, error) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	front, body := splitFrontMatter(text)
+
+	outline := &Outline{
+		Title:    front["title"],
+		Subtitle: front["subtitle"],
+		Author:   front["author"],
+		Date:     front["date"],
+		Theme:    front["theme"],
+	}
+	if outline.Subtitle == "" {
+		outline.Subtitle = front["description"]
+	}
+
+	var points []string
+	// pointIndent is the indent of the first point of the current slide,
+	// which the nesting of its points is made relative to
+	pointIndent := -1
+	// subtitle is true right after a heading, until a paragraph is read
+	subtitle := false
+
+	flush := func() {
+		if len(outline.Slides) > 0 && len(points) > 0 {
+			last := &outline.Slides[len(outline.Slides)-1]
+			last.Points = strings.Join(points, "\n")
+		}
+		points, pointIndent = nil, -1
+	}
+
+	fenced := false
+	for number, line := range strings.Split(body, "\n") {
+		if markdownFence.MatchString(line) {
+			fenced = !fenced
+		}
+		if fenced || markdownFence.MatchString(line) {
+			if len(outline.Slides) > 0 {
+				points = append(points, dedent(line, pointIndent))
+			}
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if m := markdownHeading.FindStringSubmatch(line); m != nil {
+			flush()
+			if len(m[1]) == 1 && len(outline.Slides) == 0 && outline.Title == "" {
+				outline.Title = m[2]
+			}
+			outline.Slides = append(outline.Slides, OutlineSlide{Title: m[2], Section: true})
+			subtitle = true
+			continue
+		}
+
+		m := outlineItem.FindStringSubmatch(line)
+		switch {
+		case m != nil && len(m[1]) == 0:
+			flush()
+			subtitle = false
+			outline.Slides = append(outline.Slides, OutlineSlide{Title: m[2]})
+		case subtitle && m == nil:
+			last := &outline.Slides[len(outline.Slides)-1]
+			last.Points = strings.TrimSpace(line)
+			if len(outline.Slides) == 1 && outline.Subtitle == "" {
+				outline.Subtitle = last.Points
+			}
+			subtitle = false
+		case len(outline.Slides) == 0 || outline.Slides[len(outline.Slides)-1].Section:
+			return nil, fmt.Errorf("line %d: %q is not under a slide; start slides with a top-level list item", number+1, strings.TrimSpace(line))
+		default:
+			if pointIndent < 0 {
+				pointIndent = indentOf(line)
+			}
+			points = append(points, dedent(line, pointIndent))
+		}
+	}
+	flush()
+
+	if len(outline.Slides) == 0 {
+		return nil, fmt.Errorf("no slides found; list them as top-level items")
+	}
+	if outline.Title == "" {
+		outline.Title = outline.Slides[0].Title
+	}
+	return outline, nil
+}
+
+// indentOf returns the width of the leading whitespace of a line
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
+
+// dedent removes up to indent characters of leading whitespace from a line
+func dedent(line string, indent int) string {
+	return line[min(max(indent, 0), indentOf(line)):]
+}
+
+// Skeleton returns the deck the outline describes, before its content is
+// written: section title slides and content slides holding their points
+func (o *Outline) Skeleton() types.Presentation {
+	pres := types.Presentation{
+		Title:    o.Title,
+		Subtitle: o.Subtitle,
+		Author:   o.Author,
+		Date:     o.Date,
+		Theme:    o.Theme,
+	}
+	for _, item := range o.Slides {
+		slide := types.Slide{Title: item.Title, Layout: "content", Content: item.Points}
+		if item.Section {
+			slide.Layout = "title"
+		}
+		pres.Slides = append(pres.Slides, slide)
+	}
+	return pres
+}
+
+// String renders the outline with each slide's index (0-based), as context
+// for writing its slides
+func (o *Outline) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Title: %s\n", o.Title)
+	if o.Subtitle != "" {
+		fmt.Fprintf(&sb, "Subtitle: %s\n", o.Subtitle)
+	}
+	for i, slide := range o.Slides {
+		kind := "slide"
+		if slide.Section {
+			kind = "section title slide"
+		}
+		fmt.Fprintf(&sb, "\nSlide %d (index %d, %s): %s\n", i+1, i, kind, slide.Title)
+		if slide.Points != "" {
+			sb.WriteString(slide.Points + "\n")
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,223 @@
+package presentation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// PassState records the progress of a per-slide AI pass, such as writing
+// speaker notes, so an interrupted pass can resume without redoing the slides
+// it already finished, and the slides it failed on can be retried
+type PassState struct {
+	Pass    string    `json:"pass"`
+	Started time.Time `json:"started"`
+	// Slides are the IDs of the slides the pass covers, in order
+	Slides []string `json:"slides"`
+	// Done are the IDs of the slides the pass has finished
+	Done []string `json:"done,omitempty"`
+	// Failed are the slides the pass could not finish, which were left
+	// unchanged
+	Failed []PassFailure `json:"failed,omitempty"`
+}
+
+// PassFailure records why a pass could not finish a slide
+type PassFailure struct {
+	Slide string `json:"slide"`
+	Error string `json:"error"`
+}
+
+// PassStatePath returns the progress file of a pass over the deck at path,
+// e.g. presentations/.pres/my-talk.notes.pass.json for presentations/my-talk.json
+func PassStatePath(path, pass string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return filepath.Join(filepath.Dir(path), AuditDir, name+"."+pass+".pass.json")
+}
+
+// newPassState starts a pass over the given slides
+func newPassState(pass string, slides []string) *PassState {
+	return &PassState{Pass: pass, Started: time.Now(), Slides: slides}
+}
+
+// StartPass starts a pass over the slides at the given indexes (0-based) of
+// the deck at path and saves its progress. The deck is saved first, so slides
+// that had no ID yet, such as those of a deck in the raw format, keep the IDs
+// the progress refers to.
+func (w *Writer) StartPass(path, pass string, indexes []int) (*PresentationData, *PassState, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]string, 0, len(indexes))
+	data.ensureSlideIDs()
+	for _, index := range indexes {
+		if index < 0 || index >= len(data.Slides) {
+			return nil, nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+		ids = append(ids, data.Slides[index].ID)
+	}
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, nil, err
+	}
+
+	state := newPassState(pass, ids)
+	if err := state.Save(path); err != nil {
+		return nil, nil, err
+	}
+	return data, state, nil
+}
+
+// LoadPassState returns the progress of an unfinished pass over the deck at
+// path, or nil when there is none
+func LoadPassState(path, pass string) (*PassState, error) {
+	data, err := os.ReadFile(PassStatePath(path, pass))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s progress: %w", pass, err)
+	}
+
+	var state PassState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid %s progress: %w", pass, err)
+	}
+	return &state, nil
+}
+
+// Save writes the progress of the pass over the deck at path
+func (s *PassState) Save(path string) error {
+	statePath := PassStatePath(path, s.Pass)
+	if err := os.MkdirAll(filepath.Dir(statePath), 0755); err != nil {
+		return fmt.Errorf("failed to create progress directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal progress: %w", err)
+	}
+	if err := os.WriteFile(statePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write progress: %w", err)
+	}
+	return nil
+}
+
+// Remove deletes the progress of the pass over the deck at path once the
+// pass has finished every slide
+func (s *PassState) Remove(path string) error {
+	if err := os.Remove(PassStatePath(path, s.Pass)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove progress: %w", err)
+	}
+	return nil
+}
+
+// IsDone reports whether the pass has finished the slide
+func (s *PassState) IsDone(id string) bool {
+	return slices.Contains(s.Done, id)
+}
+
+// MarkDone records that the pass has finished the slide
+func (s *PassState) MarkDone(id string) {
+	s.clearFailure(id)
+	if !s.IsDone(id) {
+		s.Done = append(s.Done, id)
+	}
+}
+
+// IsFailed reports whether the pass failed on the slide
+func (s *PassState) IsFailed(id string) bool {
+	return slices.ContainsFunc(s.Failed, func(f PassFailure) bool { return f.Slide == id })
+}
+
+// MarkFailed records that the pass could not finish the slide
+func (s *PassState) MarkFailed(id string, err error) {
+	s.clearFailure(id)
+	s.Failed = append(s.Failed, PassFailure{Slide: id, Error: err.Error()})
+}
+
+// RetryFailed returns the failed slides to the slides the pass has yet to
+// finish
+func (s *PassState) RetryFailed() {
+	s.Failed = nil
+}
+
+// clearFailure forgets a failure recorded for the slide
+func (s *PassState) clearFailure(id string) {
+	s.Failed = slices.DeleteFunc(s.Failed, func(f PassFailure) bool { return f.Slide == id })
+}
+
+// Remaining returns the IDs of the slides the pass has yet to finish, not
+// counting the ones it failed on
+func (s *PassState) Remaining() []string {
+	var remaining []string
+	for _, id := range s.Slides {
+		if !s.IsDone(id) && !s.IsFailed(id) {
+			remaining = append(remaining, id)
+		}
+	}
+	return remaining
+}
+
+// SetSlideNotes replaces the speaker notes of the slide with the given ID.
+// Locked slides may not be changed.
+func (w *Writer) SetSlideNotes(path, id, notes string) (*PresentationData, error) {
+	return w.modifySlide(path, id, func(slide *Slide) {
+		content := slide.Slide
+		content.Notes = strings.TrimSpace(notes)
+		w.setContent(slide, content)
+	})
+}
+
+// RewriteSlide replaces the generated content of the slide with the given ID,
+// keeping the fields pres manages, such as its ID, tags, and comments. Locked
+// slides and slides shared from other decks may not be changed.
+func (w *Writer) RewriteSlide(path, id string, content types.Slide) (*PresentationData, error) {
+	return w.modifySlide(path, id, func(slide *Slide) {
+		w.setContent(slide, content)
+	})
+}
+
+// modifySlide changes the slide with the given ID and saves the deck. The
+// change is audited as a slide modification, like the same change made by
+// pres update.
+func (w *Writer) modifySlide(path, id string, change func(slide *Slide)) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	index := data.GetSlideIndex(id)
+	if index < 0 {
+		return nil, fmt.Errorf("slide %s no longer exists", id)
+	}
+	if data.Slides[index].Locked {
+		return nil, fmt.Errorf("slide %d is locked", index+1)
+	}
+	if data.Slides[index].Ref != "" {
+		return nil, fmt.Errorf("slide %d is shared from %s; change it there", index+1, data.Slides[index].Ref)
+	}
+
+	change(&data.Slides[index])
+	data.Metadata.Modified = time.Now()
+	w.recordProvenance(&data.Metadata)
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	update := types.PresentationUpdate{Operation: "modify_slide", Slide_index: int64(index), New_slide: data.Slides[index].Slide}
+	if err := w.appendAudit(path, []types.PresentationUpdate{update}, []OperationResult{{Applied: true}}); err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,83 @@
+package presentation
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// DefaultMaxDeletes is how many slides a single update may delete unless
+// the policy allows more
+const DefaultMaxDeletes = 3
+
+// UpdatePolicy limits what the operations of an update may do. Operations
+// that break the policy are invalid, like an out-of-range slide index.
+type UpdatePolicy struct {
+	// MaxDeletes is the most slides an update may delete, or -1 for no limit
+	MaxDeletes int
+	// Metadata allows update_metadata operations
+	Metadata bool
+	// Protected are the IDs of slides that may not be modified or deleted
+	Protected []string
+}
+
+// metadataRequestPattern matches requests that explicitly ask for a metadata
+// change. Field names alone don't count, since "title" or "event" usually
+// mean a slide's title or what a slide is about.
+var metadataRequestPattern = regexp.MustCompile(`(?i)\b(metadata|custom fields?|(deck|presentation|talk)('s)? (title|subtitle|author|date|theme|tags|venue|event)|(change|set|update|replace|rename) (the )?(author|subtitle|theme|venue)|retitle (the )?(deck|presentation|talk))\b`)
+
+// RequestsMetadata reports whether an update request explicitly asks for a
+// metadata change, such as "the deck title" or "change the author", so the
+// update may change the deck's metadata
+func RequestsMetadata(request string) bool {
+	return metadataRequestPattern.MatchString(request)
+}
+
+// SetPolicy limits the operations of the updates the writer applies; nil
+// removes the limits
+func (w *Writer) SetPolicy(policy *UpdatePolicy) {
+	w.policy = policy
+}
+
+// checkPolicy reports why an operation breaks the writer's policy, given
+// the number of slides the update has deleted so far
+func (w *Writer) checkPolicy(data *PresentationData, update types.PresentationUpdate, deleted int) error {
+	if w.policy == nil {
+		return nil
+	}
+
+	switch update.Operation {
+	case "modify_slide", "delete_slide":
+		if slices.Contains(w.policy.Protected, data.Slides[update.Slide_index].ID) {
+			return fmt.Errorf("slide %d is protected", update.Slide_index+1)
+		}
+		if update.Operation == "delete_slide" && w.policy.MaxDeletes >= 0 && deleted >= w.policy.MaxDeletes {
+			return fmt.Errorf("policy allows deleting at most %d slides", w.policy.MaxDeletes)
+		}
+	case "update_metadata":
+		if !w.policy.Metadata {
+			return fmt.Errorf("metadata changes were not requested")
+		}
+	}
+
+	return nil
+}
+
+// EnsureSlideIDs assigns IDs to the slides of the presentation at path that
+// do not have one yet, saving it if any were assigned, and returns it. Slides
+// are protected by ID, so they stay protected as other slides move.
+func (w *Writer) EnsureSlideIDs(path string) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(data.Slides, func(slide Slide) bool { return slide.ID == "" }) {
+		return data, nil
+	}
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,491 @@
+package presentation
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// pptxNode is an element of a PowerPoint part, read without its namespace
+// so the parts can be walked in document order
+type pptxNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Nodes   []pptxNode `xml:",any"`
+	Text    string     `xml:",chardata"`
+}
+
+// child returns the first child element with the given local name
+func (n *pptxNode) child(name string) *pptxNode {
+	for i := range n.Nodes {
+		if n.Nodes[i].XMLName.Local == name {
+			return &n.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// find follows a path of child element names, returning nil when any of
+// them is missing
+func (n *pptxNode) find(names ...string) *pptxNode {
+	for _, name := range names {
+		if n == nil {
+			return nil
+		}
+		n = n.child(name)
+	}
+	return n
+}
+
+// attr returns the value of the attribute with the given local name
+func (n *pptxNode) attr(name string) string {
+	if n == nil {
+		return ""
+	}
+	for _, a := range n.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// relID returns the relationship ID an element refers to, its r:id
+// attribute, which slide lists set alongside a plain id
+func (n *pptxNode) relID() string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == "id" && a.Name.Space != "" {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// pptxPackage is an open PowerPoint file
+type pptxPackage struct {
+	files map[string]*zip.File
+}
+
+// part reads and parses the XML part at name
+func (p pptxPackage) part(name string) (*pptxNode, error) {
+	file, ok := p.files[name]
+	if !ok {
+		return nil, fmt.Errorf("missing part %s", name)
+	}
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var node pptxNode
+	if err := xml.Unmarshal(raw, &node); err != nil {
+		return nil, fmt.Errorf("invalid part %s: %w", name, err)
+	}
+	return &node, nil
+}
+
+// pptxRel is a relationship from a part to another, with the target
+// resolved to a part name
+type pptxRel struct {
+	id     string
+	kind   string
+	target string
+}
+
+// rels returns the relationships of the part at name to other parts of the
+// package
+func (p pptxPackage) rels(name string) []pptxRel {
+	node, err := p.part(path.Join(path.Dir(name), "_rels", path.Base(name)+".rels"))
+	if err != nil {
+		return nil
+	}
+	var rels []pptxRel
+	for _, rel := range node.Nodes {
+		if rel.attr("TargetMode") == "External" {
+			continue
+		}
+		target := rel.attr("Target")
+		if strings.HasPrefix(target, "/") {
+			target = strings.TrimPrefix(target, "/")
+		} else {
+			target = path.Join(path.Dir(name), target)
+		}
+		rels = append(rels, pptxRel{id: rel.attr("Id"), kind: path.Base(rel.attr("Type")), target: target})
+	}
+	return rels
+}
+
+// ParsePPTX reads a PowerPoint (.pptx) file as a new draft presentation. The
+// text of each slide's title placeholder becomes its title and the text of
+// its other shapes its content, with body text as bullets and tables as
+// Markdown tables; the notes page becomes the speaker notes. A slide with a
+// centered title or subtitle becomes a title slide, and one with two body
+// placeholders a two-column slide. Hidden slides stay hidden, and solid
+// background colors are kept.
+// Pictures, charts, and diagrams can't be carried over; the warnings list the
+// slides that had them.
+func ParsePPTX(content []byte) (*PresentationData, []string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("not a PowerPoint file: %w", err)
+	}
+	pkg := pptxPackage{files: map[string]*zip.File{}}
+	for _, file := range archive.File {
+		pkg.files[file.Name] = file
+	}
+
+	doc, err := pkg.part("ppt/presentation.xml")
+	if err != nil {
+		return nil, nil, fmt.Errorf("not a PowerPoint file: %w", err)
+	}
+	slideParts := map[string]string{}
+	for _, rel := range pkg.rels("ppt/presentation.xml") {
+		slideParts[rel.id] = rel.target
+	}
+
+	var warnings []string
+	pres := types.Presentation{Theme: "black"}
+	var hidden []bool
+	if list := doc.child("sldIdLst"); list != nil {
+		for _, id := range list.Nodes {
+			name, ok := slideParts[id.relID()]
+			if !ok {
+				continue
+			}
+			slide, isHidden, skipped, err := pkg.slide(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			number := len(pres.Slides) + 1
+			if len(skipped) > 0 {
+				warnings = append(warnings, fmt.Sprintf("slide %d: %s not imported", number, strings.Join(skipped, ", ")))
+			}
+			pres.Slides = append(pres.Slides, slide)
+			hidden = append(hidden, isHidden)
+		}
+	}
+	if len(pres.Slides) == 0 {
+		return nil, nil, fmt.Errorf("no slides found")
+	}
+
+	if core, err := pkg.part("docProps/core.xml"); err == nil {
+		for _, field := range core.Nodes {
+			value := strings.TrimSpace(field.Text)
+			switch field.XMLName.Local {
+			case "title":
+				pres.Title = value
+			case "subject":
+				pres.Subtitle = value
+			case "creator":
+				pres.Author = value
+			case "created":
+				pres.Date, _, _ = strings.Cut(value, "T")
+			}
+		}
+	}
+	if pres.Title == "" {
+		pres.Title = pres.Slides[0].Title
+	}
+	if pres.Subtitle == "" && pres.Slides[0].Layout == "title" {
+		pres.Subtitle, _, _ = strings.Cut(pres.Slides[0].Content, "\n")
+	}
+
+	data := NewPresentationData(&pres)
+	for i := range data.Slides {
+		data.Slides[i].Hidden = hidden[i]
+	}
+	return data, warnings, nil
+}
+
+// slide reads the slide part at name, reporting whether it is hidden and the
+// kinds of shapes that were skipped
+func (p pptxPackage) slide(name string) (types.Slide, bool, []string, error) {
+	var slide types.Slide
+	node, err := p.part(name)
+	if err != nil {
+		return slide, false, nil, err
+	}
+
+	var shapes pptxShapes
+	shapes.walk(node.find("cSld", "spTree"))
+
+	if fill := node.find("cSld", "bg", "bgPr", "solidFill", "srgbClr"); fill != nil {
+		slide.Background_color = "#" + fill.attr("val")
+	}
+
+	slide.Title = strings.Join(shapes.titles, " ")
+	var blocks []string
+	switch {
+	case shapes.centered || len(shapes.subtitles) > 0:
+		slide.Layout = "title"
+		blocks = slices.Concat(shapes.subtitles, shapes.bodies, shapes.other)
+	case len(shapes.bodies) == 2 && len(shapes.other) == 0:
+		slide.Layout = "two-column"
+		blocks = []string{shapes.bodies[0], "|||", shapes.bodies[1]}
+	default:
+		slide.Layout = "content"
+		blocks = slices.Concat(shapes.bodies, shapes.other)
+	}
+	slide.Content = strings.Join(blocks, "\n\n")
+	if slide.Title == "" && slide.Content == "" {
+		slide.Layout = "blank"
+	}
+
+	for _, rel := range p.rels(name) {
+		if rel.kind == "notesSlide" {
+			if page, err := p.part(rel.target); err == nil {
+				slide.Notes = pptxNotes(page.find("cSld", "spTree"))
+			}
+			break
+		}
+	}
+
+	var skipped []string
+	if shapes.pictures > 0 {
+		skipped = append(skipped, fmt.Sprintf("%d picture(s)", shapes.pictures))
+	}
+	if shapes.graphics > 0 {
+		skipped = append(skipped, fmt.Sprintf("%d chart(s) or diagram(s)", shapes.graphics))
+	}
+	return slide, node.attr("show") == "0", skipped, nil
+}
+
+// pptxShapes collects the text of a slide's shapes by their role
+type pptxShapes struct {
+	titles    []string
+	subtitles []string
+	bodies    []string
+	other     []string
+	centered  bool
+	pictures  int
+	graphics  int
+}
+
+// walk collects the shapes of a shape tree, descending into groups
+func (s *pptxShapes) walk(tree *pptxNode) {
+	if tree == nil {
+		return
+	}
+	for i := range tree.Nodes {
+		shape := &tree.Nodes[i]
+		switch shape.XMLName.Local {
+		case "grpSp":
+			s.walk(shape)
+		case "pic":
+			s.pictures++
+		case "graphicFrame":
+			if table := shape.find("graphic", "graphicData", "tbl"); table != nil {
+				if text := pptxTable(table); text != "" {
+					s.other = append(s.other, text)
+				}
+			} else {
+				s.graphics++
+			}
+		case "sp":
+			s.add(shape)
+		}
+	}
+}
+
+// add collects the text of a shape according to its placeholder type
+func (s *pptxShapes) add(shape *pptxNode) {
+	placeholder := shape.find("nvSpPr", "nvPr", "ph")
+	kind := placeholder.attr("type")
+	body := shape.child("txBody")
+	if body == nil {
+		return
+	}
+
+	switch {
+	case placeholder == nil:
+		if text := pptxText(body, false); text != "" {
+			s.other = append(s.other, text)
+		}
+	case kind == "title" || kind == "ctrTitle":
+		s.centered = s.centered || kind == "ctrTitle"
+		if text := pptxLine(body); text != "" {
+			s.titles = append(s.titles, text)
+		}
+	case kind == "subTitle":
+		if text := pptxText(body, false); text != "" {
+			s.subtitles = append(s.subtitles, text)
+		}
+	case kind == "" || kind == "body" || kind == "obj":
+		if text := pptxText(body, true); text != "" {
+			s.bodies = append(s.bodies, text)
+		}
+	}
+	// Date, footer, and slide number placeholders are left out
+}
+
+// pptxText renders the paragraphs of a text body as Markdown. Paragraphs of
+// body placeholders are bullets unless marked otherwise; those of other
+// shapes only when they have a bullet.
+func pptxText(body *pptxNode, bullets bool) string {
+	var lines []string
+	for i := range body.Nodes {
+		para := &body.Nodes[i]
+		if para.XMLName.Local != "p" {
+			continue
+		}
+		text := pptxRuns(para, true)
+		if text == "" {
+			continue
+		}
+
+		props := para.child("pPr")
+		marker := ""
+		switch {
+		case props != nil && props.child("buNone") != nil:
+		case props != nil && props.child("buAutoNum") != nil:
+			marker = "1. "
+		case bullets || (props != nil && props.child("buChar") != nil):
+			marker = "- "
+		}
+		if marker == "" {
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, text)
+			continue
+		}
+
+		level := 0
+		fmt.Sscan(props.attr("lvl"), &level)
+		if len(lines) > 0 && !pptxIsItem(lines[len(lines)-1]) {
+			lines = append(lines, "")
+		}
+		lines = append(lines, strings.Repeat("  ", level)+marker+text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// pptxIsItem reports whether a rendered line is a list item
+func pptxIsItem(line string) bool {
+	line = strings.TrimLeft(line, " ")
+	return strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "1. ")
+}
+
+// pptxLine renders the paragraphs of a text body as a single line of plain
+// text
+func pptxLine(body *pptxNode) string {
+	var parts []string
+	for i := range body.Nodes {
+		if body.Nodes[i].XMLName.Local == "p" {
+			if text := pptxRuns(&body.Nodes[i], false); text != "" {
+				parts = append(parts, text)
+			}
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// pptxRuns renders the text runs of a paragraph, with emphasis marking bold
+// and italic runs
+func pptxRuns(para *pptxNode, emphasis bool) string {
+	var sb strings.Builder
+	for i := range para.Nodes {
+		run := &para.Nodes[i]
+		switch run.XMLName.Local {
+		case "r", "fld":
+			text := run.find("t")
+			if text == nil || strings.TrimSpace(text.Text) == "" {
+				if text != nil {
+					sb.WriteString(text.Text)
+				}
+				continue
+			}
+			mark := ""
+			if props := run.child("rPr"); emphasis && props != nil {
+				if b := props.attr("b"); b == "1" || b == "true" {
+					mark += "**"
+				}
+				if it := props.attr("i"); it == "1" || it == "true" {
+					mark += "*"
+				}
+			}
+			// Keep the emphasis markers inside the spaces around the text
+			trimmed := strings.TrimSpace(text.Text)
+			lead := text.Text[:strings.Index(text.Text, trimmed)]
+			trail := text.Text[len(lead)+len(trimmed):]
+			sb.WriteString(lead + mark + trimmed + mark + trail)
+		case "br":
+			sb.WriteString(" ")
+		}
+	}
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
+
+// pptxTable renders a table as a Markdown table with its first row as the
+// header
+func pptxTable(table *pptxNode) string {
+	var rows [][]string
+	width := 0
+	for i := range table.Nodes {
+		row := &table.Nodes[i]
+		if row.XMLName.Local != "tr" {
+			continue
+		}
+		var cells []string
+		for j := range row.Nodes {
+			cell := &row.Nodes[j]
+			if cell.XMLName.Local != "tc" {
+				continue
+			}
+			text := ""
+			if body := cell.child("txBody"); body != nil {
+				text = strings.ReplaceAll(pptxLine(body), "|", `\|`)
+			}
+			cells = append(cells, text)
+		}
+		width = max(width, len(cells))
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 || width == 0 {
+		return ""
+	}
+
+	var lines []string
+	for i, cells := range rows {
+		for len(cells) < width {
+			cells = append(cells, "")
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", width))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// pptxNotes returns the text of the body placeholder of a notes page
+func pptxNotes(tree *pptxNode) string {
+	if tree == nil {
+		return ""
+	}
+	var parts []string
+	for i := range tree.Nodes {
+		shape := &tree.Nodes[i]
+		if shape.XMLName.Local != "sp" || shape.find("nvSpPr", "nvPr", "ph").attr("type") != "body" {
+			continue
+		}
+		if body := shape.child("txBody"); body != nil {
+			if text := pptxText(body, false); text != "" {
+				parts = append(parts, text)
+			}
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
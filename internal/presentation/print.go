@@ -0,0 +1,83 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PageBreak is a hint for how a slide is laid out when the deck is printed
+type PageBreak string
+
+const (
+	// PageBreakPage starts the slide on a new page (the default)
+	PageBreakPage PageBreak = "page"
+	// PageBreakContinue prints the slide below the previous one, on the same page
+	PageBreakContinue PageBreak = "continue"
+	// PageBreakSkip leaves the slide out of printouts
+	PageBreakSkip PageBreak = "skip"
+)
+
+// GetPageBreaks returns the known page-break hints
+func GetPageBreaks() []PageBreak {
+	return []PageBreak{
+		PageBreakPage,
+		PageBreakContinue,
+		PageBreakSkip,
+	}
+}
+
+// ParsePageBreak converts a string to a known page-break hint
+func ParsePageBreak(value string) (PageBreak, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, hint := range GetPageBreaks() {
+		if string(hint) == value {
+			return hint, nil
+		}
+	}
+
+	names := make([]string, 0, len(GetPageBreaks()))
+	for _, hint := range GetPageBreaks() {
+		names = append(names, string(hint))
+	}
+	return "", fmt.Errorf("unknown page break %q (expected one of: %s)", value, strings.Join(names, ", "))
+}
+
+// GetPageBreak returns the slide's page-break hint, treating slides without
+// one as starting a new page
+func (s *Slide) GetPageBreak() PageBreak {
+	if s.PageBreak == "" {
+		return PageBreakPage
+	}
+	return s.PageBreak
+}
+
+// SetPageBreak sets the print page-break hint of the slides at the given
+// indexes (0-based)
+func (w *Writer) SetPageBreak(path string, indexes []int, hint PageBreak) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		if index < 0 || index >= len(data.Slides) {
+			return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+	}
+
+	// The default is stored as no hint so decks stay minimal
+	if hint == PageBreakPage {
+		hint = ""
+	}
+	for _, index := range indexes {
+		data.Slides[index].PageBreak = hint
+	}
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
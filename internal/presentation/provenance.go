@@ -0,0 +1,42 @@
+package presentation
+
+import "time"
+
+// Provenance records one AI call that produced or changed a deck, so the
+// deck's content can be traced back to the model and prompts behind it
+type Provenance struct {
+	Timestamp time.Time `json:"timestamp"`
+	// Source is the pres command that made the call, e.g. "pres update"
+	Source string `json:"source"`
+	// Function is the BAML function that was called
+	Function string `json:"function"`
+	// Client is the BAML client that answered and Model the model it used
+	Client string `json:"client,omitempty"`
+	Model  string `json:"model,omitempty"`
+	// PromptVersion identifies the prompts pres was built with
+	PromptVersion string `json:"prompt_version,omitempty"`
+	InputTokens   int64  `json:"input_tokens,omitempty"`
+	OutputTokens  int64  `json:"output_tokens,omitempty"`
+}
+
+// AddProvenance queues provenance records to be stored in the metadata of
+// the next presentation the writer creates or updates
+func (w *Writer) AddProvenance(records ...Provenance) {
+	w.provenance = append(w.provenance, records...)
+}
+
+// recordProvenance moves the queued provenance records into the metadata
+func (w *Writer) recordProvenance(meta *Metadata) {
+	meta.Provenance = append(meta.Provenance, w.provenance...)
+	w.provenance = nil
+}
+
+// TotalTokens returns the input and output tokens used by all the AI calls
+// recorded for the deck
+func (m *Metadata) TotalTokens() (input, output int64) {
+	for _, record := range m.Provenance {
+		input += record.InputTokens
+		output += record.OutputTokens
+	}
+	return input, output
+}
@@ -0,0 +1,145 @@
+package presentation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrQuestionNotFound is returned when no question has the given ID
+var ErrQuestionNotFound = errors.New("question not found")
+
+// Question is a question submitted by the audience while presenting
+type Question struct {
+	ID       string    `json:"id"`
+	Text     string    `json:"text"`
+	Author   string    `json:"author,omitempty"`
+	Created  time.Time `json:"created"`
+	Answered bool      `json:"answered"`
+}
+
+// QuestionsPath returns the questions file for the deck at path, e.g.
+// presentations/.pres/my-talk.questions.json for presentations/my-talk.json
+func QuestionsPath(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return filepath.Join(filepath.Dir(path), AuditDir, name+".questions.json")
+}
+
+// ReadQuestions returns the questions asked about the deck at path, oldest
+// first. A deck without a questions file has no questions.
+func ReadQuestions(path string) ([]Question, error) {
+	raw, err := os.ReadFile(QuestionsPath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read questions: %w", err)
+	}
+
+	var questions []Question
+	if err := json.Unmarshal(raw, &questions); err != nil {
+		return nil, fmt.Errorf("invalid questions file: %w", err)
+	}
+	return questions, nil
+}
+
+// writeQuestions replaces the questions file for the deck at path
+func writeQuestions(path string, questions []Question) error {
+	file := QuestionsPath(path)
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return fmt.Errorf("failed to create questions directory: %w", err)
+	}
+
+	raw, err := json.MarshalIndent(questions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode questions: %w", err)
+	}
+	if err := os.WriteFile(file, raw, 0644); err != nil {
+		return fmt.Errorf("failed to write questions: %w", err)
+	}
+	return nil
+}
+
+// AddQuestion records a new question about the deck at path
+func AddQuestion(path, text, author string) (*Question, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, fmt.Errorf("question text cannot be empty")
+	}
+
+	questions, err := ReadQuestions(path)
+	if err != nil {
+		return nil, err
+	}
+
+	question := Question{
+		ID:      newCommentID(),
+		Text:    text,
+		Author:  strings.TrimSpace(author),
+		Created: time.Now(),
+	}
+	questions = append(questions, question)
+
+	if err := writeQuestions(path, questions); err != nil {
+		return nil, err
+	}
+	return &question, nil
+}
+
+// SetQuestionAnswered marks the question with the given ID as answered or not
+func SetQuestionAnswered(path, id string, answered bool) error {
+	questions, err := ReadQuestions(path)
+	if err != nil {
+		return err
+	}
+
+	for i := range questions {
+		if questions[i].ID == id {
+			questions[i].Answered = answered
+			return writeQuestions(path, questions)
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrQuestionNotFound, id)
+}
+
+// AddFollowUpSlide appends a "Follow-ups" slide listing the questions to the
+// deck at path
+func (w *Writer) AddFollowUpSlide(path string, questions []Question) (*PresentationData, error) {
+	if len(questions) == 0 {
+		return nil, fmt.Errorf("there are no questions to add")
+	}
+
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	for _, question := range questions {
+		fmt.Fprintf(&sb, "- %s", strings.Join(strings.Fields(question.Text), " "))
+		if question.Author != "" {
+			fmt.Fprintf(&sb, " (%s)", question.Author)
+		}
+		sb.WriteString("\n")
+	}
+
+	slide := Slide{ID: newSlideID()}
+	slide.Title = "Follow-ups"
+	slide.Layout = "content"
+	slide.Content = strings.TrimRight(sb.String(), "\n")
+	slide.Notes = fmt.Sprintf("Questions from the audience, collected on %s.", questions[0].Created.Format("2006-01-02"))
+
+	data.Slides = append(data.Slides, slide)
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
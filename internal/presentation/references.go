@@ -0,0 +1,125 @@
+package presentation
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/geoffjay/pres/internal/platform"
+)
+
+// maxReferenceDepth bounds how many references are followed for one slide
+const maxReferenceDepth = 8
+
+// ParseReference splits a slide reference of the form "deck.json#slide-id".
+// An empty deck path refers to the deck containing the reference.
+func ParseReference(ref string) (deckPath, slideID string, err error) {
+	deckPath, slideID, ok := strings.Cut(ref, "#")
+	if !ok || slideID == "" {
+		return "", "", fmt.Errorf("invalid slide reference %q: expected <deck.json>#<slide-id>", ref)
+	}
+	return deckPath, slideID, nil
+}
+
+// ResolveReferences returns a copy of data in which every slide that references
+// another deck's slide carries the referenced content. Reference paths are
+// relative to the directory of sourcePath, the file data was loaded from.
+func (g *Generator) ResolveReferences(data *PresentationData, sourcePath string) (*PresentationData, error) {
+	resolver := &referenceResolver{
+		writer: NewWriter("."),
+		decks:  map[string]*PresentationData{},
+	}
+
+	source, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path %s: %w", sourcePath, err)
+	}
+	resolver.decks[source] = data
+
+	resolved := *data
+	resolved.Slides = make([]Slide, len(data.Slides))
+	for i, slide := range data.Slides {
+		if slide.Ref != "" {
+			target, err := resolver.resolve(source, slide.Ref, 0)
+			if err != nil {
+				return nil, fmt.Errorf("slide %d: %w", i+1, err)
+			}
+			// Keep the local identity and annotations, take the shared content
+			slide.Slide = target.Slide
+			if len(slide.Tags) == 0 {
+				slide.Tags = target.Tags
+			}
+		}
+		resolved.Slides[i] = slide
+	}
+
+	return &resolved, nil
+}
+
+// referenceResolver loads referenced decks once and follows chains of references
+type referenceResolver struct {
+	writer *Writer
+	decks  map[string]*PresentationData
+}
+
+// resolve finds the slide ref points to, relative to the deck at from
+func (r *referenceResolver) resolve(from, ref string, depth int) (Slide, error) {
+	if depth >= maxReferenceDepth {
+		return Slide{}, fmt.Errorf("reference %q is nested too deeply (possible cycle)", ref)
+	}
+
+	deckPath, slideID, err := ParseReference(ref)
+	if err != nil {
+		return Slide{}, err
+	}
+
+	target := from
+	if deckPath != "" {
+		target = filepath.Join(filepath.Dir(from), platform.LocalPath(deckPath))
+	}
+
+	deck, ok := r.decks[target]
+	if !ok {
+		deck, err = r.writer.LoadPresentation(target)
+		if err != nil {
+			return Slide{}, fmt.Errorf("failed to load referenced deck %s: %w", deckPath, err)
+		}
+		r.decks[target] = deck
+	}
+
+	index := deck.GetSlideIndex(slideID)
+	if index < 0 {
+		return Slide{}, fmt.Errorf("reference %q: no slide with id %s", ref, slideID)
+	}
+
+	slide := deck.Slides[index]
+	if slide.Ref != "" {
+		return r.resolve(target, slide.Ref, depth+1)
+	}
+
+	return slide, nil
+}
+
+// ReferencesDeck reports whether any slide of data, loaded from sourcePath,
+// references a slide in the deck at target
+func (data *PresentationData) ReferencesDeck(sourcePath, target string) bool {
+	source, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return false
+	}
+	target, err = filepath.Abs(target)
+	if err != nil || source == target {
+		return false
+	}
+
+	for _, slide := range data.Slides {
+		deckPath, _, err := ParseReference(slide.Ref)
+		if slide.Ref == "" || err != nil || deckPath == "" {
+			continue
+		}
+		if filepath.Join(filepath.Dir(source), platform.LocalPath(deckPath)) == target {
+			return true
+		}
+	}
+	return false
+}
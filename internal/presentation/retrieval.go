@@ -0,0 +1,190 @@
+package presentation
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultRelevantSlides is how many slides keyword retrieval picks for a
+// request
+const DefaultRelevantSlides = 5
+
+// RetrievalMinSlides is the deck size from which update requests are sent
+// with only their relevant slides in full
+const RetrievalMinSlides = 15
+
+// BM25 parameters for scoring slides against a request
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// relevanceCutoff drops matches scoring below this fraction of the best one
+const relevanceCutoff = 0.25
+
+// Field weights: a word in a slide title says more about what the slide is
+// about than one in its notes
+var fieldWeights = struct {
+	title, tags, content, notes float64
+}{3, 2, 1, 0.5}
+
+// stopWords are left out of the index, including words every update request
+// uses that say nothing about which slides it is about
+var stopWords = map[string]bool{
+	"a": true, "about": true, "add": true, "after": true, "all": true, "an": true, "and": true, "any": true,
+	"are": true, "as": true, "at": true, "be": true, "before": true, "but": true, "by": true, "can": true,
+	"change": true, "deck": true, "do": true, "for": true, "from": true, "has": true, "have": true, "how": true,
+	"in": true, "into": true, "is": true, "it": true, "its": true, "make": true, "more": true, "of": true,
+	"on": true, "one": true, "or": true, "our": true, "please": true, "presentation": true, "slide": true,
+	"so": true, "some": true, "than": true, "that": true, "the": true, "their": true, "them": true, "then": true,
+	"there": true, "these": true, "this": true, "to": true, "update": true, "use": true, "was": true, "we": true,
+	"what": true, "when": true, "which": true, "with": true, "you": true, "your": true,
+}
+
+// SlideMatch is a slide found relevant to a request
+type SlideMatch struct {
+	Index int
+	Score float64
+}
+
+// SlideIndex is a keyword index over the slides of a deck, for finding the
+// slides a request is about
+type SlideIndex struct {
+	terms     []map[string]float64
+	lengths   []float64
+	avgLength float64
+	frequency map[string]int
+}
+
+// NewSlideIndex indexes the titles, tags, content, and notes of the slides
+// in data
+func NewSlideIndex(data *PresentationData) *SlideIndex {
+	index := &SlideIndex{
+		terms:     make([]map[string]float64, len(data.Slides)),
+		lengths:   make([]float64, len(data.Slides)),
+		frequency: make(map[string]int),
+	}
+
+	total := 0.0
+	for i, slide := range data.Slides {
+		terms := make(map[string]float64)
+		add := func(text string, weight float64) {
+			for _, term := range tokenize(text) {
+				terms[term] += weight
+				index.lengths[i] += weight
+			}
+		}
+		add(slide.Title, fieldWeights.title)
+		add(strings.Join(slide.Tags, " "), fieldWeights.tags)
+		add(slide.Content, fieldWeights.content)
+		add(slide.Notes, fieldWeights.notes)
+
+		for term := range terms {
+			index.frequency[term]++
+		}
+		index.terms[i] = terms
+		total += index.lengths[i]
+	}
+	if len(data.Slides) > 0 {
+		index.avgLength = total / float64(len(data.Slides))
+	}
+
+	return index
+}
+
+// Search returns up to limit slides matching query, best first. Slides
+// scoring far below the best match are left out.
+func (idx *SlideIndex) Search(query string, limit int) []SlideMatch {
+	queryTerms := tokenize(query)
+	slices.Sort(queryTerms)
+	queryTerms = slices.Compact(queryTerms)
+
+	count := float64(len(idx.terms))
+	var matches []SlideMatch
+	for i, terms := range idx.terms {
+		score := 0.0
+		for _, term := range queryTerms {
+			tf := terms[term]
+			if tf == 0 {
+				continue
+			}
+			df := float64(idx.frequency[term])
+			idf := math.Log(1 + (count-df+0.5)/(df+0.5))
+			norm := 1 - bm25B
+			if idx.avgLength > 0 {
+				norm += bm25B * idx.lengths[i] / idx.avgLength
+			}
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+		}
+		if score > 0 {
+			matches = append(matches, SlideMatch{Index: i, Score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if len(matches) > 0 {
+		cutoff := matches[0].Score * relevanceCutoff
+		end := len(matches)
+		for end > 0 && matches[end-1].Score < cutoff {
+			end--
+		}
+		matches = matches[:end]
+	}
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// RelevantSlides returns the slides a request is about, as sorted 0-based
+// indexes: those it mentions by number, and up to limit more found by
+// keyword search
+func (data *PresentationData) RelevantSlides(request string, limit int) []int {
+	var indexes []int
+	for _, index := range MentionedSlides(request) {
+		if index < len(data.Slides) {
+			indexes = append(indexes, index)
+		}
+	}
+	if limit > 0 {
+		for _, match := range NewSlideIndex(data).Search(request, limit) {
+			indexes = append(indexes, match.Index)
+		}
+	}
+	slices.Sort(indexes)
+	return slices.Compact(indexes)
+}
+
+// tokenize splits text into lowercase index terms, leaving out stop words
+// and single characters, and folding simple plurals
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if len([]rune(word)) < 2 || stopWords[word] {
+			continue
+		}
+		terms = append(terms, stem(word))
+	}
+	return terms
+}
+
+// stem folds the common English plural endings, so "goroutines" matches
+// "goroutine"
+func stem(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
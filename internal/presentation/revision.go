@@ -0,0 +1,103 @@
+package presentation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// maxSlideRevisions is how many earlier versions of a slide are kept
+const maxSlideRevisions = 20
+
+// SlideRevision is an earlier version of a slide's generated content, kept in
+// the deck so one slide can be reverted without rolling back the others.
+// Revisions are numbered from 1 and keep their number as old ones are
+// dropped.
+type SlideRevision struct {
+	Revision int `json:"revision"`
+	// Replaced is when the revision was replaced, and Source the command that
+	// replaced it
+	Replaced time.Time   `json:"replaced"`
+	Source   string      `json:"source,omitempty"`
+	Slide    types.Slide `json:"slide"`
+}
+
+// CurrentRevision returns the revision number of the slide's current content
+func (s *Slide) CurrentRevision() int {
+	if len(s.History) == 0 {
+		return 1
+	}
+	return s.History[len(s.History)-1].Revision + 1
+}
+
+// Revision returns the slide's content at the given revision
+func (s *Slide) Revision(number int) (types.Slide, error) {
+	if number == s.CurrentRevision() {
+		return s.Slide, nil
+	}
+	numbers := make([]string, 0, len(s.History)+1)
+	for _, revision := range s.History {
+		if revision.Revision == number {
+			return revision.Slide, nil
+		}
+		numbers = append(numbers, strconv.Itoa(revision.Revision))
+	}
+	numbers = append(numbers, strconv.Itoa(s.CurrentRevision()))
+	return types.Slide{}, fmt.Errorf("no revision %d (revisions: %s)", number, strings.Join(numbers, ", "))
+}
+
+// setContent replaces the generated content of a slide, keeping the content
+// it replaces as a revision. Setting the same content records nothing.
+func (w *Writer) setContent(slide *Slide, content types.Slide) {
+	if slide.Slide == content {
+		return
+	}
+
+	source := w.auditSource
+	if source == "" {
+		source = "unknown"
+	}
+	slide.History = append(slide.History, SlideRevision{
+		Revision: slide.CurrentRevision(),
+		Replaced: time.Now(),
+		Source:   source,
+		Slide:    slide.Slide,
+	})
+	if extra := len(slide.History) - maxSlideRevisions; extra > 0 {
+		slide.History = append([]SlideRevision(nil), slide.History[extra:]...)
+	}
+	slide.Slide = content
+}
+
+// RevertSlide restores the slide with the given ID to an earlier revision.
+// The content it replaces is kept as a new revision, so the revert can be
+// reverted too. Locked slides may not be changed.
+func (w *Writer) RevertSlide(path, id string, revision int) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+	index := data.GetSlideIndex(id)
+	if index < 0 {
+		return nil, fmt.Errorf("slide %s no longer exists", id)
+	}
+
+	slide := &data.Slides[index]
+	if revision == slide.CurrentRevision() {
+		return nil, fmt.Errorf("slide %d is already at revision %d", index+1, revision)
+	}
+	content, err := slide.Revision(revision)
+	if err != nil {
+		return nil, fmt.Errorf("slide %d has %w", index+1, err)
+	}
+	if content == slide.Slide {
+		return nil, fmt.Errorf("slide %d already has the content of revision %d", index+1, revision)
+	}
+
+	return w.modifySlide(path, id, func(slide *Slide) {
+		w.setContent(slide, content)
+	})
+}
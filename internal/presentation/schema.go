@@ -0,0 +1,143 @@
+package presentation
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// schemaURL identifies the presentation schema
+const schemaURL = "https://github.com/geoffjay/pres/presentation.schema.json"
+
+// strictSchemaURL identifies the strict variant of the presentation schema
+const strictSchemaURL = "https://github.com/geoffjay/pres/presentation.strict.schema.json"
+
+// strictRequired lists the fields that become required in strict mode, by definition name
+var strictRequired = map[string][]string{
+	"metadata": {"title", "theme", "created", "modified"},
+	"slide":    {"id"},
+}
+
+//go:embed schema.json
+var schemaJSON []byte
+
+var (
+	compiledSchemas     map[bool]*jsonschema.Schema
+	compiledSchemasErr  error
+	compiledSchemasOnce sync.Once
+)
+
+// GetSchema returns the JSON Schema describing the stored presentation format
+func GetSchema() []byte {
+	return schemaJSON
+}
+
+// ValidateJSON checks a stored presentation against the JSON Schema. In strict
+// mode unknown keys are rejected and more fields are required.
+func ValidateJSON(jsonData []byte, strict bool) error {
+	compiledSchemasOnce.Do(compileSchemas)
+	if compiledSchemasErr != nil {
+		return compiledSchemasErr
+	}
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	if err := compiledSchemas[strict].Validate(doc); err != nil {
+		return fmt.Errorf("presentation does not match schema: %w", err)
+	}
+
+	return nil
+}
+
+// compileSchemas compiles the embedded schema and its strict variant
+func compileSchemas() {
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat()
+
+	for _, strict := range []bool{false, true} {
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaJSON))
+		if err != nil {
+			compiledSchemasErr = fmt.Errorf("failed to parse schema: %w", err)
+			return
+		}
+
+		url := schemaURL
+		if strict {
+			url = strictSchemaURL
+			if err := makeStrict(doc); err != nil {
+				compiledSchemasErr = err
+				return
+			}
+		}
+
+		if err := compiler.AddResource(url, doc); err != nil {
+			compiledSchemasErr = fmt.Errorf("failed to load schema: %w", err)
+			return
+		}
+	}
+
+	compiledSchemas = map[bool]*jsonschema.Schema{}
+	for strict, url := range map[bool]string{false: schemaURL, true: strictSchemaURL} {
+		schema, err := compiler.Compile(url)
+		if err != nil {
+			compiledSchemasErr = fmt.Errorf("failed to compile schema: %w", err)
+			return
+		}
+		compiledSchemas[strict] = schema
+	}
+}
+
+// makeStrict rewrites a parsed schema document so that objects reject unknown
+// properties and the fields in strictRequired must be present
+func makeStrict(doc any) error {
+	root, ok := doc.(map[string]any)
+	if !ok {
+		return fmt.Errorf("schema is not an object")
+	}
+	root["$id"] = strictSchemaURL
+
+	defs, _ := root["$defs"].(map[string]any)
+	for name, fields := range strictRequired {
+		def, ok := defs[name].(map[string]any)
+		if !ok {
+			return fmt.Errorf("schema has no definition %q", name)
+		}
+		required, _ := def["required"].([]any)
+		for _, field := range fields {
+			if !slices.Contains(required, any(field)) {
+				required = append(required, field)
+			}
+		}
+		def["required"] = required
+	}
+
+	closeObjects(root)
+	return nil
+}
+
+// closeObjects disallows additional properties on every object schema that
+// declares its properties and does not say otherwise
+func closeObjects(node any) {
+	switch node := node.(type) {
+	case map[string]any:
+		if _, ok := node["properties"]; ok {
+			if _, set := node["additionalProperties"]; !set {
+				node["additionalProperties"] = false
+			}
+		}
+		for _, child := range node {
+			closeObjects(child)
+		}
+	case []any:
+		for _, child := range node {
+			closeObjects(child)
+		}
+	}
+}
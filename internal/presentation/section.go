@@ -0,0 +1,158 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// Section is a run of slides started by a title slide, up to the next title
+// slide. Start and End are the indexes (0-based) of its first slide and of
+// the slide just past its last.
+type Section struct {
+	Title string
+	Start int
+	End   int
+}
+
+// Sections returns the sections of the deck, in order. Slides before the
+// first title slide are not in any section.
+func (data *PresentationData) Sections() []Section {
+	var sections []Section
+	for i, slide := range data.Slides {
+		if slide.Layout != "title" {
+			continue
+		}
+		if len(sections) > 0 {
+			sections[len(sections)-1].End = i
+		}
+		sections = append(sections, Section{Title: slide.Title, Start: i, End: len(data.Slides)})
+	}
+	return sections
+}
+
+// FindSection returns the section whose title slide has the given title,
+// ignoring case
+func (data *PresentationData) FindSection(title string) (Section, error) {
+	var found []Section
+	var titles []string
+	for _, section := range data.Sections() {
+		if strings.EqualFold(strings.TrimSpace(section.Title), strings.TrimSpace(title)) {
+			found = append(found, section)
+		}
+		titles = append(titles, fmt.Sprintf("%q", section.Title))
+	}
+
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		if len(titles) == 0 {
+			return Section{}, fmt.Errorf("no section %q: the deck has no title slides to divide it into sections", title)
+		}
+		return Section{}, fmt.Errorf("no section %q (sections: %s)", title, strings.Join(titles, ", "))
+	default:
+		return Section{}, fmt.Errorf("%d sections are titled %q (at slides %d and %d); retitle one", len(found), title, found[0].Start+1, found[1].Start+1)
+	}
+}
+
+// Outline lists the slides of a section, one per line with their layout, as
+// the structure to regenerate it from
+func (data *PresentationData) Outline(section Section) string {
+	var sb strings.Builder
+	for i := section.Start; i < section.End; i++ {
+		slide := data.Slides[i]
+		title := slide.Title
+		if title == "" {
+			title = "(untitled)"
+		}
+		fmt.Fprintf(&sb, "%d. %s (%s)\n", i-section.Start+1, title, layoutOrDefault(slide.Layout))
+	}
+	return sb.String()
+}
+
+// layoutOrDefault returns the layout of a slide, which is content when unset
+func layoutOrDefault(layout string) string {
+	if layout == "" {
+		return "content"
+	}
+	return layout
+}
+
+// CheckSection returns an error when the section holds slides that can't be
+// regenerated: locked slides and slides shared from other decks
+func (data *PresentationData) CheckSection(section Section) error {
+	for i := section.Start; i < section.End; i++ {
+		slide := data.Slides[i]
+		if slide.Locked {
+			return fmt.Errorf("slide %d in section %q is locked", i+1, section.Title)
+		}
+		if slide.Ref != "" {
+			return fmt.Errorf("slide %d in section %q is shared from %s", i+1, section.Title, slide.Ref)
+		}
+	}
+	return nil
+}
+
+// ReplaceSection replaces the slides of the section with the given title by
+// new ones, leaving every other slide as it is. Slides that keep their
+// position in the section keep their ID, tags, and other fields pres
+// manages; extra slides are added after them and missing ones deleted. The
+// changes are audited as the slide operations they amount to.
+func (w *Writer) ReplaceSection(path, title string, slides []types.Slide) (*PresentationData, error) {
+	if len(slides) == 0 {
+		return nil, fmt.Errorf("a section needs at least one slide")
+	}
+
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+	section, err := data.FindSection(title)
+	if err != nil {
+		return nil, err
+	}
+	if err := data.CheckSection(section); err != nil {
+		return nil, err
+	}
+
+	old := data.Slides[section.Start:section.End]
+	replaced := make([]Slide, 0, len(slides))
+	var updates []types.PresentationUpdate
+	for i, content := range slides {
+		index := int64(section.Start + i)
+		if i < len(old) {
+			slide := old[i]
+			w.setContent(&slide, content)
+			replaced = append(replaced, slide)
+			updates = append(updates, types.PresentationUpdate{Operation: "modify_slide", Slide_index: index, New_slide: content})
+			continue
+		}
+		replaced = append(replaced, newSlides([]types.Slide{content})...)
+		updates = append(updates, types.PresentationUpdate{Operation: "add_slide", Slide_index: index, New_slide: content})
+	}
+	for range len(old) - len(replaced) {
+		updates = append(updates, types.PresentationUpdate{Operation: "delete_slide", Slide_index: int64(section.Start + len(replaced))})
+	}
+
+	rest := data.Slides[section.End:]
+	data.Slides = append(append(data.Slides[:section.Start:section.Start], replaced...), rest...)
+	data.Metadata.Modified = time.Now()
+	w.recordProvenance(&data.Metadata)
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	results := make([]OperationResult, len(updates))
+	for i := range results {
+		results[i].Applied = true
+	}
+	if err := w.appendAudit(path, updates, results); err != nil {
+		return data, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,140 @@
+package presentation
+
+import (
+	"crypto/rand"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/baml_client/types"
+)
+
+// Slide is a stored slide: the generated content plus the fields pres
+// manages itself, which are never produced or changed by the AI
+type Slide struct {
+	types.Slide
+	ID        string    `json:"id,omitempty"`
+	Ref       string    `json:"ref,omitempty"`
+	Hidden    bool      `json:"hidden,omitempty"`
+	Locked    bool      `json:"locked,omitempty"`
+	When      string    `json:"when,omitempty"`
+	PageBreak PageBreak `json:"page_break,omitempty"`
+	Audio     string    `json:"audio,omitempty"`
+	Image     string    `json:"image,omitempty"`
+	Tags      []string  `json:"tags,omitempty"`
+	Comments  []Comment `json:"comments,omitempty"`
+
+	// TimeBudgetSeconds is how long the slide should take to present; when
+	// unset it is estimated from the speaker notes
+	TimeBudgetSeconds int `json:"time_budget_seconds,omitempty"`
+
+	// History holds earlier versions of the generated content, oldest first
+	History []SlideRevision `json:"history,omitempty"`
+
+	// Footnotes are collected from the content when preparing output
+	Footnotes []Footnote `json:"-"`
+}
+
+// newSlides wraps generated slides for storage
+func newSlides(slides []types.Slide) []Slide {
+	result := make([]Slide, 0, len(slides))
+	for _, slide := range slides {
+		result = append(result, Slide{Slide: slide, ID: newSlideID()})
+	}
+	return result
+}
+
+// ensureSlideIDs assigns an ID to every slide that does not have one yet
+func (data *PresentationData) ensureSlideIDs() {
+	for i := range data.Slides {
+		if data.Slides[i].ID == "" {
+			data.Slides[i].ID = newSlideID()
+		}
+	}
+}
+
+// Clone returns a copy of the presentation that can be modified without
+// affecting the original
+func (data *PresentationData) Clone() *PresentationData {
+	clone := *data
+	clone.Metadata.Tags = append([]string(nil), data.Metadata.Tags...)
+	clone.Metadata.Custom = maps.Clone(data.Metadata.Custom)
+	clone.Metadata.Variables = maps.Clone(data.Metadata.Variables)
+	clone.Metadata.Sources = append([]DataSource(nil), data.Metadata.Sources...)
+	clone.Metadata.Provenance = append([]Provenance(nil), data.Metadata.Provenance...)
+	clone.Slides = make([]Slide, len(data.Slides))
+	for i, slide := range data.Slides {
+		slide.Tags = append([]string(nil), slide.Tags...)
+		slide.Comments = append([]Comment(nil), slide.Comments...)
+		slide.Footnotes = append([]Footnote(nil), slide.Footnotes...)
+		slide.History = append([]SlideRevision(nil), slide.History...)
+		clone.Slides[i] = slide
+	}
+	return &clone
+}
+
+// GetSlideIndex returns the index of the slide with the given ID, or -1
+func (data *PresentationData) GetSlideIndex(id string) int {
+	for i, slide := range data.Slides {
+		if slide.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// newSlideID generates a random (version 4) UUID for a slide
+func newSlideID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// GetSlideSummary generates a text summary of a single slide (0-based index)
+func (data *PresentationData) GetSlideSummary(index int) string {
+	if index < 0 || index >= len(data.Slides) {
+		return ""
+	}
+
+	slide := data.Slides[index]
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Slide %d (index %d)\n", index+1, index)
+	if slide.ID != "" {
+		fmt.Fprintf(&sb, "ID: %s\n", slide.ID)
+	}
+	if slide.Ref != "" {
+		fmt.Fprintf(&sb, "Reference: %s (content is maintained in another deck)\n", slide.Ref)
+	}
+	if slide.When != "" {
+		fmt.Fprintf(&sb, "Shown when: %s\n", slide.When)
+	}
+	if slide.Hidden {
+		fmt.Fprintf(&sb, "Hidden: yes (backup slide shown after the main flow)\n")
+	}
+	if slide.Locked {
+		fmt.Fprintf(&sb, "Locked: yes (must not be modified or deleted)\n")
+	}
+	if slide.TimeBudgetSeconds > 0 {
+		fmt.Fprintf(&sb, "Time budget: %s\n", FormatClock(slide.GetTimeBudget()))
+	}
+	if slide.PageBreak != "" {
+		fmt.Fprintf(&sb, "Page break: %s (when printed)\n", slide.PageBreak)
+	}
+	fmt.Fprintf(&sb, "Title: %s\n", slide.Title)
+	fmt.Fprintf(&sb, "Layout: %s\n", slide.Layout)
+	fmt.Fprintf(&sb, "Content:\n%s\n", slide.Content)
+	if slide.Image_prompt != "" {
+		fmt.Fprintf(&sb, "Image prompt: %s\n", slide.Image_prompt)
+	}
+	if slide.Notes != "" {
+		fmt.Fprintf(&sb, "Notes:\n%s\n", slide.Notes)
+	}
+
+	return sb.String()
+}
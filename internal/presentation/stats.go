@@ -0,0 +1,100 @@
+package presentation
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/geoffjay/pres/internal/platform"
+)
+
+// TagCount is the number of presentations carrying a tag
+type TagCount struct {
+	Tag   string
+	Count int
+}
+
+// ReuseCount is the number of slides referencing a shared slide
+type ReuseCount struct {
+	Ref   string
+	Count int
+}
+
+// LibraryStats aggregates statistics across a set of presentations
+type LibraryStats struct {
+	Decks         int
+	Slides        int
+	AverageSlides float64
+	Tags          []TagCount
+	SharedSlides  []ReuseCount
+	Recent        []CatalogEntry
+}
+
+// maxRecentEntries is how many recently modified presentations are reported
+const maxRecentEntries = 10
+
+// GetLibraryStats computes statistics for the scanned presentations
+func GetLibraryStats(entries []CatalogEntry) LibraryStats {
+	stats := LibraryStats{Decks: len(entries)}
+
+	tags := map[string]int{}
+	refs := map[string]int{}
+	for _, entry := range entries {
+		stats.Slides += len(entry.Data.Slides)
+		for _, tag := range entry.Data.Metadata.Tags {
+			tags[tag]++
+		}
+		for _, slide := range entry.Data.Slides {
+			if slide.Ref == "" {
+				continue
+			}
+			refs[normalizeReference(entry.Path, slide.Ref)]++
+		}
+	}
+
+	if stats.Decks > 0 {
+		stats.AverageSlides = float64(stats.Slides) / float64(stats.Decks)
+	}
+
+	for tag, count := range tags {
+		stats.Tags = append(stats.Tags, TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(stats.Tags, func(i, j int) bool {
+		if stats.Tags[i].Count != stats.Tags[j].Count {
+			return stats.Tags[i].Count > stats.Tags[j].Count
+		}
+		return stats.Tags[i].Tag < stats.Tags[j].Tag
+	})
+
+	for ref, count := range refs {
+		stats.SharedSlides = append(stats.SharedSlides, ReuseCount{Ref: ref, Count: count})
+	}
+	sort.Slice(stats.SharedSlides, func(i, j int) bool {
+		if stats.SharedSlides[i].Count != stats.SharedSlides[j].Count {
+			return stats.SharedSlides[i].Count > stats.SharedSlides[j].Count
+		}
+		return stats.SharedSlides[i].Ref < stats.SharedSlides[j].Ref
+	})
+
+	stats.Recent = append([]CatalogEntry(nil), entries...)
+	sort.Slice(stats.Recent, func(i, j int) bool {
+		return stats.Recent[i].Data.Metadata.Modified.After(stats.Recent[j].Data.Metadata.Modified)
+	})
+	if len(stats.Recent) > maxRecentEntries {
+		stats.Recent = stats.Recent[:maxRecentEntries]
+	}
+
+	return stats
+}
+
+// normalizeReference rewrites a reference found in the deck at deckPath so the
+// same target slide yields the same string from any deck
+func normalizeReference(deckPath, ref string) string {
+	target, slideID, err := ParseReference(ref)
+	if err != nil {
+		return ref
+	}
+	if target == "" {
+		return filepath.ToSlash(deckPath) + "#" + slideID
+	}
+	return filepath.ToSlash(filepath.Join(filepath.Dir(deckPath), platform.LocalPath(target))) + "#" + slideID
+}
@@ -0,0 +1,75 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Status represents where a presentation is in the review workflow
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusInReview  Status = "in-review"
+	StatusApproved  Status = "approved"
+	StatusDelivered Status = "delivered"
+)
+
+// GetStatuses returns the workflow states in order
+func GetStatuses() []Status {
+	return []Status{
+		StatusDraft,
+		StatusInReview,
+		StatusApproved,
+		StatusDelivered,
+	}
+}
+
+// ParseStatus converts a string to a known workflow status
+func ParseStatus(value string) (Status, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, status := range GetStatuses() {
+		if string(status) == value {
+			return status, nil
+		}
+	}
+
+	names := make([]string, 0, len(GetStatuses()))
+	for _, status := range GetStatuses() {
+		names = append(names, string(status))
+	}
+	return "", fmt.Errorf("unknown status %q (expected one of: %s)", value, strings.Join(names, ", "))
+}
+
+// GetStatus returns the workflow status, treating decks without one as drafts
+func (m *Metadata) GetStatus() Status {
+	if m.Status == "" {
+		return StatusDraft
+	}
+	return m.Status
+}
+
+// IsPublishable reports whether the presentation has been signed off for
+// sharing: approved, or already delivered
+func (m *Metadata) IsPublishable() bool {
+	status := m.GetStatus()
+	return status == StatusApproved || status == StatusDelivered
+}
+
+// SetStatus changes the workflow status of the presentation at path
+func (w *Writer) SetStatus(path string, status Status) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Metadata.Status = status
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
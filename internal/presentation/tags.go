@@ -0,0 +1,126 @@
+package presentation
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ParseTags splits comma-separated tag lists into normalized tags: trimmed,
+// lowercased, and without duplicates
+func ParseTags(values ...string) ([]string, error) {
+	var tags []string
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if tag == "" {
+				continue
+			}
+			if strings.ContainsFunc(tag, func(r rune) bool { return r == ' ' || r == '\t' }) {
+				return nil, fmt.Errorf("invalid tag %q (tags cannot contain spaces)", tag)
+			}
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags, nil
+}
+
+// HasAnyTag reports whether the slide has at least one of tags
+func (s *Slide) HasAnyTag(tags []string) bool {
+	for _, tag := range s.Tags {
+		if slices.Contains(tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterByTags returns a copy of the presentation with only the slides
+// matching the tag filters. With include tags, tagged slides are kept only
+// when they have one of them; slides without tags, such as the title and
+// closing slides, are shared by every subset and always kept. Slides with
+// any of the exclude tags are dropped.
+func (data *PresentationData) FilterByTags(include, exclude []string) *PresentationData {
+	if len(include) == 0 && len(exclude) == 0 {
+		return data
+	}
+
+	result := data.Clone()
+	kept := result.Slides[:0]
+	for _, slide := range result.Slides {
+		if len(include) > 0 && len(slide.Tags) > 0 && !slide.HasAnyTag(include) {
+			continue
+		}
+		if slide.HasAnyTag(exclude) {
+			continue
+		}
+		kept = append(kept, slide)
+	}
+	result.Slides = kept
+	return result
+}
+
+// SlideTags counts how many slides of the presentation carry each tag, most
+// used first
+func (data *PresentationData) SlideTags() []TagCount {
+	counts := map[string]int{}
+	for _, slide := range data.Slides {
+		for _, tag := range slide.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		tags = append(tags, TagCount{Tag: tag, Count: count})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Count != tags[j].Count {
+			return tags[i].Count > tags[j].Count
+		}
+		return tags[i].Tag < tags[j].Tag
+	})
+	return tags
+}
+
+// TagSlides adds tags to, or with remove set removes them from, the slides
+// at the given indexes (0-based)
+func (w *Writer) TagSlides(path string, indexes []int, tags []string, remove bool) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		if index < 0 || index >= len(data.Slides) {
+			return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+	}
+
+	for _, index := range indexes {
+		slide := &data.Slides[index]
+		for _, tag := range tags {
+			has := slices.Contains(slide.Tags, tag)
+			switch {
+			case remove && has:
+				slide.Tags = slices.DeleteFunc(slide.Tags, func(t string) bool { return t == tag })
+			case !remove && !has:
+				slide.Tags = append(slide.Tags, tag)
+			}
+		}
+		if len(slide.Tags) == 0 {
+			slide.Tags = nil
+		}
+	}
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
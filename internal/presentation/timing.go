@@ -0,0 +1,104 @@
+package presentation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// speakingRate is the words per minute used to estimate timing
+	speakingRate = 130
+
+	// minSlideTime is the shortest time estimated for a slide
+	minSlideTime = 30 * time.Second
+
+	// minTitleTime is the shortest time estimated for a title slide
+	minTitleTime = 15 * time.Second
+)
+
+// EstimateTime estimates how long presenting the slide takes from the length
+// of its speaker notes (or content) at a typical speaking rate
+func (s *Slide) EstimateTime() time.Duration {
+	text := s.Notes
+	if strings.TrimSpace(text) == "" {
+		text = s.Content
+	}
+	words := len(strings.Fields(text))
+	estimate := time.Duration(words) * time.Minute / speakingRate
+
+	minimum := minSlideTime
+	if s.Layout == "title" {
+		minimum = minTitleTime
+	}
+	return max(estimate, minimum).Round(time.Second)
+}
+
+// GetTimeBudget returns the time budgeted for the slide, falling back to the
+// estimate when no budget is set
+func (s *Slide) GetTimeBudget() time.Duration {
+	if s.TimeBudgetSeconds > 0 {
+		return time.Duration(s.TimeBudgetSeconds) * time.Second
+	}
+	return s.EstimateTime()
+}
+
+// TotalTimeBudget returns the time budgeted for the main flow of the deck
+func (data *PresentationData) TotalTimeBudget() time.Duration {
+	var total time.Duration
+	for i := range data.Slides {
+		if !data.Slides[i].Hidden {
+			total += data.Slides[i].GetTimeBudget()
+		}
+	}
+	return total
+}
+
+// ParseTimeBudget parses a time budget given as seconds ("90") or as a
+// duration ("1m30s"); "auto" clears the budget
+func ParseTimeBudget(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "auto") {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= time.Second {
+		return d.Round(time.Second), nil
+	}
+	return 0, fmt.Errorf("invalid time budget %q (expected seconds, a duration such as 1m30s, or auto)", value)
+}
+
+// SetTimeBudget sets the time budget of the slides at the given indexes
+// (0-based); a zero budget returns them to the estimate
+func (w *Writer) SetTimeBudget(path string, indexes []int, budget time.Duration) (*PresentationData, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		if index < 0 || index >= len(data.Slides) {
+			return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+		}
+	}
+
+	for _, index := range indexes {
+		data.Slides[index].TimeBudgetSeconds = int(budget / time.Second)
+	}
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// FormatClock formats a duration as m:ss
+func FormatClock(d time.Duration) string {
+	seconds := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,101 @@
+package presentation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Transcript is the record of a create or update session: the request, the
+// answers given during the Q&A, and the prompt and response of every AI call
+type Transcript struct {
+	Command string           `json:"command"`
+	Request string           `json:"request"`
+	Started time.Time        `json:"started"`
+	Deck    string           `json:"deck,omitempty"`
+	Answers []string         `json:"answers,omitempty"`
+	Calls   []TranscriptCall `json:"calls"`
+}
+
+// TranscriptCall is a single AI call made during a session
+type TranscriptCall struct {
+	Provenance
+	// Prompt is the request sent to the model, as the provider received it
+	Prompt string `json:"prompt"`
+	// Response is the raw model output, before it was parsed
+	Response string `json:"response"`
+}
+
+// Save writes the transcript to path, as JSON when the path ends in .json and
+// as Markdown otherwise
+func (t *Transcript) Save(path string) error {
+	var data []byte
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var err error
+		data, err = json.MarshalIndent(t, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal transcript: %w", err)
+		}
+	} else {
+		data = []byte(t.Markdown())
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("failed to create transcript directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write transcript: %w", err)
+	}
+	return nil
+}
+
+// Markdown renders the transcript as a Markdown document, with prompts and
+// responses in fenced code blocks so they can be copied as-is
+func (t *Transcript) Markdown() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# Transcript: %s\n\n", t.Command)
+	fmt.Fprintf(&sb, "- Started: %s\n", t.Started.Format("2006-01-02 15:04:05"))
+	if t.Deck != "" {
+		fmt.Fprintf(&sb, "- Deck: %s\n", t.Deck)
+	}
+	fmt.Fprintf(&sb, "\n## Request\n\n%s\n", t.Request)
+
+	if len(t.Answers) > 0 {
+		sb.WriteString("\n## Q&A\n")
+		for _, answer := range t.Answers {
+			fmt.Fprintf(&sb, "\n%s\n", answer)
+		}
+	}
+
+	for i, call := range t.Calls {
+		fmt.Fprintf(&sb, "\n## Call %d: %s\n\n", i+1, call.Function)
+		fmt.Fprintf(&sb, "- Time: %s\n", call.Timestamp.Format("2006-01-02 15:04:05"))
+		if call.Model != "" {
+			fmt.Fprintf(&sb, "- Model: %s\n", call.Model)
+		}
+		if call.Client != "" {
+			fmt.Fprintf(&sb, "- Client: %s\n", call.Client)
+		}
+		fmt.Fprintf(&sb, "- Prompt version: %s\n", call.PromptVersion)
+		fmt.Fprintf(&sb, "- Tokens: %d in / %d out\n", call.InputTokens, call.OutputTokens)
+		fmt.Fprintf(&sb, "\n### Prompt\n\n%s\n", fence(call.Prompt))
+		fmt.Fprintf(&sb, "\n### Response\n\n%s\n", fence(call.Response))
+	}
+
+	return sb.String()
+}
+
+// fence wraps text in a code fence long enough not to be closed by any
+// backticks in the text itself
+func fence(text string) string {
+	marker := "```"
+	for strings.Contains(text, marker) {
+		marker += "`"
+	}
+	return marker + "\n" + strings.TrimRight(text, "\n") + "\n" + marker
+}
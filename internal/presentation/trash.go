@@ -0,0 +1,224 @@
+package presentation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TrashDir is the directory in AuditDir that holds deleted decks
+const TrashDir = "trash"
+
+// trashManifest is the file in a trash entry describing the deleted deck
+const trashManifest = "trash.json"
+
+// TrashEntry is a deck moved to the trash. Each entry is a directory in
+// .pres/trash next to where the deck was, holding the deck file, its assets,
+// and its local data.
+type TrashEntry struct {
+	ID      string    `json:"id"`
+	Path    string    `json:"-"`
+	Name    string    `json:"name"`
+	Title   string    `json:"title"`
+	Slides  int       `json:"slides"`
+	Deleted time.Time `json:"deleted"`
+	Actor   string    `json:"actor,omitempty"`
+
+	dir string
+}
+
+// trashPath returns the trash directory for decks in dir
+func trashPath(dir string) string {
+	return filepath.Join(dir, AuditDir, TrashDir)
+}
+
+// TrashPresentation moves the deck at path, its assets, and its local data
+// into the trash next to it, from where RestorePresentation brings them back
+func (w *Writer) TrashPresentation(path string) (*TrashEntry, error) {
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	base := filepath.Base(path)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	entry := &TrashEntry{
+		ID:      name + "-" + now.Format("20060102T150405"),
+		Path:    path,
+		Name:    base,
+		Title:   data.Metadata.Title,
+		Slides:  len(data.Slides),
+		Deleted: now,
+		Actor:   w.auditActor,
+	}
+	entry.dir = filepath.Join(trashPath(filepath.Dir(path)), entry.ID)
+	if _, err := os.Stat(entry.dir); err == nil {
+		return nil, fmt.Errorf("%s was already moved to the trash just now", path)
+	}
+	if err := os.MkdirAll(entry.dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create trash directory: %w", err)
+	}
+
+	manifest, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(entry.dir, trashManifest), manifest, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write trash entry: %w", err)
+	}
+
+	for _, move := range entry.moves() {
+		if _, err := os.Stat(move[0]); err != nil {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(move[1]), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create trash directory: %w", err)
+		}
+		if err := os.Rename(move[0], move[1]); err != nil {
+			return nil, fmt.Errorf("failed to move %s to the trash: %w", move[0], err)
+		}
+	}
+
+	return entry, nil
+}
+
+// moves returns the files and directories of a deck paired with where they
+// are kept in its trash entry: the deck file, its assets directory, and its
+// local data files
+func (e *TrashEntry) moves() [][2]string {
+	moves := [][2]string{
+		{e.Path, filepath.Join(e.dir, e.Name)},
+		{assetsPath(e.Path), filepath.Join(e.dir, AssetsDir)},
+	}
+	for _, file := range localDataPaths(e.Path) {
+		moves = append(moves, [2]string{file, filepath.Join(e.dir, AuditDir, filepath.Base(file))})
+	}
+	// Pass progress files are found where they are, so look in the trash
+	// entry too when restoring
+	trashed, _ := filepath.Glob(filepath.Join(e.dir, AuditDir, filepath.Base(PassStatePath(e.Path, "*"))))
+	for _, file := range trashed {
+		moves = append(moves, [2]string{filepath.Join(filepath.Dir(e.Path), AuditDir, filepath.Base(file)), file})
+	}
+	return moves
+}
+
+// ListTrash returns the decks in the trash of dir and the directories below
+// it, most recently deleted first
+func ListTrash(dir string) ([]TrashEntry, error) {
+	var entries []TrashEntry
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+
+		trash := trashPath(path)
+		items, err := os.ReadDir(trash)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			entry, err := readTrashEntry(path, filepath.Join(trash, item.Name()))
+			if err != nil {
+				continue
+			}
+			entries = append(entries, *entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Deleted.After(entries[j].Deleted)
+	})
+	return entries, nil
+}
+
+// readTrashEntry loads the manifest of the trash entry in entryDir, for a
+// deck that was in dir
+func readTrashEntry(dir, entryDir string) (*TrashEntry, error) {
+	raw, err := os.ReadFile(filepath.Join(entryDir, trashManifest))
+	if err != nil {
+		return nil, err
+	}
+	var entry TrashEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, fmt.Errorf("invalid trash entry %s: %w", entryDir, err)
+	}
+	entry.Path = filepath.Join(dir, entry.Name)
+	entry.dir = entryDir
+	return &entry, nil
+}
+
+// RestorePresentation brings the deck deleted from path back from the trash,
+// along with its assets and local data. When it was deleted more than once,
+// the most recent copy is restored, unless id selects an entry.
+func RestorePresentation(path, id string) (*TrashEntry, error) {
+	entries, err := ListTrash(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *TrashEntry
+	for i := range entries {
+		candidate := &entries[i]
+		if filepath.Clean(candidate.Path) != filepath.Clean(path) {
+			continue
+		}
+		if id == "" || candidate.ID == id {
+			entry = candidate
+			break
+		}
+	}
+	if entry == nil {
+		if id != "" {
+			return nil, fmt.Errorf("no trash entry %s for %s", id, path)
+		}
+		return nil, fmt.Errorf("%s is not in the trash", path)
+	}
+
+	for _, move := range entry.moves() {
+		if _, err := os.Stat(move[1]); err != nil {
+			continue
+		}
+		if _, err := os.Stat(move[0]); err == nil {
+			return nil, fmt.Errorf("%s already exists; move it out of the way first", move[0])
+		}
+	}
+
+	for _, move := range entry.moves() {
+		if _, err := os.Stat(move[1]); err != nil {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(move[0]), 0755); err != nil {
+			return nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.Rename(move[1], move[0]); err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", move[0], err)
+		}
+	}
+
+	if err := os.RemoveAll(entry.dir); err != nil {
+		return nil, fmt.Errorf("failed to remove trash entry: %w", err)
+	}
+
+	return entry, nil
+}
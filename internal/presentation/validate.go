@@ -0,0 +1,146 @@
+package presentation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ValidationIssue is a problem in a deck that makes it render badly, such as
+// a deck generated with an unknown layout
+type ValidationIssue struct {
+	// Slide is the 0-based index of the slide with the problem, or -1 for a
+	// problem with the deck as a whole
+	Slide   int
+	Message string
+	// Warning is set for problems the deck still renders with, though
+	// likely not as intended
+	Warning bool
+}
+
+// Validate checks a deck for what the schema cannot: a known theme, slides
+// to show, known layouts, and well-formed markdown. Slide references should
+// be resolved first, so shared slides are checked too.
+func (data *PresentationData) Validate() []ValidationIssue {
+	var issues []ValidationIssue
+	deckIssue := func(warning bool, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Slide: -1, Message: fmt.Sprintf(format, args...), Warning: warning})
+	}
+
+	meta := data.Metadata
+	if strings.TrimSpace(meta.Title) == "" {
+		deckIssue(false, "the presentation has no title")
+	}
+	switch {
+	case meta.Theme == "":
+		deckIssue(false, "no theme is set (expected one of: %s)", strings.Join(GetRevealJSThemes(), ", "))
+	case !slices.Contains(GetRevealJSThemes(), meta.Theme):
+		deckIssue(false, "unknown theme %q (expected one of: %s)", meta.Theme, strings.Join(GetRevealJSThemes(), ", "))
+	}
+	if len(data.Slides) == 0 {
+		deckIssue(false, "the presentation has no slides")
+	} else if !slices.ContainsFunc(data.Slides, func(slide Slide) bool { return !slide.Hidden }) {
+		deckIssue(false, "every slide is hidden, so there is nothing to present")
+	}
+
+	var layouts []string
+	for _, layout := range Layouts() {
+		layouts = append(layouts, layout.Name)
+	}
+	for i, slide := range data.Slides {
+		slideIssue := func(warning bool, format string, args ...any) {
+			issues = append(issues, ValidationIssue{Slide: i, Message: fmt.Sprintf(format, args...), Warning: warning})
+		}
+
+		if slide.Layout != "" && !slices.Contains(layouts, slide.Layout) {
+			slideIssue(false, "unknown layout %q (expected one of: %s)", slide.Layout, strings.Join(layouts, ", "))
+		}
+		if strings.TrimSpace(slide.Title) == "" && strings.TrimSpace(slide.Content) == "" && slide.Image == "" {
+			slideIssue(false, "the slide is empty")
+		}
+		if slide.Layout == "two-column" && slide.Content != "" && !strings.Contains(slide.Content, "|||") {
+			slideIssue(true, "two-column slide has no ||| separating its columns")
+		}
+		for _, problem := range markdownProblems(slide.Content) {
+			slideIssue(problem.warning, "%s", problem.message)
+		}
+	}
+
+	return issues
+}
+
+// markdownProblem is a way slide markdown is malformed
+type markdownProblem struct {
+	message string
+	warning bool
+}
+
+// markdownProblems finds the mistakes in slide markdown that break how a
+// slide renders: code blocks left open, which swallow the rest of the slide,
+// links without their closing parenthesis, and unpaired ** markers. Slide
+// markdown is embedded in a textarea, so content that closes it breaks the
+// page.
+func markdownProblems(content string) []markdownProblem {
+	var problems []markdownProblem
+	if strings.Contains(strings.ToLower(content), "</textarea") {
+		problems = append(problems, markdownProblem{message: "the content contains </textarea>, which cuts the slide short"})
+	}
+
+	var fence string
+	fenceLine := 0
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence, fenceLine = trimmed[:3], i+1
+			continue
+		}
+
+		// Inline code is shown as written
+		prose := stripInlineCode(line)
+		if strings.Count(prose, "**")%2 != 0 {
+			problems = append(problems, markdownProblem{
+				message: fmt.Sprintf("line %d has an unpaired ** bold marker", i+1),
+				warning: true,
+			})
+		}
+		for rest := prose; ; {
+			start := strings.Index(rest, "](")
+			if start < 0 {
+				break
+			}
+			rest = rest[start+2:]
+			end := strings.Index(rest, ")")
+			if end < 0 {
+				problems = append(problems, markdownProblem{
+					message: fmt.Sprintf("line %d has a link without its closing )", i+1),
+					warning: true,
+				})
+				break
+			}
+			rest = rest[end+1:]
+		}
+	}
+	if fence != "" {
+		problems = append(problems, markdownProblem{message: fmt.Sprintf("the code block opened on line %d is never closed", fenceLine)})
+	}
+
+	return problems
+}
+
+// stripInlineCode removes `code` spans from a line of markdown
+func stripInlineCode(line string) string {
+	var sb strings.Builder
+	for i, part := range strings.Split(line, "`") {
+		// Even parts are outside code spans; an unclosed span is kept
+		if i%2 == 0 || i == strings.Count(line, "`") {
+			sb.WriteString(part)
+		}
+	}
+	return sb.String()
+}
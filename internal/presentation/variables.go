@@ -0,0 +1,82 @@
+package presentation
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"time"
+)
+
+// DataSource describes external data loaded into a deck's variables by
+// pres refresh
+type DataSource struct {
+	Name string `json:"name"`
+	Type string `json:"type"` // csv, json, or text
+	Path string `json:"path,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// variablePattern matches {{name}} placeholders. Names start with a letter or
+// underscore, so template syntax such as {{.Field}} in code samples is left alone.
+var variablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_.-]*)\s*\}\}`)
+
+// BuiltinVariables returns the date-derived variables for now, which
+// pres refresh updates on every run
+func BuiltinVariables(now time.Time) map[string]string {
+	year, week := now.ISOWeek()
+	return map[string]string{
+		"date":    now.Format("2006-01-02"),
+		"week":    fmt.Sprintf("%d", week),
+		"year":    fmt.Sprintf("%d", year),
+		"month":   now.Format("January"),
+		"quarter": fmt.Sprintf("Q%d", (int(now.Month())-1)/3+1),
+	}
+}
+
+// SetVariables merges values into the deck's variables
+func (m *Metadata) SetVariables(values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+	if m.Variables == nil {
+		m.Variables = make(map[string]string, len(values))
+	}
+	maps.Copy(m.Variables, values)
+}
+
+// ExpandVariables returns a copy of the presentation with {{name}}
+// placeholders in the metadata and slides replaced by the deck's variables.
+// Placeholders without a value are left as they are.
+func (data *PresentationData) ExpandVariables() *PresentationData {
+	if len(data.Metadata.Variables) == 0 {
+		return data
+	}
+
+	vars := data.Metadata.Variables
+	expanded := data.Clone()
+
+	meta := &expanded.Metadata
+	meta.Title = expandVariables(meta.Title, vars)
+	meta.Subtitle = expandVariables(meta.Subtitle, vars)
+	meta.Date = expandVariables(meta.Date, vars)
+
+	for i := range expanded.Slides {
+		slide := &expanded.Slides[i]
+		slide.Title = expandVariables(slide.Title, vars)
+		slide.Content = expandVariables(slide.Content, vars)
+		slide.Notes = expandVariables(slide.Notes, vars)
+	}
+
+	return expanded
+}
+
+// expandVariables replaces the placeholders in text that have a value
+func expandVariables(text string, vars map[string]string) string {
+	return variablePattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := variablePattern.FindStringSubmatch(match)[1]
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		return match
+	})
+}
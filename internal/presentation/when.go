@@ -0,0 +1,260 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// FilterSlides returns a copy of the presentation without the slides whose
+// when condition is false for the deck's variables. Conditions support
+// variable names, "quoted" strings, ==, !=, &&, ||, ! and parentheses; a bare
+// variable is true when it is set to anything other than "", "false" or "0".
+func (data *PresentationData) FilterSlides() (*PresentationData, error) {
+	var kept []Slide
+	filtered := false
+	for i, slide := range data.Slides {
+		if slide.When == "" {
+			kept = append(kept, slide)
+			continue
+		}
+
+		include, err := EvaluateCondition(slide.When, data.Metadata.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("slide %d: %w", i+1, err)
+		}
+		if include {
+			kept = append(kept, slide)
+		} else {
+			filtered = true
+		}
+	}
+
+	if !filtered {
+		return data, nil
+	}
+
+	result := data.Clone()
+	result.Slides = kept
+	return result, nil
+}
+
+// SetWhen sets the condition under which the slide at index (0-based) is
+// included, clearing it when expr is empty
+func (w *Writer) SetWhen(path string, index int, expr string) (*PresentationData, error) {
+	expr = strings.TrimSpace(expr)
+	if expr != "" {
+		if _, err := EvaluateCondition(expr, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := w.LoadPresentation(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 0 || index >= len(data.Slides) {
+		return nil, fmt.Errorf("slide %d does not exist (presentation has %d slides)", index+1, len(data.Slides))
+	}
+	data.Slides[index].When = expr
+	data.Metadata.Modified = time.Now()
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// EvaluateCondition evaluates a when condition against variables
+func EvaluateCondition(expr string, vars map[string]string) (bool, error) {
+	tokens, err := tokenizeCondition(expr)
+	if err != nil {
+		return false, fmt.Errorf("invalid condition %q: %w", expr, err)
+	}
+
+	p := &conditionParser{tokens: tokens, vars: vars}
+	result, err := p.parseOr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos].text)
+	}
+	if err != nil {
+		return false, fmt.Errorf("invalid condition %q: %w", expr, err)
+	}
+
+	return truthy(result), nil
+}
+
+// conditionToken is a lexical token of a condition
+type conditionToken struct {
+	kind string // "ident", "string", or the operator itself
+	text string
+}
+
+// tokenizeCondition splits a condition into tokens
+func tokenizeCondition(expr string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, conditionToken{kind: "string", text: string(runes[i+1 : end])})
+			i = end + 1
+		case r == '(' || r == ')':
+			tokens = append(tokens, conditionToken{kind: string(r), text: string(r)})
+			i++
+		case strings.HasPrefix(string(runes[i:]), "=="), strings.HasPrefix(string(runes[i:]), "!="),
+			strings.HasPrefix(string(runes[i:]), "&&"), strings.HasPrefix(string(runes[i:]), "||"):
+			op := string(runes[i : i+2])
+			tokens = append(tokens, conditionToken{kind: op, text: op})
+			i += 2
+		case r == '!':
+			tokens = append(tokens, conditionToken{kind: "!", text: "!"})
+			i++
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-':
+			end := i
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || strings.ContainsRune("_.-", runes[end])) {
+				end++
+			}
+			tokens = append(tokens, conditionToken{kind: "ident", text: string(runes[i:end])})
+			i = end
+		default:
+			return nil, fmt.Errorf("unexpected character %q", r)
+		}
+	}
+	return tokens, nil
+}
+
+// conditionParser evaluates tokens by recursive descent, with the usual
+// precedence: comparison, then !, then &&, then ||
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+	vars   map[string]string
+}
+
+func (p *conditionParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos].kind
+	}
+	return ""
+}
+
+func (p *conditionParser) parseOr() (string, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return "", err
+	}
+	for p.peek() == "||" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return "", err
+		}
+		left = boolValue(truthy(left) || truthy(right))
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseAnd() (string, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return "", err
+	}
+	for p.peek() == "&&" {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return "", err
+		}
+		left = boolValue(truthy(left) && truthy(right))
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseNot() (string, error) {
+	if p.peek() == "!" {
+		p.pos++
+		value, err := p.parseNot()
+		if err != nil {
+			return "", err
+		}
+		return boolValue(!truthy(value)), nil
+	}
+	return p.parseComparison()
+}
+
+func (p *conditionParser) parseComparison() (string, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return "", err
+	}
+	if op := p.peek(); op == "==" || op == "!=" {
+		p.pos++
+		right, err := p.parseOperand()
+		if err != nil {
+			return "", err
+		}
+		return boolValue((left == right) == (op == "==")), nil
+	}
+	return left, nil
+}
+
+func (p *conditionParser) parseOperand() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of condition")
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case "string":
+		return token.text, nil
+	case "ident":
+		if value, ok := p.vars[token.text]; ok {
+			return value, nil
+		}
+		// Bare numbers and true/false compare as literals; unknown names are empty
+		if token.text == "true" || token.text == "false" || strings.IndexFunc(token.text, unicode.IsLetter) < 0 {
+			return token.text, nil
+		}
+		return "", nil
+	case "(":
+		value, err := p.parseOr()
+		if err != nil {
+			return "", err
+		}
+		if p.peek() != ")" {
+			return "", fmt.Errorf("missing )")
+		}
+		p.pos++
+		return value, nil
+	default:
+		return "", fmt.Errorf("unexpected %q", token.text)
+	}
+}
+
+// truthy reports whether a value counts as true
+func truthy(value string) bool {
+	return value != "" && value != "false" && value != "0"
+}
+
+// boolValue converts a boolean to its condition value
+func boolValue(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
@@ -5,29 +5,48 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/geoffjay/pres/baml_client/types"
 )
 
+// Metadata holds the descriptive fields stored alongside the slides
+type Metadata struct {
+	Title     string            `json:"title"`
+	Subtitle  string            `json:"subtitle"`
+	Author    string            `json:"author"`
+	Date      string            `json:"date"`
+	Theme     string            `json:"theme"`
+	Tags      []string          `json:"tags"`
+	EventDate string            `json:"event_date,omitempty"`
+	Venue     string            `json:"venue,omitempty"`
+	Status    Status            `json:"status,omitempty"`
+	Custom    map[string]string `json:"custom,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+	Sources   []DataSource      `json:"sources,omitempty"`
+	Created   time.Time         `json:"created"`
+	Modified  time.Time         `json:"modified"`
+
+	// Provenance lists the AI calls that produced and edited the deck
+	Provenance []Provenance `json:"provenance,omitempty"`
+}
+
 // PresentationData represents the stored presentation format
 type PresentationData struct {
-	Metadata struct {
-		Title    string    `json:"title"`
-		Subtitle string    `json:"subtitle"`
-		Author   string    `json:"author"`
-		Date     string    `json:"date"`
-		Theme    string    `json:"theme"`
-		Tags     []string  `json:"tags"`
-		Created  time.Time `json:"created"`
-		Modified time.Time `json:"modified"`
-	} `json:"metadata"`
-	Slides []types.Slide `json:"slides"`
+	Metadata Metadata `json:"metadata"`
+	Slides   []Slide  `json:"slides"`
 }
 
 // Writer handles writing presentations to disk
 type Writer struct {
-	baseDir string
+	baseDir     string
+	strict      bool
+	auditSource string
+	auditActor  string
+	policy      *UpdatePolicy
+	provenance  []Provenance
+	gitCommit   bool
 }
 
 // NewWriter creates a new presentation writer
@@ -35,8 +54,39 @@ func NewWriter(baseDir string) *Writer {
 	return &Writer{baseDir: baseDir}
 }
 
+// SetStrict toggles strict loading. Strict loading only accepts the stored
+// format and rejects unknown keys and missing required fields.
+func (w *Writer) SetStrict(strict bool) {
+	w.strict = strict
+}
+
+// NewPresentationData wraps a generated presentation in the stored format,
+// as a new draft
+func NewPresentationData(pres *types.Presentation) *PresentationData {
+	data := PresentationData{}
+	data.Metadata.Title = pres.Title
+	data.Metadata.Subtitle = pres.Subtitle
+	data.Metadata.Author = pres.Author
+	data.Metadata.Date = pres.Date
+	data.Metadata.Theme = pres.Theme
+	data.Metadata.Tags = pres.Tags
+	data.Metadata.Status = StatusDraft
+	data.Metadata.Created = time.Now()
+	data.Metadata.Modified = time.Now()
+	data.Slides = newSlides(pres.Slides)
+	return &data
+}
+
 // SavePresentation saves a presentation to a JSON file
 func (w *Writer) SavePresentation(pres *types.Presentation, filename string) (string, error) {
+	data := NewPresentationData(pres)
+	w.recordProvenance(&data.Metadata)
+
+	return w.SaveData(data, filename)
+}
+
+// SaveData saves presentation data to a new JSON file, returning its path
+func (w *Writer) SaveData(data *PresentationData, filename string) (string, error) {
 	// Ensure filename has .json extension
 	if filepath.Ext(filename) != ".json" {
 		filename = filename + ".json"
@@ -51,27 +101,12 @@ func (w *Writer) SavePresentation(pres *types.Presentation, filename string) (st
 		return "", fmt.Errorf("failed to create directory: %w", err)
 	}
 
-	// Create presentation data structure
-	data := PresentationData{}
-	data.Metadata.Title = pres.Title
-	data.Metadata.Subtitle = pres.Subtitle
-	data.Metadata.Author = pres.Author
-	data.Metadata.Date = pres.Date
-	data.Metadata.Theme = pres.Theme
-	data.Metadata.Tags = pres.Tags
-	data.Metadata.Created = time.Now()
-	data.Metadata.Modified = time.Now()
-	data.Slides = pres.Slides
-
-	// Marshal to JSON with indentation
-	jsonData, err := json.MarshalIndent(data, "", "  ")
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal JSON: %w", err)
+	if err := w.writeData(fullPath, data); err != nil {
+		return "", err
 	}
 
-	// Write to file
-	if err := os.WriteFile(fullPath, jsonData, 0644); err != nil {
-		return "", fmt.Errorf("failed to write file: %w", err)
+	if err := w.commitDeck(fullPath, createCommitMessage(fullPath, data)); err != nil {
+		return fullPath, err
 	}
 
 	return fullPath, nil
@@ -85,23 +120,36 @@ func (w *Writer) LoadPresentation(path string) (*PresentationData, error) {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	// Try to unmarshal as PresentationData first (wrapped format)
-	var data PresentationData
-	if err := json.Unmarshal(jsonData, &data); err == nil {
-		// Check if this is the wrapped format by seeing if metadata is populated
-		if data.Metadata.Title != "" {
-			return &data, nil
+	// Detect the wrapped format (PresentationData) by its metadata object
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(jsonData, &probe); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
+	}
+
+	if _, ok := probe["metadata"]; ok {
+		if err := ValidateJSON(jsonData, w.strict); err != nil {
+			return nil, err
+		}
+
+		var data PresentationData
+		if err := json.Unmarshal(jsonData, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
 		}
+		return &data, nil
+	}
+
+	// Otherwise this is the raw Presentation format (BAML output)
+	if w.strict {
+		return nil, fmt.Errorf("not a stored presentation: missing metadata (raw format is not accepted in strict mode)")
 	}
 
-	// If that failed or metadata is empty, try raw Presentation format (BAML output)
 	var pres types.Presentation
 	if err := json.Unmarshal(jsonData, &pres); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal JSON (tried both formats): %w", err)
+		return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
 
 	// Convert to PresentationData format
-	data = PresentationData{}
+	data := PresentationData{}
 	data.Metadata.Title = pres.Title
 	data.Metadata.Subtitle = pres.Subtitle
 	data.Metadata.Author = pres.Author
@@ -110,48 +158,115 @@ func (w *Writer) LoadPresentation(path string) (*PresentationData, error) {
 	data.Metadata.Tags = pres.Tags
 	data.Metadata.Created = time.Now()
 	data.Metadata.Modified = time.Now()
-	data.Slides = pres.Slides
+	data.Slides = newSlides(pres.Slides)
 
 	return &data, nil
 }
 
-// UpdatePresentation applies updates to an existing presentation
-func (w *Writer) UpdatePresentation(path string, updates []types.PresentationUpdate) error {
+// UpdatePresentation applies updates to an existing presentation and reports
+// the outcome of each operation. The updates are applied in memory and only
+// saved if every operation is valid; with partial set, the valid operations
+// are saved and the invalid ones skipped. When the update is rejected the
+// results are returned along with an ErrUpdateRejected error.
+func (w *Writer) UpdatePresentation(path string, updates []types.PresentationUpdate, partial bool) ([]OperationResult, error) {
 	// Load existing presentation
 	data, err := w.LoadPresentation(path)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	results, err := w.ApplyUpdates(data, updates)
+	if err != nil {
+		return nil, err
 	}
 
-	// Apply each update operation
-	for _, update := range updates {
+	if skipped := len(results) - CountApplied(results); skipped > 0 && !partial {
+		return results, fmt.Errorf("%w: %d of %d operations are invalid", ErrUpdateRejected, skipped, len(results))
+	}
+
+	// Update modification time
+	data.Metadata.Modified = time.Now()
+	w.recordProvenance(&data.Metadata)
+
+	if err := w.writeData(path, data); err != nil {
+		return nil, err
+	}
+
+	if err := w.appendAudit(path, updates, results); err != nil {
+		return results, err
+	}
+
+	if err := w.commitDeck(path, updateCommitMessage(path, updates, results)); err != nil {
+		return results, err
+	}
+
+	return results, nil
+}
+
+// ApplyUpdates applies update operations to presentation data in memory.
+// Invalid operations, including those the writer's policy forbids, are
+// skipped, or fail the whole update when the writer is strict.
+func (w *Writer) ApplyUpdates(data *PresentationData, updates []types.PresentationUpdate) ([]OperationResult, error) {
+	results := make([]OperationResult, 0, len(updates))
+	deleted := 0
+	for i, update := range updates {
+		result := OperationResult{Index: i, Operation: update.Operation}
+
+		update, err := data.ResolveSlideID(update)
+		if err == nil {
+			err = w.validateOperation(data, update)
+		}
+		if err == nil {
+			err = w.checkPolicy(data, update, deleted)
+		}
+		if err != nil {
+			if w.strict {
+				return nil, fmt.Errorf("operation %d (%s): %w", i+1, update.Operation, err)
+			}
+			result.Reason = err.Error()
+			results = append(results, result)
+			continue
+		}
+
 		switch update.Operation {
 		case "add_slide":
-			data.Slides = w.addSlide(data.Slides, update.Slide_index, update.New_slide)
+			data.Slides = w.addSlide(data.Slides, update.Slide_index, Slide{Slide: update.New_slide, ID: newSlideID()})
 		case "modify_slide":
-			if update.Slide_index >= 0 && update.Slide_index < int64(len(data.Slides)) {
-				data.Slides[update.Slide_index] = update.New_slide
-			}
+			// Replace the content but keep annotations such as comments.
+			// Local content overrides a reference, so the slide is detached.
+			w.setContent(&data.Slides[update.Slide_index], update.New_slide)
+			data.Slides[update.Slide_index].Ref = ""
 		case "delete_slide":
-			if update.Slide_index >= 0 && update.Slide_index < int64(len(data.Slides)) {
-				data.Slides = append(data.Slides[:update.Slide_index], data.Slides[update.Slide_index+1:]...)
-			}
+			deleted++
+			data.Slides = append(data.Slides[:update.Slide_index], data.Slides[update.Slide_index+1:]...)
 		case "reorder_slides":
 			data.Slides = w.reorderSlides(data.Slides, update.New_order)
 		case "update_metadata":
 			w.updateMetadata(&data.Metadata, update.Metadata_updates)
 		}
+
+		result.Applied = true
+		results = append(results, result)
 	}
 
-	// Update modification time
-	data.Metadata.Modified = time.Now()
+	return results, nil
+}
 
-	// Marshal to JSON
+// writeData marshals presentation data and writes it to path
+func (w *Writer) writeData(path string, data *PresentationData) error {
+	data.ensureSlideIDs()
+
+	// Marshal to JSON with indentation
 	jsonData, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
+	// Keep the version being replaced, for pres undo
+	if err := w.saveRevision(path, jsonData); err != nil {
+		return err
+	}
+
 	// Write to file
 	if err := os.WriteFile(path, jsonData, 0644); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
@@ -161,16 +276,8 @@ func (w *Writer) UpdatePresentation(path string, updates []types.PresentationUpd
 }
 
 // addSlide inserts a slide at the specified index
-func (w *Writer) addSlide(slides []types.Slide, index int64, newSlide types.Slide) []types.Slide {
-	if index < 0 {
-		index = 0
-	}
-	if index > int64(len(slides)) {
-		index = int64(len(slides))
-	}
-
-	// Insert slide at index
-	result := make([]types.Slide, 0, len(slides)+1)
+func (w *Writer) addSlide(slides []Slide, index int64, newSlide Slide) []Slide {
+	result := make([]Slide, 0, len(slides)+1)
 	result = append(result, slides[:index]...)
 	result = append(result, newSlide)
 	result = append(result, slides[index:]...)
@@ -179,32 +286,17 @@ func (w *Writer) addSlide(slides []types.Slide, index int64, newSlide types.Slid
 }
 
 // reorderSlides reorders slides based on new order indices
-func (w *Writer) reorderSlides(slides []types.Slide, newOrder []int64) []types.Slide {
-	if len(newOrder) != len(slides) {
-		return slides // Invalid order, return unchanged
-	}
-
-	result := make([]types.Slide, len(slides))
+func (w *Writer) reorderSlides(slides []Slide, newOrder []int64) []Slide {
+	result := make([]Slide, len(slides))
 	for i, oldIdx := range newOrder {
-		if oldIdx >= 0 && oldIdx < int64(len(slides)) {
-			result[i] = slides[oldIdx]
-		}
+		result[i] = slides[oldIdx]
 	}
 
 	return result
 }
 
 // updateMetadata updates presentation metadata
-func (w *Writer) updateMetadata(metadata *struct {
-	Title    string    `json:"title"`
-	Subtitle string    `json:"subtitle"`
-	Author   string    `json:"author"`
-	Date     string    `json:"date"`
-	Theme    string    `json:"theme"`
-	Tags     []string  `json:"tags"`
-	Created  time.Time `json:"created"`
-	Modified time.Time `json:"modified"`
-}, updates map[string]string) {
+func (w *Writer) updateMetadata(metadata *Metadata, updates map[string]string) {
 	for key, value := range updates {
 		switch key {
 		case "title":
@@ -217,6 +309,17 @@ func (w *Writer) updateMetadata(metadata *struct {
 			metadata.Date = value
 		case "theme":
 			metadata.Theme = value
+		case "tags":
+			metadata.Tags = splitMetadataTags(value)
+		case "event_date":
+			metadata.EventDate = value
+		case "venue":
+			metadata.Venue = value
+		default:
+			// Custom fields are addressed as "custom.<key>"; an empty value removes them
+			if key, ok := strings.CutPrefix(key, "custom."); ok && key != "" {
+				metadata.SetCustom(key, value)
+			}
 		}
 	}
 }
@@ -228,18 +331,40 @@ Subtitle: %s
 Author: %s
 Date: %s
 Theme: %s
+Status: %s
 Tags: %v
 Number of Slides: %d
 Created: %s
-Modified: %s`,
+Modified: %s%s`,
 		data.Metadata.Title,
 		data.Metadata.Subtitle,
 		data.Metadata.Author,
 		data.Metadata.Date,
 		data.Metadata.Theme,
+		data.Metadata.GetStatus(),
 		data.Metadata.Tags,
 		len(data.Slides),
 		data.Metadata.Created.Format("2006-01-02 15:04:05"),
 		data.Metadata.Modified.Format("2006-01-02 15:04:05"),
+		data.Metadata.optionalSummary(),
 	)
 }
+
+// optionalSummary renders the metadata fields that are only present on some
+// presentations, for appending to the presentation summary
+func (m *Metadata) optionalSummary() string {
+	var sb strings.Builder
+	if m.EventDate != "" {
+		fmt.Fprintf(&sb, "\nEvent Date: %s", m.EventDate)
+	}
+	if m.Venue != "" {
+		fmt.Fprintf(&sb, "\nVenue: %s", m.Venue)
+	}
+	if len(m.Custom) > 0 {
+		sb.WriteString("\nCustom Fields:")
+		for _, key := range m.GetCustomKeys() {
+			fmt.Fprintf(&sb, "\n  %s: %s", key, m.Custom[key])
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,167 @@
+// Package ratelimit paces AI calls to stay within a provider's requests per
+// minute (RPM) and tokens per minute (TPM), so large jobs slow down instead
+// of failing halfway through.
+//
+// The limits are set with the PRES_AI_RPM and PRES_AI_TPM environment
+// variables; unset or zero means no limit.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// window is the period the limits apply to
+const window = time.Minute
+
+// Limiter is a sliding-window limiter on requests and tokens per minute. It
+// is safe for concurrent use, so one limiter can be shared by every call of
+// a command. The zero value and a nil *Limiter don't limit anything.
+type Limiter struct {
+	rpm int
+	tpm int
+
+	mu      sync.Mutex
+	entries []entry
+	now     func() time.Time
+}
+
+// entry is a request or a batch of tokens counted against the window
+type entry struct {
+	at       time.Time
+	requests int
+	tokens   int
+}
+
+// New returns a limiter allowing rpm requests and tpm tokens per minute.
+// Zero or negative limits are not enforced.
+func New(rpm, tpm int) *Limiter {
+	return &Limiter{rpm: rpm, tpm: tpm, now: time.Now}
+}
+
+// FromEnv returns a limiter with the limits set by PRES_AI_RPM and
+// PRES_AI_TPM
+func FromEnv() (*Limiter, error) {
+	rpm, err := envLimit("PRES_AI_RPM")
+	if err != nil {
+		return nil, err
+	}
+	tpm, err := envLimit("PRES_AI_TPM")
+	if err != nil {
+		return nil, err
+	}
+	return New(rpm, tpm), nil
+}
+
+// envLimit parses a limit from the environment, zero when unset
+func envLimit(name string) (int, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 {
+		return 0, fmt.Errorf("invalid %s %q: expected a non-negative number", name, value)
+	}
+	return limit, nil
+}
+
+// Enabled reports whether the limiter enforces any limit
+func (l *Limiter) Enabled() bool {
+	return l != nil && (l.rpm > 0 || l.tpm > 0)
+}
+
+// Wait blocks until a request of about tokens input tokens fits within the
+// limits, then counts it. A request larger than the whole token limit waits
+// for an empty window rather than forever.
+func (l *Limiter) Wait(ctx context.Context, tokens int) error {
+	if !l.Enabled() {
+		return nil
+	}
+	if l.tpm > 0 && tokens > l.tpm {
+		tokens = l.tpm
+	}
+
+	for {
+		delay := l.reserve(tokens)
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Add counts tokens used after the fact, such as a response's output tokens
+func (l *Limiter) Add(tokens int) {
+	if !l.Enabled() || tokens <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry{at: l.now(), tokens: tokens})
+}
+
+// reserve counts the request when it fits, or returns how long to wait until
+// enough of the window has expired for it to fit
+func (l *Limiter) reserve(tokens int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.expire(now)
+
+	requests, used := 0, 0
+	for _, e := range l.entries {
+		requests += e.requests
+		used += e.tokens
+	}
+
+	var delay time.Duration
+	if l.rpm > 0 && requests+1 > l.rpm {
+		delay = max(delay, l.freed(now, func(e entry) int { return e.requests }, requests+1-l.rpm))
+	}
+	if l.tpm > 0 && used+tokens > l.tpm {
+		delay = max(delay, l.freed(now, func(e entry) int { return e.tokens }, used+tokens-l.tpm))
+	}
+	if delay > 0 {
+		return delay
+	}
+
+	l.entries = append(l.entries, entry{at: now, requests: 1, tokens: tokens})
+	return 0
+}
+
+// expire drops the entries that have left the window
+func (l *Limiter) expire(now time.Time) {
+	kept := l.entries[:0]
+	for _, e := range l.entries {
+		if now.Sub(e.at) < window {
+			kept = append(kept, e)
+		}
+	}
+	l.entries = kept
+}
+
+// freed returns how long until at least excess of the amount counted by size
+// has left the window
+func (l *Limiter) freed(now time.Time, size func(entry) int, excess int) time.Duration {
+	freed := 0
+	for _, e := range l.entries {
+		freed += size(e)
+		if freed >= excess {
+			return max(e.at.Add(window).Sub(now), time.Millisecond)
+		}
+	}
+	return window
+}
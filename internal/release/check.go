@@ -0,0 +1,90 @@
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// checkFile caches the latest release seen, in the user's config directory
+const checkFile = "update-check.json"
+
+// checkInterval is how often the latest release is looked up
+const checkInterval = 24 * time.Hour
+
+// updateCheck is the cached result of looking up the latest release
+type updateCheck struct {
+	Checked time.Time `json:"checked"`
+	Latest  string    `json:"latest"`
+}
+
+// Available returns the latest release recorded by CheckForUpdate in dir
+// when it is newer than the running version, or ""
+func Available(dir string) string {
+	check, _ := readCheck(dir)
+	if Newer(check.Latest, Current()) {
+		return check.Latest
+	}
+	return ""
+}
+
+// CheckForUpdate looks up the latest release and records it in dir, unless
+// it was looked up less than a day ago. It is meant to run alongside a
+// command, so the answer is there for the next one.
+func CheckForUpdate(ctx context.Context, dir string) error {
+	check, _ := readCheck(dir)
+	if time.Since(check.Checked) < checkInterval {
+		return nil
+	}
+
+	// Record the attempt before making it, so a failing lookup is not
+	// retried by every command
+	check.Checked = time.Now()
+	if err := writeCheck(dir, check); err != nil {
+		return err
+	}
+	latest, err := Latest(ctx)
+	if err != nil {
+		return err
+	}
+	check.Latest = latest.Tag
+	return writeCheck(dir, check)
+}
+
+// readCheck reads the cached lookup from dir
+func readCheck(dir string) (updateCheck, error) {
+	var check updateCheck
+	raw, err := os.ReadFile(filepath.Join(dir, checkFile))
+	if err != nil {
+		return check, err
+	}
+	err = json.Unmarshal(raw, &check)
+	return check, err
+}
+
+// writeCheck caches a lookup in dir. The file is replaced whole, as a
+// command may exit while it is written.
+func writeCheck(dir string, check updateCheck) error {
+	raw, err := json.Marshal(check)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(dir, ".update-check-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(raw)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filepath.Join(dir, checkFile))
+}
@@ -0,0 +1,258 @@
+// Package release finds pres releases on GitHub and upgrades the running
+// binary to one.
+//
+// A release carries a binary for each platform named pres_<os>_<arch>, with
+// .exe on Windows, such as pres_linux_amd64, and a checksums.txt listing the
+// SHA-256 of each in the format sha256sum prints. make release builds them.
+package release
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Repository is the GitHub repository releases are published to
+const Repository = "geoffjay/pres"
+
+// checksumsAsset is the release asset listing the checksum of each binary
+const checksumsAsset = "checksums.txt"
+
+// Version is the version pres was built as, set when building a release with
+// -ldflags "-X github.com/geoffjay/pres/internal/release.Version=v0.7.0"
+var Version string
+
+// apiURL is the GitHub API the latest release is looked up in
+var apiURL = "https://api.github.com"
+
+// httpClient downloads releases; binaries can take a while on slow links
+var httpClient = &http.Client{Timeout: 5 * time.Minute}
+
+// Release is a published version of pres
+type Release struct {
+	Tag    string  `json:"tag_name"`
+	URL    string  `json:"html_url"`
+	Assets []Asset `json:"assets"`
+}
+
+// Asset is a file attached to a release
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// Current returns the version pres was built as, e.g. v0.7.0, or "devel" for
+// a development build
+func Current() string {
+	if Version != "" {
+		return Version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return "devel"
+}
+
+// Latest looks up the most recent release
+func Latest(ctx context.Context) (*Release, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL+"/repos/"+Repository+"/releases/latest", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	// A token raises GitHub's rate limit for shared networks and CI
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for releases: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("GitHub returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	var release Release
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, fmt.Errorf("invalid release from GitHub: %w", err)
+	}
+	return &release, nil
+}
+
+// Newer reports whether version is later than current. A development build
+// is never out of date, as its version is unknown.
+func Newer(version, current string) bool {
+	latest, ok := parseVersion(version)
+	if !ok {
+		return false
+	}
+	running, ok := parseVersion(current)
+	if !ok {
+		return false
+	}
+	for i := range latest {
+		if latest[i] != running[i] {
+			return latest[i] > running[i]
+		}
+	}
+	return false
+}
+
+// parseVersion splits a version such as v1.2.3 into its numbers. Pre-release
+// and build suffixes are ignored.
+func parseVersion(version string) ([3]int, bool) {
+	var numbers [3]int
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return numbers, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return numbers, false
+		}
+		numbers[i] = n
+	}
+	return numbers, true
+}
+
+// BinaryName returns the name of the release asset for a platform, e.g.
+// pres_linux_amd64
+func BinaryName(goos, goarch string) string {
+	name := "pres_" + goos + "_" + goarch
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// asset returns the release asset with the given name
+func (r *Release) asset(name string) (Asset, bool) {
+	for _, asset := range r.Assets {
+		if asset.Name == name {
+			return asset, true
+		}
+	}
+	return Asset{}, false
+}
+
+// Install downloads the release's binary for this platform, checks it
+// against the release's checksums, and replaces the executable at path
+// with it
+func (r *Release) Install(ctx context.Context, path string) error {
+	name := BinaryName(runtime.GOOS, runtime.GOARCH)
+	binary, ok := r.asset(name)
+	if !ok {
+		return fmt.Errorf("release %s has no binary for %s/%s (%s)", r.Tag, runtime.GOOS, runtime.GOARCH, name)
+	}
+	checksums, ok := r.asset(checksumsAsset)
+	if !ok {
+		return fmt.Errorf("release %s has no %s to verify the download with", r.Tag, checksumsAsset)
+	}
+
+	want, err := expectedChecksum(ctx, checksums.URL, name)
+	if err != nil {
+		return err
+	}
+
+	// Download next to the executable, so it can be renamed into place
+	file, err := os.CreateTemp(filepath.Dir(path), ".pres-upgrade-*")
+	if err != nil {
+		return fmt.Errorf("failed to download the new version: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	digest := sha256.New()
+	err = download(ctx, binary.URL, io.MultiWriter(file, digest))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", name, err)
+	}
+	if got := hex.EncodeToString(digest.Sum(nil)); got != want {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, downloaded %s", name, want, got)
+	}
+
+	if err := os.Chmod(file.Name(), 0755); err != nil {
+		return err
+	}
+	return replaceExecutable(path, file.Name())
+}
+
+// expectedChecksum reads the SHA-256 of the named binary from a checksums
+// file
+func expectedChecksum(ctx context.Context, url, name string) (string, error) {
+	var sb strings.Builder
+	if err := download(ctx, url, &sb); err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", checksumsAsset, err)
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(sb.String()))
+	for scanner.Scan() {
+		// sha256sum marks binary-mode files with a * before the name
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	return "", fmt.Errorf("%s lists no checksum for %s", checksumsAsset, name)
+}
+
+// download writes the file at url to w
+func download(ctx context.Context, url string, w io.Writer) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("server returned %s", resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// replaceExecutable moves the new binary over the executable at path.
+// Windows won't replace a running executable but lets it be renamed, so the
+// old one is moved aside first and removed when possible.
+func replaceExecutable(path, binary string) error {
+	old := path + ".old"
+	os.Remove(old)
+	if err := os.Rename(path, old); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	if err := os.Rename(binary, path); err != nil {
+		// Put the old version back
+		if restoreErr := os.Rename(old, path); restoreErr != nil {
+			return errors.Join(fmt.Errorf("failed to replace %s: %w", path, err), restoreErr)
+		}
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	os.Remove(old)
+	return nil
+}
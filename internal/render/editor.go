@@ -0,0 +1,437 @@
+package render
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/geoffjay/pres/baml_client/types"
+	"github.com/geoffjay/pres/internal/presentation"
+	"github.com/geoffjay/pres/internal/text"
+)
+
+// The parts of the slide editor that take keys
+const (
+	editList = iota
+	editTitle
+	editContent
+	editNotes
+)
+
+// editFieldNames label the editor's fields
+var editFieldNames = map[int]string{
+	editTitle:   "Title",
+	editContent: "Content",
+	editNotes:   "Notes",
+}
+
+// SlideEditor is a bubbletea model for editing a deck's slides by hand: a
+// list of the slides beside the title, content, and speaker notes of the
+// selected one. Slides can be added, deleted, and moved in the list. Locked
+// slides and slides shared from other decks can be moved but not changed.
+// The edits are kept in the editor; get them with Slides once it quits.
+type SlideEditor struct {
+	deck   string
+	slides []presentation.Slide
+
+	selected int
+	offset   int // The first slide shown in the list
+	focus    int
+
+	title   textinput.Model
+	content textarea.Model
+	notes   textarea.Model
+
+	// pending is the action waiting for y to confirm it: "delete" or
+	// "discard"
+	pending string
+	message string
+	dirty   bool
+	save    bool
+
+	width  int
+	height int
+}
+
+// NewSlideEditor creates an editor for a copy of the deck's slides, with the
+// first slide selected
+func NewSlideEditor(data *presentation.PresentationData) SlideEditor {
+	e := SlideEditor{
+		deck:    data.Metadata.Title,
+		slides:  data.Clone().Slides,
+		title:   textinput.New(),
+		content: newEditorArea(),
+		notes:   newEditorArea(),
+	}
+	e.title.Prompt = ""
+	e.title.Cursor.SetMode(cursor.CursorStatic)
+	e.load()
+	return e
+}
+
+// newEditorArea creates the input for a multi-line field
+func newEditorArea() textarea.Model {
+	area := textarea.New()
+	area.Prompt = ""
+	area.ShowLineNumbers = false
+	area.CharLimit = 0
+	area.MaxHeight = 0
+	area.FocusedStyle.CursorLine = lipgloss.NewStyle()
+	area.Cursor.SetMode(cursor.CursorStatic)
+	return area
+}
+
+// Slides returns the slides as edited
+func (e SlideEditor) Slides() []presentation.Slide {
+	return e.slides
+}
+
+// Save reports whether the editor was closed to save its edits, rather than
+// to discard them
+func (e SlideEditor) Save() bool {
+	return e.save
+}
+
+// Init does nothing; the editor waits for keys
+func (e SlideEditor) Init() tea.Cmd {
+	return nil
+}
+
+// Update moves through the slides and edits them
+func (e SlideEditor) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		e.width, e.height = msg.Width, msg.Height
+		e.resize()
+		return e, nil
+	case tea.KeyMsg:
+		e.message = ""
+		if e.pending != "" {
+			return e.confirm(msg.String() == "y")
+		}
+		if msg.String() == "ctrl+c" {
+			if !e.dirty {
+				return e, tea.Quit
+			}
+			e.pending = "discard"
+			return e, nil
+		}
+		if e.focus == editList {
+			return e.updateList(msg)
+		}
+		return e.updateField(msg)
+	}
+	return e, nil
+}
+
+// updateList handles keys while the slide list has focus
+func (e SlideEditor) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		e.save = true
+		return e, tea.Quit
+	case "up", "k":
+		e.selectSlide(e.selected - 1)
+	case "down", "j":
+		e.selectSlide(e.selected + 1)
+	case "home", "g":
+		e.selectSlide(0)
+	case "end", "G":
+		e.selectSlide(len(e.slides) - 1)
+	case "shift+up", "K":
+		e.move(-1)
+	case "shift+down", "J":
+		e.move(1)
+	case "enter", "tab", "e":
+		if err := e.editable(); err != nil {
+			e.message = err.Error()
+			break
+		}
+		e.focusField(editTitle)
+	case "a":
+		slide := presentation.Slide{Slide: types.Slide{Layout: "content"}}
+		at := min(e.selected+1, len(e.slides))
+		e.slides = slices.Insert(e.slides, at, slide)
+		e.dirty = true
+		e.selectSlide(at)
+		e.focusField(editTitle)
+	case "d", "delete":
+		if len(e.slides) == 0 {
+			break
+		}
+		if err := e.editable(); err != nil {
+			e.message = err.Error()
+			break
+		}
+		e.pending = "delete"
+	}
+	return e, nil
+}
+
+// updateField handles keys while one of the slide's fields has focus
+func (e SlideEditor) updateField(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		e.focusField(editList)
+		return e, nil
+	case "tab":
+		e.focusField(e.focus%editNotes + 1)
+		return e, nil
+	case "shift+tab":
+		e.focusField((e.focus+1)%editNotes + 1)
+		return e, nil
+	case "enter":
+		if e.focus == editTitle {
+			e.focusField(editContent)
+			return e, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	switch e.focus {
+	case editTitle:
+		e.title, cmd = e.title.Update(msg)
+	case editContent:
+		e.content, cmd = e.content.Update(msg)
+	case editNotes:
+		e.notes, cmd = e.notes.Update(msg)
+	}
+	e.store()
+	return e, cmd
+}
+
+// confirm carries out or cancels the action waiting to be confirmed
+func (e SlideEditor) confirm(yes bool) (tea.Model, tea.Cmd) {
+	pending := e.pending
+	e.pending = ""
+	if !yes {
+		return e, nil
+	}
+	switch pending {
+	case "delete":
+		e.slides = slices.Delete(e.slides, e.selected, e.selected+1)
+		e.dirty = true
+		e.selectSlide(min(e.selected, len(e.slides)-1))
+	case "discard":
+		return e, tea.Quit
+	}
+	return e, nil
+}
+
+// editable reports why the selected slide can't be changed, if it can't
+func (e SlideEditor) editable() error {
+	if len(e.slides) == 0 {
+		return fmt.Errorf("no slide to edit; press a to add one")
+	}
+	slide := e.slides[e.selected]
+	if slide.Locked {
+		return fmt.Errorf("slide %d is locked; unlock it with pres slide unlock", e.selected+1)
+	}
+	if slide.Ref != "" {
+		return fmt.Errorf("slide %d is shared from %s; change it there", e.selected+1, slide.Ref)
+	}
+	return nil
+}
+
+// selectSlide selects the slide at index, within the deck, and shows its
+// fields
+func (e *SlideEditor) selectSlide(index int) {
+	e.selected = max(min(index, len(e.slides)-1), 0)
+	e.scroll()
+	e.load()
+}
+
+// scroll keeps the selected slide in the part of the list shown
+func (e *SlideEditor) scroll() {
+	if rows := e.listRows(); e.selected < e.offset {
+		e.offset = e.selected
+	} else if e.selected >= e.offset+rows {
+		e.offset = e.selected - rows + 1
+	}
+}
+
+// move moves the selected slide up or down the deck by delta
+func (e *SlideEditor) move(delta int) {
+	to := e.selected + delta
+	if to < 0 || to >= len(e.slides) {
+		return
+	}
+	e.slides[e.selected], e.slides[to] = e.slides[to], e.slides[e.selected]
+	e.dirty = true
+	e.selectSlide(to)
+}
+
+// load fills the fields from the selected slide
+func (e *SlideEditor) load() {
+	var slide types.Slide
+	if len(e.slides) > 0 {
+		slide = e.slides[e.selected].Slide
+	}
+	e.title.SetValue(slide.Title)
+	e.content.SetValue(slide.Content)
+	e.notes.SetValue(slide.Notes)
+}
+
+// store copies the fields back to the selected slide
+func (e *SlideEditor) store() {
+	slide := &e.slides[e.selected].Slide
+	edited := *slide
+	edited.Title = e.title.Value()
+	edited.Content = e.content.Value()
+	edited.Notes = e.notes.Value()
+	if edited != *slide {
+		*slide = edited
+		e.dirty = true
+	}
+}
+
+// focusField gives focus to a field, or back to the slide list
+func (e *SlideEditor) focusField(field int) {
+	e.focus = field
+	e.title.Blur()
+	e.content.Blur()
+	e.notes.Blur()
+	switch field {
+	case editTitle:
+		e.title.Focus()
+	case editContent:
+		e.content.Focus()
+	case editNotes:
+		e.notes.Focus()
+	}
+}
+
+// listWidth is the width of the slide list
+func (e SlideEditor) listWidth() int {
+	return min(max(e.width/3, 24), 40)
+}
+
+// listRows is how many slides the list shows at once
+func (e SlideEditor) listRows() int {
+	return max(e.height-6, 1)
+}
+
+// resize fits the fields to the terminal, the content taking two thirds of
+// the height the fields share
+func (e *SlideEditor) resize() {
+	width := max(e.width-e.listWidth()-3, 20)
+	e.title.Width = width
+	e.content.SetWidth(width)
+	e.notes.SetWidth(width)
+
+	// The header, labels, layout line, and key hints take ten rows
+	rows := max(e.height-10, 6)
+	e.content.SetHeight(rows * 2 / 3)
+	e.notes.SetHeight(rows - rows*2/3)
+	e.scroll()
+}
+
+// View renders the slide list, the selected slide's fields, and the key
+// hints
+func (e SlideEditor) View() string {
+	if e.width == 0 {
+		return ""
+	}
+
+	header := titleStyle.Render(e.deck)
+	if e.dirty {
+		header += mutedStyle.Render(" • modified")
+	}
+
+	list := lipgloss.NewStyle().Width(e.listWidth()).Render(e.listView())
+	view := lipgloss.JoinHorizontal(lipgloss.Top, list, " │ ", e.fieldsView())
+	return header + "\n\n" + view + "\n\n" + e.status()
+}
+
+// listView renders the slides around the selected one
+func (e SlideEditor) listView() string {
+	if len(e.slides) == 0 {
+		return mutedStyle.Render("No slides; press a to add one")
+	}
+
+	var b strings.Builder
+	end := min(e.offset+e.listRows(), len(e.slides))
+	for i := e.offset; i < end; i++ {
+		slide := e.slides[i]
+		title := slide.Title
+		if title == "" {
+			title = "(untitled)"
+		}
+		var marks string
+		switch {
+		case slide.Locked:
+			marks = " 🔒"
+		case slide.Ref != "":
+			marks = " ↗"
+		}
+		line := text.Truncate(fmt.Sprintf("%2d. %s", i+1, title), e.listWidth()-2-lipgloss.Width(marks)) + marks
+		switch {
+		case i == e.selected:
+			line = selectedStyle.Render("› " + line)
+		case slide.Hidden:
+			line = mutedStyle.Render("  " + line)
+		default:
+			line = "  " + line
+		}
+		b.WriteString(line)
+		if i < end-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// fieldsView renders the fields of the selected slide
+func (e SlideEditor) fieldsView() string {
+	if len(e.slides) == 0 {
+		return ""
+	}
+
+	slide := e.slides[e.selected]
+	label := func(field int) string {
+		if e.focus == field {
+			return selectedStyle.Render(editFieldNames[field])
+		}
+		return headingStyle.Render(editFieldNames[field])
+	}
+
+	about := fmt.Sprintf("Slide %d of %d · %s", e.selected+1, len(e.slides), layoutName(slide.Layout))
+	if slide.Hidden {
+		about += " · hidden"
+	}
+	if err := e.editable(); err != nil {
+		about += " · read-only"
+	}
+
+	return strings.Join([]string{
+		headerStyle.Render(about),
+		label(editTitle),
+		e.title.View(),
+		label(editContent),
+		e.content.View(),
+		label(editNotes),
+		e.notes.View(),
+	}, "\n")
+}
+
+// status renders the question waiting for an answer, the last message, or
+// the keys of the part with focus
+func (e SlideEditor) status() string {
+	switch {
+	case e.pending == "delete":
+		return behindStyle.Render(fmt.Sprintf("Delete slide %d? (y/n)", e.selected+1))
+	case e.pending == "discard":
+		return behindStyle.Render("Quit and discard your changes? (y/n)")
+	case e.message != "":
+		return behindStyle.Render(e.message)
+	case e.focus == editList:
+		return mutedStyle.Render("↑/↓ select · enter edit · a add · d delete · K/J move · q save and quit · ctrl+c discard")
+	default:
+		return mutedStyle.Render("tab next field · esc back to slides · ctrl+c discard")
+	}
+}
@@ -0,0 +1,150 @@
+package render
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color/palette"
+	"image/draw"
+	"image/png"
+	"os"
+	"strings"
+)
+
+// ImageProtocol is a way of showing images inline in a terminal
+type ImageProtocol string
+
+const (
+	ImageProtocolNone  ImageProtocol = "none"
+	ImageProtocolITerm ImageProtocol = "iterm"
+	ImageProtocolSixel ImageProtocol = "sixel"
+)
+
+// DetectImageProtocol guesses which inline image protocol the terminal
+// supports from its environment. PRES_IMAGE_PROTOCOL (iterm, sixel, or none)
+// overrides the guess for terminals that are not recognized.
+func DetectImageProtocol() ImageProtocol {
+	switch ImageProtocol(strings.ToLower(os.Getenv("PRES_IMAGE_PROTOCOL"))) {
+	case ImageProtocolITerm:
+		return ImageProtocolITerm
+	case ImageProtocolSixel:
+		return ImageProtocolSixel
+	case ImageProtocolNone:
+		return ImageProtocolNone
+	}
+
+	// Multiplexers swallow the escape sequences
+	if os.Getenv("TMUX") != "" || strings.HasPrefix(os.Getenv("TERM"), "screen") {
+		return ImageProtocolNone
+	}
+
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode":
+		return ImageProtocolITerm
+	}
+	if os.Getenv("LC_TERMINAL") == "iTerm2" {
+		return ImageProtocolITerm
+	}
+
+	term := os.Getenv("TERM")
+	for _, name := range []string{"foot", "mlterm", "yaft", "sixel"} {
+		if strings.Contains(term, name) {
+			return ImageProtocolSixel
+		}
+	}
+
+	return ImageProtocolNone
+}
+
+// InlineImage returns the escape sequence that shows img in the terminal with
+// the given protocol, or "" when the protocol cannot show images
+func InlineImage(img image.Image, protocol ImageProtocol) (string, error) {
+	switch protocol {
+	case ImageProtocolITerm:
+		return itermImage(img)
+	case ImageProtocolSixel:
+		return sixelImage(img), nil
+	}
+	return "", nil
+}
+
+// itermImage encodes an image with the iTerm2 inline image protocol
+func itermImage(img image.Image) (string, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("\x1b]1337;File=inline=1;size=%d;preserveAspectRatio=1:%s\a\n",
+		buf.Len(), base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+}
+
+// sixelImage encodes an image as sixels, the DEC graphics format. Colors are
+// reduced to the Plan 9 palette.
+func sixelImage(img image.Image) string {
+	bounds := img.Bounds()
+	paletted := image.NewPaletted(image.Rect(0, 0, bounds.Dx(), bounds.Dy()), palette.Plan9)
+	draw.FloydSteinberg.Draw(paletted, paletted.Bounds(), img, bounds.Min)
+	width, height := paletted.Bounds().Dx(), paletted.Bounds().Dy()
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\x1bPq\"1;1;%d;%d", width, height)
+	for i, c := range paletted.Palette {
+		r, g, b, _ := c.RGBA()
+		fmt.Fprintf(&sb, "#%d;2;%d;%d;%d", i, r*100/0xffff, g*100/0xffff, b*100/0xffff)
+	}
+
+	// Each band covers six rows; every color used in the band is drawn in a
+	// pass over its columns, returning to the start of the band in between
+	for top := 0; top < height; top += 6 {
+		used := map[uint8]bool{}
+		var order []uint8
+		for y := top; y < top+6 && y < height; y++ {
+			for x := 0; x < width; x++ {
+				if c := paletted.ColorIndexAt(x, y); !used[c] {
+					used[c] = true
+					order = append(order, c)
+				}
+			}
+		}
+
+		for n, c := range order {
+			if n > 0 {
+				sb.WriteByte('$')
+			}
+			fmt.Fprintf(&sb, "#%d", c)
+
+			var run byte
+			count := 0
+			flush := func() {
+				switch {
+				case count == 0:
+				case count > 3:
+					fmt.Fprintf(&sb, "!%d%c", count, run)
+				default:
+					sb.WriteString(strings.Repeat(string(run), count))
+				}
+			}
+			for x := 0; x < width; x++ {
+				var bits byte
+				for row := 0; row < 6 && top+row < height; row++ {
+					if paletted.ColorIndexAt(x, top+row) == c {
+						bits |= 1 << row
+					}
+				}
+				char := 63 + bits
+				if char == run {
+					count++
+					continue
+				}
+				flush()
+				run, count = char, 1
+			}
+			flush()
+		}
+		sb.WriteByte('-')
+	}
+
+	sb.WriteString("\x1b\\\n")
+	return sb.String()
+}
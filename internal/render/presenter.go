@@ -0,0 +1,195 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// presenterWidth is the widest slides are rendered when presenting
+const presenterWidth = 96
+
+var (
+	notesStyle = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), true, false, false, false).
+			BorderForeground(lipgloss.Color("62")).
+			Padding(0, 2)
+
+	behindStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("203")).
+			Bold(true)
+)
+
+// presenterTick advances the presenter's clock
+type presenterTick time.Time
+
+// Presenter is a bubbletea model that presents a deck in the terminal, one
+// slide at a time, with a slide counter, an elapsed-time clock, and speaker
+// notes that can be toggled
+type Presenter struct {
+	title  string
+	slides []presentation.Slide
+	// main is the number of slides in the main flow, which hidden slides
+	// follow
+	main int
+	// ends are when each slide of the main flow should be done, counted from
+	// the start of the talk
+	ends []time.Duration
+
+	current int
+	notes   bool
+	jump    string
+
+	width   int
+	height  int
+	started time.Time
+	elapsed time.Duration
+}
+
+// NewPresenter creates a presenter for a deck readied with Generator.Prepare,
+// starting at the slide at index start
+func NewPresenter(data *presentation.PresentationData, start int, notes bool) Presenter {
+	p := Presenter{
+		title:   data.Metadata.Title,
+		slides:  data.Slides,
+		current: max(min(start, len(data.Slides)-1), 0),
+		notes:   notes,
+		started: time.Now(),
+	}
+
+	var elapsed time.Duration
+	for _, slide := range data.Slides {
+		if slide.Hidden {
+			break
+		}
+		elapsed += slide.GetTimeBudget()
+		p.ends = append(p.ends, elapsed)
+		p.main++
+	}
+	return p
+}
+
+// Init starts the clock
+func (p Presenter) Init() tea.Cmd {
+	return p.tick()
+}
+
+func (p Presenter) tick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg { return presenterTick(t) })
+}
+
+// Update handles navigation keys, window resizes, and clock ticks
+func (p Presenter) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.width, p.height = msg.Width, msg.Height
+	case presenterTick:
+		p.elapsed = time.Time(msg).Sub(p.started)
+		return p, p.tick()
+	case tea.KeyMsg:
+		key := msg.String()
+		if len(key) == 1 && key[0] >= '0' && key[0] <= '9' {
+			p.jump += key
+			return p, nil
+		}
+
+		switch key {
+		case "q", "esc", "ctrl+c":
+			return p, tea.Quit
+		case "enter":
+			if p.jump != "" {
+				if number, err := strconv.Atoi(p.jump); err == nil && number >= 1 {
+					p.current = min(number, len(p.slides)) - 1
+				}
+				p.jump = ""
+				return p, nil
+			}
+			p.current = min(p.current+1, len(p.slides)-1)
+		case "right", "l", "j", "down", "pgdown", " ":
+			p.current = min(p.current+1, len(p.slides)-1)
+		case "left", "h", "k", "up", "pgup", "backspace":
+			p.current = max(p.current-1, 0)
+		case "home", "g":
+			p.current = 0
+		case "end", "G":
+			p.current = len(p.slides) - 1
+		case "n":
+			p.notes = !p.notes
+		case "r":
+			p.started, p.elapsed = time.Now(), 0
+		}
+		p.jump = ""
+	}
+	return p, nil
+}
+
+// View renders the current slide centered in the terminal, the speaker notes
+// when shown, and a status line
+func (p Presenter) View() string {
+	if p.width == 0 || len(p.slides) == 0 {
+		return ""
+	}
+	slide := p.slides[p.current]
+
+	var notes string
+	if p.notes {
+		text := strings.TrimSpace(slide.Notes)
+		if text == "" {
+			text = mutedStyle.Render("(no notes)")
+		}
+		lines := max(p.height/3, 3)
+		notes = notesStyle.Width(p.width).Render(limitLines(text, lines-2))
+	}
+
+	// The status line takes the last row
+	area := p.height - 1
+	if notes != "" {
+		area -= lipgloss.Height(notes)
+	}
+	width := min(p.width-8, presenterWidth)
+	body := Slide(slide, Options{Width: width, MaxLines: max(area-6, 1)})
+	// Pad every line to the same width so the slide is centered as a block
+	body = lipgloss.NewStyle().Width(width).Render(body)
+	view := lipgloss.Place(p.width, max(area, 1), lipgloss.Center, lipgloss.Center, body)
+
+	if notes != "" {
+		view += "\n" + notes
+	}
+	return view + "\n" + p.status()
+}
+
+// status renders the slide counter, the key hints, and the clock, which
+// turns red when the talk is behind its time budget
+func (p Presenter) status() string {
+	counter := fmt.Sprintf(" %d / %d", p.current+1, p.main)
+	if p.current >= p.main {
+		counter = fmt.Sprintf(" backup %d / %d", p.current-p.main+1, len(p.slides)-p.main)
+	}
+	if p.jump != "" {
+		counter = " go to slide " + p.jump
+	}
+
+	clock := "⏱ " + presentation.FormatClock(p.elapsed)
+	if p.main > 0 {
+		clock += " / " + presentation.FormatClock(p.ends[p.main-1])
+	}
+	clock += " "
+	if p.current < p.main && p.elapsed > p.ends[p.current] {
+		clock = behindStyle.Render(clock)
+	} else {
+		clock = headerStyle.Render(clock)
+	}
+
+	hints := mutedStyle.Render("←/→ navigate · n notes · r reset clock · q quit")
+	gap := p.width - lipgloss.Width(counter) - lipgloss.Width(hints) - lipgloss.Width(clock)
+	if gap < 2 {
+		hints, gap = "", p.width-lipgloss.Width(counter)-lipgloss.Width(clock)
+	}
+	left, right := max(gap/2, 1), max(gap-gap/2, 1)
+	return headerStyle.Render(counter) + strings.Repeat(" ", left) + hints + strings.Repeat(" ", right) + clock
+}
@@ -0,0 +1,163 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// Options controls how slides are rendered in the terminal
+type Options struct {
+	Width    int // Total width available, including the frame
+	MaxLines int // Maximum number of content lines, 0 for no limit
+}
+
+var (
+	frameStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("62")).
+			Padding(0, 1)
+
+	headerStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241"))
+
+	titleStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("205"))
+
+	headingStyle = lipgloss.NewStyle().
+			Bold(true)
+
+	mutedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241")).
+			Italic(true)
+)
+
+// Card renders a slide inside a frame headed by its number and layout
+func Card(slide presentation.Slide, number int, opts Options) string {
+	width := opts.Width
+	if width <= 0 {
+		width = 72
+	}
+
+	// Leave room for the border and padding
+	inner := width - 4
+
+	label := fmt.Sprintf("Slide %d · %s", number, layoutName(slide.Layout))
+	if slide.Hidden {
+		label += " · hidden"
+	}
+	if slide.Locked {
+		label += " · locked"
+	}
+	header := headerStyle.Render(label)
+	body := Slide(slide, Options{Width: inner, MaxLines: opts.MaxLines})
+
+	return frameStyle.Width(width - 2).Render(header + "\n\n" + body)
+}
+
+// Slide renders the title and content of a slide according to its layout
+func Slide(slide presentation.Slide, opts Options) string {
+	width := opts.Width
+	if width <= 0 {
+		width = 68
+	}
+
+	var parts []string
+
+	switch slide.Layout {
+	case "title":
+		center := lipgloss.NewStyle().Width(width).Align(lipgloss.Center)
+		if slide.Title != "" {
+			parts = append(parts, center.Render(titleStyle.Render(strings.ToUpper(slide.Title))))
+		}
+		if body := limitLines(formatMarkdown(slide.Content), opts.MaxLines); body != "" {
+			parts = append(parts, center.Render(body))
+		}
+	case "two-column":
+		if slide.Title != "" {
+			parts = append(parts, titleStyle.Render(slide.Title))
+		}
+		columns := presentation.SplitColumns(slide.Content)
+		colWidth := (width - 3) / 2
+		var rendered []string
+		for i, col := range columns {
+			text := limitLines(formatMarkdown(strings.TrimSpace(col)), opts.MaxLines)
+			style := lipgloss.NewStyle().Width(colWidth)
+			if i > 0 {
+				style = style.MarginLeft(3)
+			}
+			rendered = append(rendered, style.Render(text))
+		}
+		parts = append(parts, lipgloss.JoinHorizontal(lipgloss.Top, rendered...))
+	case "blank":
+		if body := limitLines(formatMarkdown(slide.Content), opts.MaxLines); body != "" {
+			parts = append(parts, lipgloss.NewStyle().Width(width).Render(body))
+		}
+	default:
+		if slide.Title != "" {
+			parts = append(parts, titleStyle.Render(slide.Title))
+		}
+		if body := limitLines(formatMarkdown(slide.Content), opts.MaxLines); body != "" {
+			parts = append(parts, lipgloss.NewStyle().Width(width).Render(body))
+		}
+	}
+
+	if slide.Ref != "" {
+		parts = append(parts, mutedStyle.Render("→ "+slide.Ref))
+	}
+
+	if len(parts) == 0 {
+		return mutedStyle.Render("(empty slide)")
+	}
+
+	return strings.Join(parts, "\n\n")
+}
+
+// formatMarkdown applies light terminal styling to markdown content
+func formatMarkdown(content string) string {
+	lines := strings.Split(strings.TrimSpace(content), "\n")
+	inCode := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			lines[i] = mutedStyle.Render(trimmed)
+			continue
+		}
+		if inCode {
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			lines[i] = headingStyle.Render(strings.TrimSpace(strings.TrimLeft(trimmed, "#")))
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			lines[i] = indent + "• " + trimmed[2:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// limitLines truncates text to at most max lines, 0 meaning no limit
+func limitLines(text string, max int) string {
+	if max <= 0 {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) <= max {
+		return text
+	}
+	return strings.Join(lines[:max], "\n") + "\n" + mutedStyle.Render(fmt.Sprintf("… %d more lines", len(lines)-max))
+}
+
+// layoutName returns the display name of a layout
+func layoutName(layout string) string {
+	if layout == "" {
+		return "content"
+	}
+	return layout
+}
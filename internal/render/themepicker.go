@@ -0,0 +1,114 @@
+package render
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// pickerPreviewWidth is the widest the theme picker renders its preview
+const pickerPreviewWidth = 72
+
+var selectedStyle = lipgloss.NewStyle().
+	Bold(true).
+	Foreground(lipgloss.Color("205"))
+
+// ThemePicker is a bubbletea model for choosing a deck's theme: a list of
+// the reveal.js themes beside a preview of the deck's slides in the theme
+// under the cursor
+type ThemePicker struct {
+	themes  []Theme
+	current string
+	slides  []presentation.Slide
+
+	cursor int
+	slide  int
+	chosen string
+
+	width  int
+	height int
+}
+
+// NewThemePicker creates a theme picker previewing the given slides, with the
+// cursor on the deck's current theme
+func NewThemePicker(slides []presentation.Slide, current string) ThemePicker {
+	p := ThemePicker{themes: Themes(), current: current, slides: slides}
+	p.cursor = max(slices.IndexFunc(p.themes, func(t Theme) bool { return t.Name == current }), 0)
+	return p
+}
+
+// Chosen returns the theme picked with enter, or "" when the picker was
+// cancelled
+func (p ThemePicker) Chosen() string {
+	return p.chosen
+}
+
+// Init does nothing; the picker waits for keys
+func (p ThemePicker) Init() tea.Cmd {
+	return nil
+}
+
+// Update moves through the themes and preview slides, and picks or cancels
+func (p ThemePicker) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		p.width, p.height = msg.Width, msg.Height
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return p, tea.Quit
+		case "enter":
+			p.chosen = p.themes[p.cursor].Name
+			return p, tea.Quit
+		case "up", "k":
+			p.cursor = (p.cursor + len(p.themes) - 1) % len(p.themes)
+		case "down", "j", "tab":
+			p.cursor = (p.cursor + 1) % len(p.themes)
+		case "right", "l", " ":
+			p.slide = min(p.slide+1, len(p.slides)-1)
+		case "left", "h":
+			p.slide = max(p.slide-1, 0)
+		}
+	}
+	return p, nil
+}
+
+// View renders the theme list, the preview, and the key hints
+func (p ThemePicker) View() string {
+	if p.width == 0 {
+		return ""
+	}
+
+	var list strings.Builder
+	list.WriteString(headerStyle.Render("Themes") + "\n\n")
+	for i, theme := range p.themes {
+		name := fmt.Sprintf("%-10s", theme.Name)
+		if i == p.cursor {
+			name = selectedStyle.Render("› " + name)
+		} else {
+			name = "  " + name
+		}
+		list.WriteString(name + " " + theme.Swatch())
+		if theme.Name == p.current {
+			list.WriteString(mutedStyle.Render(" current"))
+		}
+		list.WriteString("\n")
+	}
+
+	theme := p.themes[p.cursor]
+	width := max(min(p.width-lipgloss.Width(list.String())-4, pickerPreviewWidth), 20)
+	preview := headerStyle.Render(theme.Description) + "\n\n"
+	if len(p.slides) > 0 {
+		lines := max(p.height-12, 3)
+		preview += ThemedSlide(p.slides[p.slide], theme, Options{Width: width, MaxLines: lines})
+		preview += "\n" + headerStyle.Render(fmt.Sprintf("Slide %d / %d", p.slide+1, len(p.slides)))
+	}
+
+	view := lipgloss.JoinHorizontal(lipgloss.Top, list.String(), "    ", preview)
+	hints := mutedStyle.Render("↑/↓ theme · ←/→ slide · enter choose · q cancel")
+	return view + "\n\n" + hints
+}
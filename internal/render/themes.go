@@ -0,0 +1,140 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// Theme approximates a reveal.js theme in the terminal: its colors and a
+// short description of its look
+type Theme struct {
+	Name        string
+	Description string
+	Background  string
+	Text        string
+	Heading     string
+	Link        string
+}
+
+// themes are the terminal approximations of the reveal.js themes, from the
+// colors of their stylesheets
+var themes = map[string]Theme{
+	"black":     {Description: "Dark gray background, white text, blue links", Background: "#191919", Text: "#ffffff", Heading: "#ffffff", Link: "#42affa"},
+	"white":     {Description: "White background, dark text, blue links", Background: "#ffffff", Text: "#222222", Heading: "#222222", Link: "#2a76dd"},
+	"league":    {Description: "Gray radial background, League Gothic headings", Background: "#2b2b2b", Text: "#eeeeee", Heading: "#eeeeee", Link: "#13daec"},
+	"beige":     {Description: "Beige radial background, dark text", Background: "#f7f3de", Text: "#333333", Heading: "#333333", Link: "#8b743d"},
+	"sky":       {Description: "Light blue background, dark text", Background: "#add9e4", Text: "#333333", Heading: "#333333", Link: "#3b759e"},
+	"night":     {Description: "Black background, thick white headings, orange links", Background: "#111111", Text: "#eeeeee", Heading: "#eeeeee", Link: "#e7ad52"},
+	"serif":     {Description: "Cappuccino background, gray text, serif fonts", Background: "#f0f1eb", Text: "#000000", Heading: "#383d3d", Link: "#51483d"},
+	"simple":    {Description: "White background, black text, plain headings", Background: "#ffffff", Text: "#000000", Heading: "#000000", Link: "#00008b"},
+	"solarized": {Description: "Cream background, Solarized colors", Background: "#fdf6e3", Text: "#657b83", Heading: "#586e75", Link: "#268bd2"},
+}
+
+// Themes returns the terminal approximations of the reveal.js themes, in the
+// order of presentation.GetRevealJSThemes
+func Themes() []Theme {
+	names := presentation.GetRevealJSThemes()
+	list := make([]Theme, 0, len(names))
+	for _, name := range names {
+		list = append(list, LookupTheme(name))
+	}
+	return list
+}
+
+// LookupTheme returns the terminal approximation of a reveal.js theme,
+// falling back to black's colors for a theme without one
+func LookupTheme(name string) Theme {
+	theme, ok := themes[name]
+	if !ok {
+		theme = themes["black"]
+		theme.Description = "Unknown theme"
+	}
+	theme.Name = name
+	return theme
+}
+
+// Swatch renders a sample of the theme's heading, text, and link colors on
+// its background
+func (t Theme) Swatch() string {
+	bg := lipgloss.Color(t.Background)
+	segment := func(text, color string, bold bool) string {
+		return lipgloss.NewStyle().Background(bg).Foreground(lipgloss.Color(color)).Bold(bold).Render(text)
+	}
+	return segment(" Aa ", t.Heading, true) + segment("text ", t.Text, false) + segment("link ", t.Link, false)
+}
+
+// ThemedSlide renders a slide in the colors of a theme, as a preview of how
+// it looks in the generated deck. Two-column content is shown one column
+// after the other.
+func ThemedSlide(slide presentation.Slide, theme Theme, opts Options) string {
+	width := opts.Width
+	if width <= 0 {
+		width = 68
+	}
+
+	bg := lipgloss.Color(theme.Background)
+	block := lipgloss.NewStyle().Background(bg).Width(width).Padding(0, 3)
+	heading := block.Foreground(lipgloss.Color(theme.Heading)).Bold(true)
+	text := block.Foreground(lipgloss.Color(theme.Text))
+	blank := block.Render("")
+
+	content := slide.Content
+	if slide.Layout == "two-column" {
+		content = strings.Join(presentation.SplitColumns(content), "\n")
+	}
+	body := plainMarkdown(content)
+	if lines := strings.Split(body, "\n"); opts.MaxLines > 0 && len(lines) > opts.MaxLines {
+		body = strings.Join(lines[:opts.MaxLines], "\n") + "\n…"
+	}
+
+	parts := []string{blank}
+	switch slide.Layout {
+	case "title":
+		if slide.Title != "" {
+			parts = append(parts, heading.Align(lipgloss.Center).Render(strings.ToUpper(slide.Title)))
+		}
+		if body != "" {
+			parts = append(parts, blank, text.Align(lipgloss.Center).Render(body))
+		}
+	default:
+		if slide.Title != "" {
+			parts = append(parts, heading.Render(slide.Title))
+		}
+		if body != "" {
+			if slide.Title != "" {
+				parts = append(parts, blank)
+			}
+			parts = append(parts, text.Render(body))
+		}
+	}
+	parts = append(parts, blank)
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+// plainMarkdown formats markdown content for the terminal without styling,
+// which would break the theme's background. Code fences are dropped and the
+// code itself kept.
+func plainMarkdown(content string) string {
+	var lines []string
+	inCode := false
+	for _, line := range strings.Split(strings.TrimSpace(content), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		switch {
+		case inCode:
+		case strings.HasPrefix(trimmed, "#"):
+			line = strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			line = indent + "• " + trimmed[2:]
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
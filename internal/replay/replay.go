@@ -0,0 +1,166 @@
+// Package replay records AI calls to JSON fixtures and serves them back, for
+// deterministic integration tests and offline demos.
+//
+// The mode is set with the PRES_AI_MODE environment variable:
+//
+//	live    call the model (default)
+//	record  call the model and save each response as a fixture
+//	replay  serve responses from fixtures without calling the model
+//
+// Fixtures are stored in PRES_AI_FIXTURES (default testdata/ai-fixtures), one
+// file per call named after the function and a hash of its arguments.
+package replay
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Mode selects whether AI calls reach the model, are recorded, or are
+// replayed from fixtures
+type Mode string
+
+const (
+	// ModeLive calls the model
+	ModeLive Mode = "live"
+	// ModeRecord calls the model and saves the response as a fixture
+	ModeRecord Mode = "record"
+	// ModeReplay serves the response from a fixture
+	ModeReplay Mode = "replay"
+)
+
+// DefaultDir is where fixtures are stored when PRES_AI_FIXTURES is unset
+const DefaultDir = "testdata/ai-fixtures"
+
+// ErrNoFixture is returned in replay mode when no fixture matches a call
+var ErrNoFixture = errors.New("no recorded response")
+
+// Args are the named arguments of an AI call
+type Args map[string]any
+
+// Fixture is a recorded AI call
+type Fixture struct {
+	Function string          `json:"function"`
+	Args     Args            `json:"args"`
+	Result   json.RawMessage `json:"result"`
+}
+
+// CurrentMode returns the mode set by PRES_AI_MODE
+func CurrentMode() (Mode, error) {
+	switch mode := Mode(strings.ToLower(os.Getenv("PRES_AI_MODE"))); mode {
+	case "", ModeLive:
+		return ModeLive, nil
+	case ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid PRES_AI_MODE %q: expected live, record, or replay", mode)
+	}
+}
+
+// Dir returns the fixture directory set by PRES_AI_FIXTURES
+func Dir() string {
+	if dir := os.Getenv("PRES_AI_FIXTURES"); dir != "" {
+		return dir
+	}
+	return DefaultDir
+}
+
+// Call runs an AI call according to the current mode. In replay mode the
+// result is read from the fixture recorded for the same function and
+// arguments, or else from the function's default fixture (<function>.json),
+// which lets demos answer any input.
+func Call[T any](function string, args Args, call func() (T, error)) (T, error) {
+	var zero T
+
+	mode, err := CurrentMode()
+	if err != nil {
+		return zero, err
+	}
+
+	switch mode {
+	case ModeReplay:
+		return load[T](function, args)
+	case ModeRecord:
+		result, err := call()
+		if err != nil {
+			return zero, err
+		}
+		if err := save(function, args, result); err != nil {
+			return zero, err
+		}
+		return result, nil
+	default:
+		return call()
+	}
+}
+
+// FixturePath returns the path of the fixture for a call
+func FixturePath(function string, args Args) (string, error) {
+	key, err := json.Marshal(args)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode arguments of %s: %w", function, err)
+	}
+	sum := sha256.Sum256(key)
+	return filepath.Join(Dir(), function+"-"+hex.EncodeToString(sum[:6])+".json"), nil
+}
+
+// load reads the result of a call from its fixture
+func load[T any](function string, args Args) (T, error) {
+	var result T
+
+	path, err := FixturePath(function, args)
+	if err != nil {
+		return result, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		data, err = os.ReadFile(filepath.Join(Dir(), function+".json"))
+		if errors.Is(err, os.ErrNotExist) {
+			return result, fmt.Errorf("%w for %s in %s (record one with PRES_AI_MODE=record)", ErrNoFixture, function, Dir())
+		}
+	}
+	if err != nil {
+		return result, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var fixture Fixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		return result, fmt.Errorf("invalid fixture for %s: %w", function, err)
+	}
+	if err := json.Unmarshal(fixture.Result, &result); err != nil {
+		return result, fmt.Errorf("invalid result in fixture for %s: %w", function, err)
+	}
+	return result, nil
+}
+
+// save writes the result of a call to its fixture
+func save(function string, args Args, result any) error {
+	path, err := FixturePath(function, args)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode result of %s: %w", function, err)
+	}
+	data, err := json.MarshalIndent(Fixture{Function: function, Args: args, Result: encoded}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fixture: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+// Package telemetry records anonymous usage metrics for people who opt in:
+// which command ran, how long it took, and whether it succeeded. Arguments,
+// flag values, file names, and presentation content are never recorded.
+//
+// Events are queued in the user's config directory and sent in batches to
+// Endpoint. Telemetry is off until turned on with pres telemetry on, and the
+// DO_NOT_TRACK and PRES_TELEMETRY=off environment variables turn it off
+// whatever was chosen.
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/geoffjay/pres/internal/release"
+)
+
+// Endpoint is where queued events are sent. It is set when building a
+// release, with -ldflags "-X github.com/geoffjay/pres/internal/telemetry.Endpoint=<url>";
+// without it events are only queued.
+var Endpoint string
+
+const (
+	// consentFile records whether the user opted in
+	consentFile = "telemetry.json"
+	// queueFile holds the events not sent yet, one JSON object per line
+	queueFile = "telemetry-queue.jsonl"
+
+	// flushEvents and flushAge are how many events, or how old the oldest
+	// of them, make the queue due to be sent
+	flushEvents = 25
+	flushAge    = 24 * time.Hour
+	// maxQueued caps the queue when events cannot be sent
+	maxQueued = 500
+)
+
+// Consent is the user's choice about telemetry
+type Consent struct {
+	Enabled bool `json:"enabled"`
+	// ID anonymously tells one installation's events from another's. It is
+	// random, created on opting in, and discarded on opting out.
+	ID      string    `json:"id,omitempty"`
+	Changed time.Time `json:"changed"`
+}
+
+// Event is one command run
+type Event struct {
+	ID         string `json:"id"`
+	Command    string `json:"command"`
+	DurationMS int64  `json:"duration_ms"`
+	Success    bool   `json:"success"`
+	Version    string `json:"version"`
+	OS         string `json:"os"`
+	Arch       string `json:"arch"`
+	// Time is rounded down to the hour
+	Time time.Time `json:"time"`
+}
+
+// Recorder keeps the consent and event queue in a directory
+type Recorder struct {
+	dir string
+}
+
+// NewRecorder creates a recorder keeping its files in dir, e.g.
+// ~/.config/pres
+func NewRecorder(dir string) *Recorder {
+	return &Recorder{dir: dir}
+}
+
+// QueuePath returns the file events are queued in
+func (r *Recorder) QueuePath() string {
+	return filepath.Join(r.dir, queueFile)
+}
+
+// Consent returns the user's choice; a user who never chose has not opted in
+func (r *Recorder) Consent() (Consent, error) {
+	var consent Consent
+	raw, err := os.ReadFile(filepath.Join(r.dir, consentFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return consent, nil
+	}
+	if err != nil {
+		return consent, fmt.Errorf("failed to read telemetry settings: %w", err)
+	}
+	if err := json.Unmarshal(raw, &consent); err != nil {
+		return consent, fmt.Errorf("invalid telemetry settings: %w", err)
+	}
+	return consent, nil
+}
+
+// Enable opts in, keeping the installation's ID if it already has one
+func (r *Recorder) Enable() (Consent, error) {
+	consent, err := r.Consent()
+	if err != nil {
+		return consent, err
+	}
+	if consent.ID == "" {
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return consent, err
+		}
+		consent.ID = hex.EncodeToString(id)
+	}
+	consent.Enabled, consent.Changed = true, time.Now()
+	return consent, r.saveConsent(consent)
+}
+
+// Disable opts out, discarding the installation's ID and any events not
+// sent yet
+func (r *Recorder) Disable() error {
+	if err := os.Remove(r.QueuePath()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove queued events: %w", err)
+	}
+	return r.saveConsent(Consent{Changed: time.Now()})
+}
+
+// saveConsent records the user's choice
+func (r *Recorder) saveConsent(consent Consent) error {
+	raw, err := json.MarshalIndent(consent, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(r.dir, consentFile), raw, 0644); err != nil {
+		return fmt.Errorf("failed to save telemetry settings: %w", err)
+	}
+	return nil
+}
+
+// DisabledByEnv returns the environment variable that turns telemetry off,
+// or "" when none does
+func DisabledByEnv() string {
+	if value := os.Getenv("DO_NOT_TRACK"); value != "" && value != "0" {
+		return "DO_NOT_TRACK"
+	}
+	switch strings.ToLower(os.Getenv("PRES_TELEMETRY")) {
+	case "off", "0", "false", "no":
+		return "PRES_TELEMETRY"
+	}
+	return ""
+}
+
+// Record queues an event for a command run, when the user opted in
+func (r *Recorder) Record(command string, duration time.Duration, success bool) error {
+	if DisabledByEnv() != "" {
+		return nil
+	}
+	consent, err := r.Consent()
+	if err != nil || !consent.Enabled {
+		return err
+	}
+
+	events, err := r.Pending()
+	if err != nil {
+		return err
+	}
+	events = append(events, Event{
+		ID:         consent.ID,
+		Command:    command,
+		DurationMS: duration.Milliseconds(),
+		Success:    success,
+		Version:    release.Current(),
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Time:       time.Now().UTC().Truncate(time.Hour),
+	})
+	if extra := len(events) - maxQueued; extra > 0 {
+		events = events[extra:]
+	}
+	return r.writeQueue(events)
+}
+
+// Pending returns the queued events, oldest first
+func (r *Recorder) Pending() ([]Event, error) {
+	file, err := os.Open(r.QueuePath())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read queued events: %w", err)
+	}
+	defer file.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event Event
+		// A line cut short by an interrupted write is dropped
+		if json.Unmarshal(scanner.Bytes(), &event) == nil {
+			events = append(events, event)
+		}
+	}
+	return events, scanner.Err()
+}
+
+// writeQueue replaces the queued events
+func (r *Recorder) writeQueue(events []Event) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(r.QueuePath(), buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to queue event: %w", err)
+	}
+	return nil
+}
+
+// Flush sends the queued events to Endpoint once there are enough of them or
+// the oldest is a day old, and empties the queue when they were received
+func (r *Recorder) Flush(ctx context.Context) error {
+	if Endpoint == "" || DisabledByEnv() != "" {
+		return nil
+	}
+	events, err := r.Pending()
+	if err != nil || len(events) == 0 {
+		return err
+	}
+	if len(events) < flushEvents && time.Since(events[0].Time) < flushAge {
+		return nil
+	}
+
+	payload, err := json.Marshal(map[string][]Event{"events": events})
+	if err != nil {
+		return fmt.Errorf("failed to encode events: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, Endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send events: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("telemetry endpoint returned %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	return os.Remove(r.QueuePath())
+}
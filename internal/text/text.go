@@ -0,0 +1,59 @@
+// Package text measures and shortens text for display in the terminal and in
+// generated files. Text is handled as grapheme clusters, the characters a
+// reader sees, so emoji, accented letters, and CJK text are never cut in half,
+// and measured in terminal columns, so wide characters line up.
+package text
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// Ellipsis marks text that was shortened
+const Ellipsis = "…"
+
+// Width returns the number of terminal columns s takes up
+func Width(s string) int {
+	return uniseg.StringWidth(s)
+}
+
+// Truncate shortens s to at most width columns, ending it with an ellipsis
+// when anything was cut. Characters are never split.
+func Truncate(s string, width int) string {
+	if Width(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+
+	// Leave room for the ellipsis
+	limit := width - Width(Ellipsis)
+	var sb strings.Builder
+	used := 0
+	graphemes := uniseg.NewGraphemes(s)
+	for graphemes.Next() {
+		if used+graphemes.Width() > limit {
+			break
+		}
+		sb.WriteString(graphemes.Str())
+		used += graphemes.Width()
+	}
+	return strings.TrimRight(sb.String(), " ") + Ellipsis
+}
+
+// PadRight pads s with spaces to width columns, for aligning columns of text
+// that fmt's %-*s would pad by rune instead
+func PadRight(s string, width int) string {
+	if gap := width - Width(s); gap > 0 {
+		return s + strings.Repeat(" ", gap)
+	}
+	return s
+}
+
+// First returns the first character of s, or "" for empty text
+func First(s string) string {
+	first, _, _, _ := uniseg.FirstGraphemeClusterInString(s, -1)
+	return first
+}
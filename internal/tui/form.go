@@ -0,0 +1,611 @@
+// Package tui holds the terminal forms of pres. The iterative Q&A form of
+// pres create and pres update started as the one in
+// github.com/geoffjay/agar/tui, and lives here so its questions can do more.
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/geoffjay/pres/internal/text"
+	"github.com/rivo/uniseg"
+)
+
+// maxInputRows is the most rows the answer input grows to before it scrolls
+const maxInputRows = 10
+
+// IterationConfig controls the iterative Q&A behavior
+type IterationConfig struct {
+	MaxIterations    int
+	IterationPrompt  string // What to ask between iterations
+	CompletionPrompt string // How to ask if they're done
+}
+
+// QuestionKind is how a question is answered
+type QuestionKind int
+
+const (
+	// TextQuestion is answered with free text
+	TextQuestion QuestionKind = iota
+	// ChoiceQuestion is answered by picking one of its options
+	ChoiceQuestion
+	// MultiSelectQuestion is answered by picking any of its options; the
+	// response is the picked options joined with ", "
+	MultiSelectQuestion
+	// YesNoQuestion is answered by picking "yes" or "no"
+	YesNoQuestion
+)
+
+// IterativeQuestion represents a question in an iterative session
+type IterativeQuestion struct {
+	Question  string
+	HelpText  string
+	Iteration int // Which iteration this question is from
+	// Optional questions may be skipped, with an empty answer or Tab; a
+	// skipped question's response is ""
+	Optional bool
+	Kind     QuestionKind
+	// Options are the options of a choice or multi-select question
+	Options []string
+}
+
+// options returns the options to pick from, or nil for a text question. A
+// choice question without options is asked as a text question.
+func (q IterativeQuestion) options() []string {
+	switch q.Kind {
+	case ChoiceQuestion, MultiSelectQuestion:
+		if len(q.Options) > 0 {
+			return q.Options
+		}
+	case YesNoQuestion:
+		return []string{"yes", "no"}
+	}
+	return nil
+}
+
+// IterativeFormModel represents an iterative Q&A form. The up arrow goes back
+// to the previous question of the iteration with its answer filled in for
+// editing, and the questions after it keep their answers too. In an answer
+// that wraps, the up arrow moves between its rows first. Questions answered
+// from a list use the arrow keys to pick, so there Shift+Tab goes back
+// instead.
+type IterativeFormModel struct {
+	title      string
+	config     IterationConfig
+	questions  []IterativeQuestion
+	responses  []string
+	ahead      []string // Answers of the questions gone back past, the next question's last
+	currentIdx int
+	iteration  int
+	input      textarea.Model
+	cursor     int    // The highlighted option of a choice question
+	picked     []bool // The picked options of a multi-select question
+	err        error
+	done       bool
+	needsMore  bool // Whether user wants another iteration
+	askingMore bool // Whether we're asking if they want more
+	width      int  // Terminal width for text wrapping
+}
+
+// NewIterativeForm creates a new iterative form
+func NewIterativeForm(title string, config IterationConfig) IterativeFormModel {
+	return IterativeFormModel{
+		title:      title,
+		config:     config,
+		questions:  []IterativeQuestion{},
+		responses:  []string{},
+		currentIdx: 0,
+		iteration:  0,
+		input:      newAnswerInput(),
+		done:       false,
+		needsMore:  false,
+		askingMore: false,
+		width:      80, // Default width, will be updated by WindowSizeMsg
+	}
+}
+
+// AddQuestions adds questions from a new iteration
+func (m *IterativeFormModel) AddQuestions(questions []IterativeQuestion) {
+	m.questions = append(m.questions, questions...)
+	m.resetOptions()
+}
+
+// Init initializes the model
+func (m IterativeFormModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages
+func (m IterativeFormModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.input.SetWidth(max(m.width-4, 42))
+		m.fitInput()
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "esc":
+			m.done = true
+			return m, tea.Quit
+
+		case "enter":
+			return m.handleEnter()
+
+		case "shift+tab":
+			m.back()
+			return m, nil
+
+		case "tab":
+			if m.currentOptional() {
+				return m.accept("")
+			}
+			return m, nil
+		}
+
+		if options := m.currentOptions(); options != nil {
+			switch msg.String() {
+			case "up", "k":
+				m.cursor = max(m.cursor-1, 0)
+			case "down", "j":
+				m.cursor = min(m.cursor+1, len(options)-1)
+			case " ", "x":
+				if m.questions[m.currentIdx].Kind == MultiSelectQuestion {
+					m.picked[m.cursor] = !m.picked[m.cursor]
+				}
+			}
+			return m, nil
+		}
+
+		if msg.String() == "up" && m.inputAtTop() && m.canGoBack() {
+			m.back()
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		m.fitInput()
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// handleEnter processes the enter key press
+func (m IterativeFormModel) handleEnter() (tea.Model, tea.Cmd) {
+	input := strings.TrimSpace(m.input.Value())
+
+	// Handle "do you need more info?" question
+	if m.askingMore {
+		lower := strings.ToLower(input)
+		switch lower {
+		case "yes", "y":
+			m.needsMore = true
+			m.askingMore = false
+			m.done = true // Signal to caller to add more questions
+			return m, tea.Quit
+		case "no", "n":
+			m.needsMore = false
+			m.askingMore = false
+			m.done = true
+			return m, tea.Quit
+		default:
+			m.err = fmt.Errorf("please answer 'yes' or 'no'")
+			m.resetInput()
+			return m, nil
+		}
+	}
+
+	if m.currentOptions() != nil {
+		input = m.selection()
+		if input == "" && !m.currentOptional() {
+			m.err = fmt.Errorf("please pick at least one option")
+			return m, nil
+		}
+		return m.accept(input)
+	}
+
+	// Validate input
+	if input == "" && !m.currentOptional() {
+		m.err = fmt.Errorf("please provide an answer")
+		return m, nil
+	}
+
+	return m.accept(input)
+}
+
+// accept stores the response to the current question and moves on
+func (m IterativeFormModel) accept(input string) (tea.Model, tea.Cmd) {
+	// Store response
+	m.responses = append(m.responses, input)
+	m.err = nil
+	m.resetInput()
+	m.currentIdx++
+	m.resetOptions()
+	if len(m.ahead) > 0 && m.currentIdx < len(m.questions) {
+		// Fill in the next question's earlier answer
+		m.setAnswer(m.ahead[len(m.ahead)-1])
+		m.ahead = m.ahead[:len(m.ahead)-1]
+	}
+
+	// Check if we've answered all questions in current iteration
+	if m.currentIdx >= len(m.questions) {
+		// Check if we can do another iteration
+		if m.iteration < m.config.MaxIterations-1 {
+			m.askingMore = true
+		} else {
+			m.done = true
+			return m, tea.Quit
+		}
+	}
+
+	return m, nil
+}
+
+// canGoBack reports whether there is an earlier question of this iteration
+// to go back to
+func (m IterativeFormModel) canGoBack() bool {
+	return !m.done && m.currentIdx > countQuestionsBeforeIteration(m.questions, m.iteration)
+}
+
+// back returns to the previous question of this iteration with its answer
+// filled in, keeping the answer of the question being asked, as typed so
+// far, to fill in when it is reached again
+func (m *IterativeFormModel) back() {
+	if !m.canGoBack() {
+		return
+	}
+	if m.askingMore {
+		m.askingMore = false
+	} else {
+		m.ahead = append(m.ahead, m.draft())
+	}
+	m.err = nil
+	m.currentIdx--
+	answer := m.responses[m.currentIdx]
+	m.responses = m.responses[:m.currentIdx]
+	m.resetInput()
+	m.resetOptions()
+	m.setAnswer(answer)
+}
+
+// draft returns the answer to the question being asked as it stands
+func (m IterativeFormModel) draft() string {
+	if m.choosing() {
+		return m.selection()
+	}
+	return m.input.Value()
+}
+
+// currentOptional reports whether the question being asked may be skipped
+func (m IterativeFormModel) currentOptional() bool {
+	return !m.askingMore && m.currentIdx < len(m.questions) && m.questions[m.currentIdx].Optional
+}
+
+// currentOptions returns the options of the question being asked, or nil
+// when it is answered with text
+func (m IterativeFormModel) currentOptions() []string {
+	if m.askingMore || m.currentIdx >= len(m.questions) {
+		return nil
+	}
+	return m.questions[m.currentIdx].options()
+}
+
+// resetOptions clears the highlighted and picked options for the question
+// being asked
+func (m *IterativeFormModel) resetOptions() {
+	m.cursor = 0
+	m.picked = make([]bool, len(m.currentOptions()))
+}
+
+// selection returns the response the options picked so far make
+func (m IterativeFormModel) selection() string {
+	options := m.currentOptions()
+	if m.questions[m.currentIdx].Kind != MultiSelectQuestion {
+		return options[m.cursor]
+	}
+	var picked []string
+	for i, option := range options {
+		if m.picked[i] {
+			picked = append(picked, option)
+		}
+	}
+	return strings.Join(picked, ", ")
+}
+
+// choosing reports whether the question being asked is answered by picking
+// from a list, which uses the arrow keys
+func (m IterativeFormModel) choosing() bool {
+	return m.currentOptions() != nil
+}
+
+// setAnswer fills in an answer to the question being asked: the text of a
+// text question, or the options of the response to a choice question
+func (m *IterativeFormModel) setAnswer(answer string) {
+	options := m.currentOptions()
+	if options == nil {
+		m.input.SetValue(answer)
+		m.fitInput()
+		return
+	}
+	m.resetOptions()
+	if m.questions[m.currentIdx].Kind != MultiSelectQuestion {
+		m.cursor = max(slices.Index(options, answer), 0)
+		return
+	}
+	for _, part := range strings.Split(answer, ", ") {
+		if i := slices.Index(options, part); i >= 0 {
+			m.picked[i] = true
+		}
+	}
+}
+
+// inputAtTop reports whether the cursor of a text answer is on its first
+// row, where the up arrow has no row to move to
+func (m IterativeFormModel) inputAtTop() bool {
+	return m.input.Line() == 0 && m.input.LineInfo().RowOffset == 0
+}
+
+// newAnswerInput creates the input text answers are typed in: it wraps at
+// word boundaries, handles cursor movement, word editing, and pasting, and
+// takes multi-line pastes, though Enter submits the answer
+func newAnswerInput() textarea.Model {
+	input := textarea.New()
+	input.ShowLineNumbers = false
+	input.CharLimit = 0
+	input.KeyMap.InsertNewline.SetEnabled(false)
+	input.SetPromptFunc(2, func(row int) string {
+		if row == 0 {
+			return "> "
+		}
+		return "  "
+	})
+	style := textarea.Style{Text: InputStyle, CursorLine: InputStyle, Prompt: InputStyle}
+	input.FocusedStyle, input.BlurredStyle = style, style
+	input.Cursor.Style = InputStyle
+	input.Cursor.SetMode(cursor.CursorStatic)
+	input.Focus()
+	input.SetWidth(76)
+	input.SetHeight(1)
+	return input
+}
+
+// resetInput clears the answer input
+func (m *IterativeFormModel) resetInput() {
+	m.input.Reset()
+	m.fitInput()
+}
+
+// fitInput sizes the answer input to the rows its text wraps to, so it
+// grows as the answer does
+func (m *IterativeFormModel) fitInput() {
+	width := max(m.input.Width(), 1)
+	rows := 0
+	for _, line := range strings.Split(m.input.Value(), "\n") {
+		// One more column for the cursor at the end of the line
+		rows += uniseg.StringWidth(line)/width + 1
+	}
+	m.input.SetHeight(min(rows, maxInputRows))
+}
+
+// View renders the UI
+func (m IterativeFormModel) View() string {
+	if m.done && !m.askingMore {
+		if m.needsMore {
+			return SuccessStyle.Render("✓ Gathering more information...\n")
+		}
+		return SuccessStyle.Render("✓ Information gathering complete!\n")
+	}
+
+	var b strings.Builder
+
+	// Title
+	b.WriteString(TitleStyle.Render(m.title))
+	b.WriteString("\n\n")
+
+	// Iteration indicator
+	if m.config.MaxIterations > 1 {
+		b.WriteString(HelpStyle.Render(fmt.Sprintf("Iteration %d of %d", m.iteration+1, m.config.MaxIterations)))
+		b.WriteString("\n\n")
+	}
+
+	// If asking about more information
+	if m.askingMore {
+		b.WriteString(QuestionStyle.Render(m.config.CompletionPrompt))
+		b.WriteString("\n")
+		b.WriteString(HelpStyle.Render("(yes/no)"))
+		b.WriteString("\n\n")
+		b.WriteString(m.input.View())
+		b.WriteString("\n\n")
+
+		if m.err != nil {
+			b.WriteString(ErrorStyle.Render(fmt.Sprintf("⚠ %s", m.err.Error())))
+			b.WriteString("\n\n")
+		}
+
+		// Show what we've gathered so far
+		if len(m.responses) > 0 {
+			b.WriteString("─────────────────────────────────\n")
+			b.WriteString("Information gathered:\n")
+			for i, resp := range m.responses {
+				display := preview(resp, 60)
+				if display == "" {
+					display = "(skipped)"
+				}
+				b.WriteString(fmt.Sprintf("%d. %s\n", i+1, display))
+			}
+		}
+	} else if m.currentIdx < len(m.questions) {
+		question := m.questions[m.currentIdx]
+
+		// Progress
+		b.WriteString(fmt.Sprintf("Question %d of %d (this iteration)\n\n", m.currentIdx+1-countQuestionsBeforeIteration(m.questions, m.iteration), countQuestionsInIteration(m.questions, m.iteration)))
+
+		// Question text
+		b.WriteString(QuestionStyle.Render(question.Question))
+		if question.Optional {
+			b.WriteString(HelpStyle.Render(" (optional)"))
+		}
+		b.WriteString("\n")
+
+		// Help text
+		if question.HelpText != "" {
+			b.WriteString(HelpStyle.Render(question.HelpText))
+			b.WriteString("\n")
+		}
+
+		b.WriteString("\n")
+
+		if options := question.options(); options != nil {
+			b.WriteString(m.renderOptions(question.Kind, options))
+		} else {
+			b.WriteString(m.input.View())
+		}
+		b.WriteString("\n\n")
+
+		// Error
+		if m.err != nil {
+			b.WriteString(ErrorStyle.Render(fmt.Sprintf("⚠ %s", m.err.Error())))
+			b.WriteString("\n\n")
+		}
+
+		// Previous responses in this iteration
+		startIdx := countQuestionsBeforeIteration(m.questions, m.iteration)
+		if m.currentIdx > startIdx {
+			b.WriteString("─────────────────────────────────\n")
+			b.WriteString("Previous answers (this iteration):\n")
+			for i := startIdx; i < m.currentIdx; i++ {
+				resp := preview(m.responses[i], 50)
+				if resp == "" {
+					resp = "(skipped)"
+				}
+				b.WriteString(fmt.Sprintf("%d. %s\n", i-startIdx+1, resp))
+			}
+		}
+	}
+
+	b.WriteString("\n")
+	var hints []string
+	if m.choosing() {
+		hints = append(hints, "↑/↓ to select")
+		if m.questions[m.currentIdx].Kind == MultiSelectQuestion {
+			hints = append(hints, "Space to pick")
+		}
+		hints = append(hints, "Enter to continue")
+	}
+	if m.currentOptional() {
+		if m.choosing() {
+			hints = append(hints, "Tab to skip")
+		} else {
+			hints = append(hints, "Enter with no answer or Tab to skip")
+		}
+	}
+	if m.canGoBack() {
+		if m.choosing() {
+			hints = append(hints, "Shift+Tab to edit the previous answer")
+		} else {
+			hints = append(hints, "↑ to edit the previous answer")
+		}
+	}
+	hints = append(hints, "Esc to cancel")
+	b.WriteString(HelpStyle.Render("Press " + strings.Join(hints, " • ")))
+
+	return b.String()
+}
+
+// preview shortens an answer to one line of at most width columns, joining
+// the lines of a multi-line answer
+func preview(answer string, width int) string {
+	return text.Truncate(strings.Join(strings.Fields(answer), " "), width)
+}
+
+// renderOptions renders the options of a choice question, the highlighted
+// one marked
+func (m IterativeFormModel) renderOptions(kind QuestionKind, options []string) string {
+	var b strings.Builder
+	for i, option := range options {
+		line := option
+		if kind == MultiSelectQuestion {
+			box := "[ ] "
+			if m.picked[i] {
+				box = "[x] "
+			}
+			line = box + option
+		}
+		if i == m.cursor {
+			b.WriteString(InputStyle.Bold(true).Render("› " + line))
+		} else {
+			b.WriteString("  " + line)
+		}
+		if i < len(options)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// GetResponses returns all collected responses
+func (m IterativeFormModel) GetResponses() []string {
+	return m.responses
+}
+
+// GetResponsesForIteration returns responses for a specific iteration
+func (m IterativeFormModel) GetResponsesForIteration(iteration int) []string {
+	var responses []string
+	for i, q := range m.questions {
+		if q.Iteration == iteration && i < len(m.responses) {
+			responses = append(responses, m.responses[i])
+		}
+	}
+	return responses
+}
+
+// IsDone returns whether the form is complete
+func (m IterativeFormModel) IsDone() bool {
+	return m.done && !m.needsMore
+}
+
+// NeedsMoreInfo returns whether user wants another iteration
+func (m IterativeFormModel) NeedsMoreInfo() bool {
+	return m.needsMore
+}
+
+// NextIteration prepares for the next iteration
+func (m *IterativeFormModel) NextIteration() {
+	m.iteration++
+	m.askingMore = false
+	m.needsMore = false
+	m.done = false
+}
+
+// GetCurrentIteration returns the current iteration number
+func (m IterativeFormModel) GetCurrentIteration() int {
+	return m.iteration
+}
+
+// Helper functions
+
+func countQuestionsBeforeIteration(questions []IterativeQuestion, iteration int) int {
+	count := 0
+	for _, q := range questions {
+		if q.Iteration < iteration {
+			count++
+		}
+	}
+	return count
+}
+
+func countQuestionsInIteration(questions []IterativeQuestion, iteration int) int {
+	count := 0
+	for _, q := range questions {
+		if q.Iteration == iteration {
+			count++
+		}
+	}
+	return count
+}
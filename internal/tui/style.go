@@ -0,0 +1,32 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Styles shared by the form and the other TUI components
+var (
+	TitleStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("205")).
+			MarginBottom(1)
+
+	QuestionStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("86")).
+			Bold(true)
+
+	HelpStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("241")).
+			Italic(true)
+
+	InputStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("212"))
+
+	ErrorStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")).
+			Bold(true)
+
+	SuccessStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("46")).
+			Bold(true)
+)
@@ -0,0 +1,615 @@
+package web
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// DeckServer serves a single presentation live: the deck is regenerated from
+// its JSON on every load, and the presenter's current slide is shared with
+// companion views such as the teleprompter
+type DeckServer struct {
+	path      string
+	variables map[string]string
+	writer    *presentation.Writer
+	generator *presentation.Generator
+
+	// Record adds the narration recording controls to the served deck
+	Record bool
+
+	// Follow locks audience browsers to the presenter's current slide. Only
+	// the presenter, who opens the deck with the presenter key, can change it.
+	Follow       bool
+	presenterKey string
+
+	// Feedback asks the audience for a rating and comment at the end of the
+	// deck
+	Feedback bool
+
+	// LiveReload reloads the browsers showing the deck when its JSON changes;
+	// see Watch
+	LiveReload bool
+
+	mu          sync.Mutex
+	current     int
+	subscribers map[chan int]struct{}
+	reloaders   map[chan struct{}]struct{}
+
+	// questionsMu and feedbackMu serialize changes to the questions file and
+	// feedback log
+	questionsMu sync.Mutex
+	feedbackMu  sync.Mutex
+}
+
+// NotesSlide is a slide's speaker notes as served to companion views
+type NotesSlide struct {
+	Title string `json:"title"`
+	Notes string `json:"notes"`
+}
+
+// NewDeckServer creates a server for the presentation at path, overriding
+// its variables with the given values and rendering it with generator
+func NewDeckServer(path string, variables map[string]string, generator *presentation.Generator) *DeckServer {
+	return &DeckServer{
+		path:         path,
+		variables:    variables,
+		writer:       presentation.NewWriter("."),
+		generator:    generator,
+		presenterKey: newPresenterKey(),
+		subscribers:  map[chan int]struct{}{},
+		reloaders:    map[chan struct{}]struct{}{},
+	}
+}
+
+// PresenterKey returns the key that identifies the presenter; open the deck
+// with ?presenter=<key> to present
+func (s *DeckServer) PresenterKey() string {
+	return s.presenterKey
+}
+
+// hasPresenterKey reports whether a request carries the presenter key
+func (s *DeckServer) hasPresenterKey(r *http.Request) bool {
+	return r.URL.Query().Get("presenter") == s.presenterKey
+}
+
+// newPresenterKey generates a random presenter key
+func newPresenterKey() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%x", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
+
+// Handler returns the HTTP handler for the deck and its companion views.
+// Other paths are served from the deck's directory so relative images load.
+func (s *DeckServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleDeck)
+	mux.HandleFunc("GET /teleprompter", s.handleTeleprompter)
+	mux.HandleFunc("GET /api/notes", s.handleNotes)
+	mux.HandleFunc("GET /api/slide", s.handleSlideEvents)
+	mux.HandleFunc("POST /api/slide", s.handleSlideChange)
+	mux.HandleFunc("GET /api/reload", s.handleReloadEvents)
+	mux.HandleFunc("POST /api/recordings/{id}", s.handleRecording)
+	mux.HandleFunc("GET /ask", s.handleAsk)
+	mux.HandleFunc("GET /questions", s.handleQuestionQueue)
+	mux.HandleFunc("GET /api/questions", s.handleQuestions)
+	mux.HandleFunc("POST /api/questions", s.handleAddQuestion)
+	mux.HandleFunc("POST /api/questions/{id}/answered", s.handleAnswerQuestion)
+	mux.HandleFunc("POST /api/feedback", s.handleFeedback)
+	mux.HandleFunc("GET /oembed", s.handleOEmbed)
+	mux.Handle("GET /", hideLocalData(http.FileServer(http.Dir(filepath.Dir(s.path)))))
+	return mux
+}
+
+// hideLocalData keeps the .pres directory, which holds the audit log and the
+// audience's questions and feedback, out of the files served with the deck,
+// along with deck JSON and generated HTML, which carry the speaker notes
+func hideLocalData(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, part := range strings.Split(r.URL.Path, "/") {
+			if part == presentation.AuditDir {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		switch strings.ToLower(path.Ext(r.URL.Path)) {
+		case ".json", ".html", ".htm":
+			http.NotFound(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// load reads the presentation and readies it for output
+func (s *DeckServer) load() (*presentation.PresentationData, error) {
+	data, err := s.writer.LoadPresentation(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load presentation: %w", err)
+	}
+	data.Metadata.SetVariables(s.variables)
+
+	data, _, err = s.generator.Prepare(data, s.path)
+	return data, err
+}
+
+// handleDeck renders the presentation, for the presenter with the script that
+// reports slide changes to the server, and in follow mode, for the audience,
+// with the script that follows them
+func (s *DeckServer) handleDeck(w http.ResponseWriter, r *http.Request) {
+	data, err := s.load()
+	if err != nil {
+		s.writeLoadError(w, err)
+		return
+	}
+
+	// Only the presenter's copy carries the speaker notes
+	generator := *s.generator
+	generator.OmitNotes = !s.hasPresenterKey(r)
+	html, err := generator.RenderHTML(data)
+	if err != nil {
+		s.writeLoadError(w, err)
+		return
+	}
+	if i := strings.LastIndex(html, "</body>"); i >= 0 {
+		var scripts string
+		if s.hasPresenterKey(r) {
+			scripts = syncScript
+			if s.Record {
+				scripts += recordScript
+			}
+		} else if s.Follow {
+			scripts = followScript
+		}
+		// The presenter's own screen stays clean when the audience follows
+		if s.Feedback && (!s.Follow || !s.hasPresenterKey(r)) {
+			scripts += feedbackScript
+		}
+		if s.LiveReload {
+			scripts += reloadScript
+		}
+		html = html[:i] + scripts + html[i:]
+	}
+	if i := strings.Index(html, "</head>"); i >= 0 {
+		html = html[:i] + oEmbedLink(r, data.Metadata.Title) + html[i:]
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, html)
+}
+
+// handleNotes returns the title and speaker notes of every slide. The notes
+// always need the presenter key, since the audience can reach the server.
+func (s *DeckServer) handleNotes(w http.ResponseWriter, r *http.Request) {
+	if !s.hasPresenterKey(r) {
+		http.Error(w, "only the presenter can see the speaker notes", http.StatusForbidden)
+		return
+	}
+
+	data, err := s.load()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	slides := make([]NotesSlide, 0, len(data.Slides))
+	for _, slide := range data.Slides {
+		slides = append(slides, NotesSlide{Title: slide.Title, Notes: slide.Notes})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(slides)
+}
+
+// handleSlideChange records the slide the presenter is on. Changes always
+// need the presenter key, since the audience can reach the server.
+func (s *DeckServer) handleSlideChange(w http.ResponseWriter, r *http.Request) {
+	if !s.hasPresenterKey(r) {
+		http.Error(w, "only the presenter can change slides", http.StatusForbidden)
+		return
+	}
+
+	var change struct {
+		Index int `json:"index"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&change); err != nil || change.Index < 0 {
+		http.Error(w, "invalid slide change", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.current = change.Index
+	for ch := range s.subscribers {
+		select {
+		case ch <- change.Index:
+		default:
+			// A slow subscriber catches up with the next change
+		}
+	}
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleSlideEvents streams the presenter's current slide as server-sent
+// events, starting with the slide they are on now
+func (s *DeckServer) handleSlideEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ch := make(chan int, 1)
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	current := s.current
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, ch)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+
+	for {
+		fmt.Fprintf(w, "data: %d\n\n", current)
+		flusher.Flush()
+
+		select {
+		case current = <-ch:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// handleRecording stores narration recorded for a slide. Recordings always
+// need the presenter key, since the audience can reach the server.
+func (s *DeckServer) handleRecording(w http.ResponseWriter, r *http.Request) {
+	if !s.Record {
+		http.Error(w, "recording is not enabled (start the server with --record)", http.StatusForbidden)
+		return
+	}
+	if !s.hasPresenterKey(r) {
+		http.Error(w, "only the presenter can record", http.StatusForbidden)
+		return
+	}
+
+	id := r.PathValue("id")
+	if !slideIDPattern.MatchString(id) {
+		http.Error(w, "invalid slide id", http.StatusBadRequest)
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	ext, ok := audioExtensions[mediaType]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unsupported audio type %q", mediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	audio, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRecordingSize))
+	if err != nil {
+		http.Error(w, "recording is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if _, err := s.writer.SaveSlideAudio(s.path, id, ext, audio); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleTeleprompter renders the teleprompter view. Like the notes it shows,
+// it always needs the presenter key, since the audience can reach the server.
+func (s *DeckServer) handleTeleprompter(w http.ResponseWriter, r *http.Request) {
+	if !s.hasPresenterKey(r) {
+		http.Error(w, "only the presenter can see the teleprompter", http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, teleprompterPage)
+}
+
+// maxRecordingSize bounds the size of one slide's narration
+const maxRecordingSize = 64 << 20
+
+// slideIDPattern matches the slide IDs pres assigns
+var slideIDPattern = regexp.MustCompile(`^[0-9a-f-]+$`)
+
+// audioExtensions maps the audio types browsers record to file extensions
+var audioExtensions = map[string]string{
+	"audio/webm": ".webm",
+	"audio/ogg":  ".ogg",
+	"audio/mp4":  ".m4a",
+	"audio/mpeg": ".mp3",
+	"audio/wav":  ".wav",
+}
+
+// syncScript reports the presenter's slide changes to the server, passing on
+// the presenter key. The speaker view loads the deck in frames marked
+// "receiver", which stay quiet.
+const syncScript = `    <script>
+        var presenterQuery = location.search.match(/[?&](presenter=[^&]*)/);
+        presenterQuery = presenterQuery ? '?' + presenterQuery[1] : '';
+        (function () {
+            if (/receiver/.test(location.search)) return;
+            function report() {
+                fetch('/api/slide' + presenterQuery, {
+                    method: 'POST',
+                    headers: { 'Content-Type': 'application/json' },
+                    body: JSON.stringify({ index: Reveal.getState().indexh })
+                });
+            }
+            Reveal.on('ready', report);
+            Reveal.on('slidechanged', report);
+        })();
+    </script>
+`
+
+// followScript keeps an audience browser on the presenter's current slide,
+// with a toggle to browse the deck freely and return later
+const followScript = `    <style>
+        .follow-toggle {
+            position: fixed;
+            top: 16px;
+            right: 16px;
+            z-index: 30;
+            padding: 6px 12px;
+            border: 0;
+            border-radius: 4px;
+            background: rgba(0, 0, 0, 0.6);
+            color: #fff;
+            font: 14px system-ui, sans-serif;
+            cursor: pointer;
+        }
+        @media print {
+            .follow-toggle {
+                display: none;
+            }
+        }
+    </style>
+    <script>
+        (function () {
+            if (/receiver/.test(location.search)) return;
+
+            var following = true;
+            var target = 0;
+            var button = document.createElement('button');
+            button.className = 'follow-toggle';
+            document.body.appendChild(button);
+
+            function sync() {
+                if (following && Reveal.isReady()) Reveal.slide(target);
+            }
+
+            function update() {
+                button.textContent = following ? 'Browse freely' : 'Follow presenter';
+                Reveal.configure({ keyboard: !following, touch: !following, controls: !following });
+                sync();
+            }
+
+            button.addEventListener('click', function () {
+                following = !following;
+                update();
+            });
+            new EventSource('/api/slide').onmessage = function (e) {
+                target = Number(e.data);
+                sync();
+            };
+            if (Reveal.isReady()) {
+                update();
+            } else {
+                Reveal.on('ready', update);
+            }
+        })();
+    </script>
+`
+
+// recordScript records microphone narration, one take per slide: R starts
+// and stops recording, and every slide change uploads the take for the slide
+// being left, passing on the presenter key read by syncScript, which always
+// comes first. Slides pres adds itself, such as the appendix divider, have no
+// ID and are skipped.
+const recordScript = `    <style>
+        .recording-badge {
+            position: fixed;
+            top: 16px;
+            left: 16px;
+            z-index: 30;
+            padding: 4px 10px;
+            border-radius: 4px;
+            background: #c0392b;
+            color: #fff;
+            font: bold 14px system-ui, sans-serif;
+            display: none;
+        }
+        .recording-badge.active {
+            display: block;
+        }
+    </style>
+    <script>
+        (function () {
+            if (/receiver/.test(location.search) || !window.MediaRecorder) return;
+
+            var badge = document.createElement('div');
+            badge.className = 'recording-badge';
+            badge.textContent = '● REC';
+            document.body.appendChild(badge);
+
+            var stream = null;
+            var recorder = null;
+
+            function currentSlideID() {
+                var id = Reveal.getCurrentSlide().id || '';
+                return id.indexOf('slide-') === 0 ? id.slice(6) : '';
+            }
+
+            function startTake() {
+                var id = currentSlideID();
+                if (!id) return;
+
+                var chunks = [];
+                var take = new MediaRecorder(stream);
+                take.ondataavailable = function (e) { chunks.push(e.data); };
+                take.onstop = function () {
+                    var blob = new Blob(chunks, { type: take.mimeType });
+                    fetch('/api/recordings/' + id + presenterQuery, {
+                        method: 'POST',
+                        headers: { 'Content-Type': take.mimeType.split(';')[0] },
+                        body: blob
+                    }).then(function (r) {
+                        if (!r.ok) r.text().then(function (msg) { console.warn('Saving narration failed:', msg); });
+                    });
+                };
+                take.start();
+                recorder = take;
+            }
+
+            function stopTake() {
+                if (recorder && recorder.state !== 'inactive') recorder.stop();
+                recorder = null;
+            }
+
+            Reveal.on('slidechanged', function () {
+                if (!stream) return;
+                stopTake();
+                startTake();
+            });
+
+            Reveal.addKeyBinding({ keyCode: 82, key: 'R', description: 'Start/stop recording narration' }, function () {
+                if (stream) {
+                    stopTake();
+                    stream.getTracks().forEach(function (track) { track.stop(); });
+                    stream = null;
+                    badge.classList.remove('active');
+                    return;
+                }
+                navigator.mediaDevices.getUserMedia({ audio: true }).then(function (s) {
+                    stream = s;
+                    badge.classList.add('active');
+                    startTake();
+                }).catch(function (err) {
+                    console.warn('Microphone unavailable:', err);
+                });
+            });
+        })();
+    </script>
+`
+
+const teleprompterPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>pres - Teleprompter</title>
+    <style>
+        html, body { margin: 0; height: 100%; background: #000; color: #fff; font-family: system-ui, sans-serif; }
+        #script { height: 100%; overflow: hidden; padding: 0 8vw; box-sizing: border-box; }
+        #script.mirrored { transform: scaleX(-1); }
+        #script .title { color: #888; font-size: 3vw; margin: 40vh 0 2rem; }
+        #script .notes { font-size: 5vw; line-height: 1.4; white-space: pre-wrap; padding-bottom: 60vh; }
+        #marker { position: fixed; top: 40vh; left: 0; right: 0; border-top: 2px solid rgba(255, 80, 80, 0.5); pointer-events: none; }
+        #controls { position: fixed; bottom: 0; left: 0; right: 0; padding: 0.6rem 1rem; background: rgba(30, 30, 30, 0.9); font-size: 0.9rem; display: flex; gap: 1rem; align-items: center; }
+        #controls .hint { color: #888; margin-left: auto; }
+    </style>
+</head>
+<body>
+    <div id="script"><div class="title"></div><div class="notes"></div></div>
+    <div id="marker"></div>
+    <div id="controls">
+        <span id="slide">Waiting for the presenter…</span>
+        <label>Speed <input id="speed" type="range" min="0" max="200" step="5"></label>
+        <span id="speed-value"></span>
+        <span class="hint">Space: pause · ↑/↓: speed · M: mirror</span>
+    </div>
+    <script>
+        var script = document.getElementById('script');
+        var speedInput = document.getElementById('speed');
+        var slides = [];
+        var current = 0;
+        var paused = false;
+        var offset = 0;
+        var speed = Number(localStorage.getItem('pres.teleprompter.speed') || 40);
+
+        function setSpeed(value) {
+            speed = Math.max(0, Math.min(200, value));
+            speedInput.value = speed;
+            document.getElementById('speed-value').textContent = speed + ' px/s' + (paused ? ' (paused)' : '');
+            localStorage.setItem('pres.teleprompter.speed', speed);
+        }
+
+        function show(index) {
+            current = index;
+            var slide = slides[index] || { title: '', notes: '' };
+            script.querySelector('.title').textContent = slide.title;
+            script.querySelector('.notes').textContent = slide.notes || '(no speaker notes)';
+            document.getElementById('slide').textContent = 'Slide ' + (index + 1) + ' of ' + slides.length;
+            offset = 0;
+            script.scrollTop = 0;
+        }
+
+        var query = location.search.match(/[?&](presenter=[^&]*)/);
+        query = query ? '?' + query[1] : '';
+
+        function load() {
+            return fetch('/api/notes' + query).then(function (r) { return r.json(); }).then(function (data) {
+                slides = data;
+            });
+        }
+
+        var last = null;
+        function tick(now) {
+            if (last !== null && !paused) {
+                offset += speed * (now - last) / 1000;
+                script.scrollTop = offset;
+            }
+            last = now;
+            requestAnimationFrame(tick);
+        }
+
+        speedInput.addEventListener('input', function () { setSpeed(Number(speedInput.value)); });
+        document.addEventListener('keydown', function (e) {
+            if (e.target === speedInput) return;
+            if (e.key === ' ') { paused = !paused; setSpeed(speed); e.preventDefault(); }
+            if (e.key === 'ArrowUp') { setSpeed(speed + 5); e.preventDefault(); }
+            if (e.key === 'ArrowDown') { setSpeed(speed - 5); e.preventDefault(); }
+            if (e.key === 'm' || e.key === 'M') { script.classList.toggle('mirrored'); }
+        });
+
+        setSpeed(speed);
+        load().then(function () {
+            show(current);
+            // Reload the notes on each slide change so edits to the deck show up
+            new EventSource('/api/slide').onmessage = function (e) {
+                var index = Number(e.data);
+                load().then(function () { show(index); });
+            };
+            requestAnimationFrame(tick);
+        });
+    </script>
+</body>
+</html>
+`
@@ -0,0 +1,160 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// maxFeedbackLength bounds the length of a feedback comment
+const maxFeedbackLength = 2000
+
+// handleFeedback records an audience rating and comment
+func (s *DeckServer) handleFeedback(w http.ResponseWriter, r *http.Request) {
+	if !s.Feedback {
+		http.Error(w, "feedback is not enabled (start the server with --feedback)", http.StatusForbidden)
+		return
+	}
+
+	var response struct {
+		Rating  int    `json:"rating"`
+		Comment string `json:"comment"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 16<<10)).Decode(&response); err != nil {
+		http.Error(w, "invalid feedback", http.StatusBadRequest)
+		return
+	}
+	if len(response.Comment) > maxFeedbackLength {
+		http.Error(w, "comment is too long", http.StatusBadRequest)
+		return
+	}
+
+	s.feedbackMu.Lock()
+	_, err := presentation.AppendFeedback(s.path, response.Rating, response.Comment)
+	s.feedbackMu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// feedbackScript shows a rating and comment form once the viewer reaches the
+// last slide of the main flow. Each browser is asked only once per deck.
+const feedbackScript = `    <style>
+        .feedback-widget {
+            position: fixed;
+            left: 50%;
+            bottom: 24px;
+            transform: translateX(-50%);
+            z-index: 30;
+            width: min(420px, 90vw);
+            padding: 14px 16px;
+            border-radius: 8px;
+            background: #fff;
+            color: #222;
+            font: 15px system-ui, sans-serif;
+            box-shadow: 0 6px 24px rgba(0, 0, 0, 0.35);
+            display: none;
+        }
+        .feedback-widget.open {
+            display: block;
+        }
+        .feedback-widget .stars button {
+            border: 0;
+            background: none;
+            font-size: 28px;
+            color: #ccc;
+            cursor: pointer;
+            padding: 0 2px;
+        }
+        .feedback-widget .stars button.on {
+            color: #f5a623;
+        }
+        .feedback-widget textarea {
+            width: 100%;
+            box-sizing: border-box;
+            height: 4.5em;
+            margin: 8px 0;
+            font: inherit;
+        }
+        .feedback-widget .actions {
+            display: flex;
+            justify-content: flex-end;
+            gap: 8px;
+        }
+        @media print {
+            .feedback-widget {
+                display: none !important;
+            }
+        }
+    </style>
+    <script>
+        (function () {
+            if (/receiver/.test(location.search)) return;
+
+            var key = 'pres.feedback.' + location.pathname + document.title;
+            if (localStorage.getItem(key)) return;
+
+            var widget = document.createElement('div');
+            widget.className = 'feedback-widget';
+            widget.innerHTML = '<div><strong>How was this talk?</strong></div>' +
+                '<div class="stars"></div>' +
+                '<textarea maxlength="2000" placeholder="Anything to add? (optional)"></textarea>' +
+                '<div class="actions"><button class="later">Not now</button><button class="send" disabled>Send</button></div>';
+            document.body.appendChild(widget);
+
+            var rating = 0;
+            var stars = widget.querySelector('.stars');
+            for (var i = 1; i <= 5; i++) {
+                (function (value) {
+                    var star = document.createElement('button');
+                    star.textContent = '★';
+                    star.title = value + ' of 5';
+                    star.addEventListener('click', function () {
+                        rating = value;
+                        Array.prototype.forEach.call(stars.children, function (s, j) {
+                            s.classList.toggle('on', j < value);
+                        });
+                        widget.querySelector('.send').disabled = false;
+                    });
+                    stars.appendChild(star);
+                })(i);
+            }
+
+            // Keep reveal.js from handling keys typed into the form
+            widget.addEventListener('keydown', function (e) { e.stopPropagation(); });
+
+            var dismissed = false;
+            widget.querySelector('.later').addEventListener('click', function () {
+                dismissed = true;
+                widget.classList.remove('open');
+            });
+            widget.querySelector('.send').addEventListener('click', function () {
+                fetch('/api/feedback', {
+                    method: 'POST',
+                    headers: { 'Content-Type': 'application/json' },
+                    body: JSON.stringify({ rating: rating, comment: widget.querySelector('textarea').value })
+                }).then(function (r) {
+                    if (!r.ok) return;
+                    localStorage.setItem(key, '1');
+                    widget.innerHTML = '<strong>Thanks for your feedback!</strong>';
+                    setTimeout(function () { widget.classList.remove('open'); }, 2000);
+                });
+            });
+
+            // The last slide of the main flow is the last one counted in the
+            // slide number; backup slides after it are uncounted
+            function update() {
+                var counted = document.querySelectorAll('.reveal .slides > section:not([data-visibility="uncounted"])');
+                var last = counted[counted.length - 1];
+                var atEnd = last && Reveal.getCurrentSlide() === last;
+                widget.classList.toggle('open', atEnd && !dismissed && !localStorage.getItem(key));
+            }
+            Reveal.on('ready', update);
+            Reveal.on('slidechanged', update);
+        })();
+    </script>
+`
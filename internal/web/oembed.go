@@ -0,0 +1,91 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// OEmbed is an oEmbed response describing the served deck as rich content
+// (https://oembed.com)
+type OEmbed struct {
+	Version      string `json:"version"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	AuthorName   string `json:"author_name,omitempty"`
+	ProviderName string `json:"provider_name"`
+	HTML         string `json:"html"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+// deckURL returns the address the deck is served at, as seen by the client
+func deckURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host + "/"
+}
+
+// oEmbedLink returns the discovery link that lets consumers find the oEmbed
+// endpoint from the deck's address
+func oEmbedLink(r *http.Request, title string) string {
+	endpoint := deckURL(r) + "oembed?format=json&url=" + url.QueryEscape(deckURL(r))
+	return fmt.Sprintf(`    <link rel="alternate" type="application/json+oembed" href="%s" title="%s">
+`, html.EscapeString(endpoint), html.EscapeString(title))
+}
+
+// handleOEmbed describes how to embed the deck for blogs and portals that
+// support oEmbed
+func (s *DeckServer) handleOEmbed(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if format := query.Get("format"); format != "" && format != "json" {
+		http.Error(w, "only the json format is supported", http.StatusNotImplemented)
+		return
+	}
+
+	// Only the deck itself can be embedded
+	target, err := url.Parse(query.Get("url"))
+	if err != nil || target.Host != r.Host || (target.Path != "" && target.Path != "/") {
+		http.Error(w, "no deck at that url", http.StatusNotFound)
+		return
+	}
+
+	data, err := s.load()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Scale the deck down to fit within the consumer's limits
+	width, height := presentation.DeckWidth, presentation.DeckHeight
+	if maxWidth, err := strconv.Atoi(query.Get("maxwidth")); err == nil && maxWidth > 0 && maxWidth < width {
+		width, height = maxWidth, maxWidth*presentation.DeckHeight/presentation.DeckWidth
+	}
+	if maxHeight, err := strconv.Atoi(query.Get("maxheight")); err == nil && maxHeight > 0 && maxHeight < height {
+		width, height = maxHeight*presentation.DeckWidth/presentation.DeckHeight, maxHeight
+	}
+
+	response := OEmbed{
+		Version:      "1.0",
+		Type:         "rich",
+		Title:        data.Metadata.Title,
+		AuthorName:   data.Metadata.Author,
+		ProviderName: "pres",
+		HTML:         presentation.EmbedSnippet(deckURL(r), data.Metadata.Title),
+		Width:        width,
+		Height:       height,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,233 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// maxQuestionLength bounds the length of a submitted question
+const maxQuestionLength = 1000
+
+// handleAsk renders the page where the audience submits questions
+func (s *DeckServer) handleAsk(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, askPage)
+}
+
+// handleQuestionQueue renders the presenter's queue of questions. The queue
+// always needs the presenter key, since the audience can reach the server.
+func (s *DeckServer) handleQuestionQueue(w http.ResponseWriter, r *http.Request) {
+	if !s.hasPresenterKey(r) {
+		http.Error(w, "only the presenter can see the question queue", http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, questionQueuePage)
+}
+
+// handleQuestions returns the questions asked so far
+func (s *DeckServer) handleQuestions(w http.ResponseWriter, r *http.Request) {
+	if !s.hasPresenterKey(r) {
+		http.Error(w, "only the presenter can see the question queue", http.StatusForbidden)
+		return
+	}
+
+	questions, err := presentation.ReadQuestions(s.path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if questions == nil {
+		questions = []presentation.Question{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(questions)
+}
+
+// handleAddQuestion records a question from the audience
+func (s *DeckServer) handleAddQuestion(w http.ResponseWriter, r *http.Request) {
+	var submission struct {
+		Text   string `json:"text"`
+		Author string `json:"author"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 16<<10)).Decode(&submission); err != nil {
+		http.Error(w, "invalid question", http.StatusBadRequest)
+		return
+	}
+	if len(submission.Text) > maxQuestionLength || len(submission.Author) > 100 {
+		http.Error(w, "question is too long", http.StatusBadRequest)
+		return
+	}
+
+	s.questionsMu.Lock()
+	question, err := presentation.AddQuestion(s.path, submission.Text, submission.Author)
+	s.questionsMu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(question)
+}
+
+// handleAnswerQuestion marks a question as answered, or unanswered again
+func (s *DeckServer) handleAnswerQuestion(w http.ResponseWriter, r *http.Request) {
+	if !s.hasPresenterKey(r) {
+		http.Error(w, "only the presenter can answer questions", http.StatusForbidden)
+		return
+	}
+
+	var change struct {
+		Answered bool `json:"answered"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&change); err != nil {
+		http.Error(w, "invalid change", http.StatusBadRequest)
+		return
+	}
+
+	s.questionsMu.Lock()
+	err := presentation.SetQuestionAnswered(s.path, r.PathValue("id"), change.Answered)
+	s.questionsMu.Unlock()
+	if errors.Is(err, presentation.ErrQuestionNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+const askPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>pres - Ask a question</title>
+    <style>
+        body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 560px; padding: 0 1rem; color: #222; }
+        label { display: block; margin: 1rem 0 0.3rem; color: #666; }
+        input, textarea { width: 100%; box-sizing: border-box; padding: 0.5rem; font: inherit; border: 1px solid #ccc; border-radius: 4px; }
+        textarea { height: 8rem; }
+        button { margin-top: 1rem; padding: 0.5rem 1.2rem; font: inherit; border: 0; border-radius: 4px; background: #2a76dd; color: #fff; cursor: pointer; }
+        #status { margin-top: 1rem; color: #2a7d2a; }
+        #status.error { color: #c0392b; }
+    </style>
+</head>
+<body>
+    <h1>Ask a question</h1>
+    <form id="ask">
+        <label for="text">Question</label>
+        <textarea id="text" maxlength="1000" required></textarea>
+        <label for="author">Name (optional)</label>
+        <input id="author" maxlength="100">
+        <button type="submit">Send</button>
+    </form>
+    <p id="status"></p>
+    <script>
+        var author = document.getElementById('author');
+        author.value = localStorage.getItem('pres.ask.author') || '';
+
+        document.getElementById('ask').addEventListener('submit', function (e) {
+            e.preventDefault();
+            var text = document.getElementById('text');
+            var status = document.getElementById('status');
+            localStorage.setItem('pres.ask.author', author.value);
+            fetch('/api/questions', {
+                method: 'POST',
+                headers: { 'Content-Type': 'application/json' },
+                body: JSON.stringify({ text: text.value, author: author.value })
+            }).then(function (r) {
+                if (!r.ok) return r.text().then(function (msg) { throw new Error(msg); });
+                text.value = '';
+                status.className = '';
+                status.textContent = 'Thanks! Your question was sent to the presenter.';
+            }).catch(function (err) {
+                status.className = 'error';
+                status.textContent = 'Could not send your question: ' + err.message;
+            });
+        });
+    </script>
+</body>
+</html>
+`
+
+const questionQueuePage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>pres - Questions</title>
+    <style>
+        body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 760px; padding: 0 1rem; color: #222; }
+        .question { border: 1px solid #ddd; border-radius: 6px; padding: 0.8rem 1rem; margin-bottom: 0.8rem; display: flex; gap: 1rem; align-items: flex-start; }
+        .question.answered { opacity: 0.5; }
+        .question .text { flex: 1; white-space: pre-wrap; font-size: 1.2rem; }
+        .question .meta { color: #888; font-size: 0.85rem; margin-top: 0.3rem; }
+        .empty { color: #999; }
+        button { font: inherit; cursor: pointer; }
+    </style>
+</head>
+<body>
+    <h1>Questions</h1>
+    <p class="meta">Audience link: <a id="ask-link" href="/ask"></a></p>
+    <div id="queue"><p class="empty">No questions yet.</p></div>
+    <script>
+        var query = location.search.match(/[?&](presenter=[^&]*)/);
+        query = query ? '?' + query[1] : '';
+        document.getElementById('ask-link').textContent = location.origin + '/ask';
+
+        function render(questions) {
+            var queue = document.getElementById('queue');
+            queue.innerHTML = '';
+            if (questions.length === 0) {
+                queue.innerHTML = '<p class="empty">No questions yet.</p>';
+                return;
+            }
+            // Open questions first, oldest first within each group
+            questions.sort(function (a, b) { return a.answered - b.answered; });
+            questions.forEach(function (q) {
+                var item = document.createElement('div');
+                item.className = 'question' + (q.answered ? ' answered' : '');
+                var body = document.createElement('div');
+                body.className = 'text';
+                body.textContent = q.text;
+                var meta = document.createElement('div');
+                meta.className = 'meta';
+                meta.textContent = (q.author || 'Anonymous') + ' · ' + new Date(q.created).toLocaleTimeString();
+                body.appendChild(meta);
+                var button = document.createElement('button');
+                button.textContent = q.answered ? 'Reopen' : 'Answered';
+                button.addEventListener('click', function () {
+                    fetch('/api/questions/' + q.id + '/answered' + query, {
+                        method: 'POST',
+                        headers: { 'Content-Type': 'application/json' },
+                        body: JSON.stringify({ answered: !q.answered })
+                    }).then(refresh);
+                });
+                item.appendChild(body);
+                item.appendChild(button);
+                queue.appendChild(item);
+            });
+        }
+
+        function refresh() {
+            fetch('/api/questions' + query).then(function (r) { return r.json(); }).then(render);
+        }
+
+        refresh();
+        setInterval(refresh, 3000);
+    </script>
+</body>
+</html>
+`
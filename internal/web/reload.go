@@ -0,0 +1,142 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"os"
+	"time"
+)
+
+// reloadInterval is how often the deck's JSON is checked for changes
+const reloadInterval = 500 * time.Millisecond
+
+// Watch reloads the browsers showing the deck whenever its JSON file changes,
+// until ctx is done. It only has an effect when LiveReload is set.
+func (s *DeckServer) Watch(ctx context.Context) {
+	if !s.LiveReload {
+		return
+	}
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	last := modTime(s.path)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if current := modTime(s.path); !current.Equal(last) {
+			last = current
+			s.notifyReload()
+		}
+	}
+}
+
+// modTime returns the modification time of the file at path, or the zero time
+// when it cannot be read, e.g. while an editor replaces it
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// notifyReload tells every browser watching for changes to reload
+func (s *DeckServer) notifyReload() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ch := range s.reloaders {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// A reload is already pending for this browser
+		}
+	}
+}
+
+// handleReloadEvents streams a server-sent event whenever the deck changes
+func (s *DeckServer) handleReloadEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ch := make(chan struct{}, 1)
+	s.mu.Lock()
+	s.reloaders[ch] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.reloaders, ch)
+		s.mu.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	fmt.Fprint(w, ": watching\n\n")
+	flusher.Flush()
+
+	for {
+		select {
+		case <-ch:
+			fmt.Fprint(w, "data: reload\n\n")
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// writeLoadError shows why the deck could not be loaded. With live reload the
+// page reloads once the file is fixed, so a half-saved edit doesn't end the
+// preview.
+func (s *DeckServer) writeLoadError(w http.ResponseWriter, err error) {
+	if !s.LiveReload {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusInternalServerError)
+	fmt.Fprintf(w, loadErrorPage, html.EscapeString(err.Error()), reloadScript)
+}
+
+// reloadScript reloads the page when the server reports that the deck has
+// changed. reveal.js keeps the current slide in the URL hash, so the reload
+// returns to it.
+const reloadScript = `    <script>
+        (function () {
+            if (/receiver/.test(location.search) || !window.EventSource) return;
+            new EventSource('/api/reload').onmessage = function () {
+                location.reload();
+            };
+        })();
+    </script>
+`
+
+// loadErrorPage is shown in place of the deck while its JSON is invalid
+const loadErrorPage = `<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="utf-8">
+    <title>pres: cannot load presentation</title>
+    <style>
+        body { font: 16px system-ui, sans-serif; margin: 40px; color: #222; }
+        pre { white-space: pre-wrap; background: #f6f6f6; padding: 16px; border-radius: 6px; }
+    </style>
+</head>
+<body>
+    <h1>Cannot load presentation</h1>
+    <pre>%s</pre>
+    <p>This page reloads when the file changes.</p>
+%s</body>
+</html>
+`
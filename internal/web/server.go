@@ -0,0 +1,153 @@
+package web
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/geoffjay/pres/internal/export"
+	"github.com/geoffjay/pres/internal/presentation"
+)
+
+// Server serves the web interface for a directory of presentations
+type Server struct {
+	dir    string
+	writer *presentation.Writer
+}
+
+// NewServer creates a new web server for the presentations in dir
+func NewServer(dir string) *Server {
+	return &Server{
+		dir:    dir,
+		writer: presentation.NewWriter("."),
+	}
+}
+
+// Handler returns the HTTP handler for the web interface
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleDashboard)
+	mux.HandleFunc("GET /thumbnail", s.handleThumbnail)
+	return mux
+}
+
+// handleThumbnail serves the catalog thumbnail of a deck in the library. Only
+// decks found by scanning the directory are served.
+func (s *Server) handleThumbnail(w http.ResponseWriter, r *http.Request) {
+	entries, err := s.writer.ScanPresentations(s.dir)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to scan presentations: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	path := r.URL.Query().Get("path")
+	for _, entry := range entries {
+		if entry.Path != path {
+			continue
+		}
+		thumb, err := export.Thumbnail(entry.Path, entry.Data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		http.ServeFile(w, r, thumb)
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
+// handleDashboard renders library statistics
+func (s *Server) handleDashboard(w http.ResponseWriter, r *http.Request) {
+	entries, err := s.writer.ScanPresentations(s.dir)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to scan presentations: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	page := struct {
+		Dir   string
+		Stats presentation.LibraryStats
+	}{
+		Dir:   s.dir,
+		Stats: presentation.GetLibraryStats(entries),
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := dashboardTemplate.Execute(w, page); err != nil {
+		http.Error(w, fmt.Sprintf("failed to render dashboard: %v", err), http.StatusInternalServerError)
+	}
+}
+
+var dashboardTemplate = template.Must(template.New("dashboard").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>pres - Dashboard</title>
+    <style>
+        body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 960px; color: #222; }
+        h1 { margin-bottom: 0.25rem; }
+        .subtitle { color: #666; margin-top: 0; }
+        .cards { display: grid; grid-template-columns: repeat(3, 1fr); gap: 1rem; margin: 1.5rem 0; }
+        .card { border: 1px solid #ddd; border-radius: 6px; padding: 1rem; }
+        .card .value { font-size: 2rem; font-weight: bold; }
+        table { border-collapse: collapse; width: 100%; margin-bottom: 2rem; }
+        th, td { text-align: left; padding: 0.4rem 0.6rem; border-bottom: 1px solid #eee; }
+        th { color: #666; font-weight: normal; }
+        .empty { color: #999; }
+        .thumb { width: 120px; height: auto; display: block; border-radius: 3px; box-shadow: 0 1px 3px rgba(0, 0, 0, 0.3); }
+    </style>
+</head>
+<body>
+    <h1>Presentation Library</h1>
+    <p class="subtitle">{{.Dir}}</p>
+
+    <div class="cards">
+        <div class="card"><div class="value">{{.Stats.Decks}}</div>presentations</div>
+        <div class="card"><div class="value">{{.Stats.Slides}}</div>slides</div>
+        <div class="card"><div class="value">{{printf "%.1f" .Stats.AverageSlides}}</div>slides per deck</div>
+    </div>
+
+    <h2>Recent activity</h2>
+    {{if .Stats.Recent}}
+    <table>
+        <tr><th></th><th>Title</th><th>Status</th><th>Slides</th><th>Modified</th><th>Path</th></tr>
+        {{range .Stats.Recent}}
+        <tr>
+            <td>{{if .Data.Slides}}<img class="thumb" src="/thumbnail?path={{.Path}}" alt="" loading="lazy">{{end}}</td>
+            <td>{{.Data.Metadata.Title}}</td>
+            <td>{{.Data.Metadata.GetStatus}}</td>
+            <td>{{len .Data.Slides}}</td>
+            <td>{{.Data.Metadata.Modified.Format "2006-01-02 15:04"}}</td>
+            <td>{{.Path}}</td>
+        </tr>
+        {{end}}
+    </table>
+    {{else}}
+    <p class="empty">No presentations found.</p>
+    {{end}}
+
+    <h2>Decks per tag</h2>
+    {{if .Stats.Tags}}
+    <table>
+        <tr><th>Tag</th><th>Decks</th></tr>
+        {{range .Stats.Tags}}<tr><td>{{.Tag}}</td><td>{{.Count}}</td></tr>{{end}}
+    </table>
+    {{else}}
+    <p class="empty">No tags.</p>
+    {{end}}
+
+    <h2>Most reused slides</h2>
+    {{if .Stats.SharedSlides}}
+    <table>
+        <tr><th>Slide</th><th>References</th></tr>
+        {{range .Stats.SharedSlides}}<tr><td>{{.Ref}}</td><td>{{.Count}}</td></tr>{{end}}
+    </table>
+    {{else}}
+    <p class="empty">No shared slides.</p>
+    {{end}}
+</body>
+</html>
+`))
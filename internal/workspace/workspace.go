@@ -0,0 +1,182 @@
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFile is the name of the file marking a project's root directory
+const ConfigFile = "pres.yaml"
+
+// Config is a project's configuration, read from ConfigFile. Directories are
+// relative to the project root.
+type Config struct {
+	Name          string `yaml:"name"`
+	Presentations string `yaml:"presentations"`
+	Templates     string `yaml:"templates"`
+	Assets        string `yaml:"assets"`
+}
+
+// DefaultConfig returns the configuration of a new project with the given
+// name
+func DefaultConfig(name string) Config {
+	return Config{
+		Name:          name,
+		Presentations: "presentations",
+		Templates:     "templates",
+		Assets:        "assets",
+	}
+}
+
+// Workspace is a pres project: a directory holding its configuration and
+// folders for presentations, templates, and shared assets
+type Workspace struct {
+	Root   string
+	Config Config
+}
+
+// Find returns the project containing dir, found by looking for ConfigFile in
+// dir and each of its parents, as git finds a repository. It returns nil
+// when dir is not inside a project.
+func Find(dir string) (*Workspace, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		_, err := os.Stat(filepath.Join(dir, ConfigFile))
+		if err == nil {
+			return Load(dir)
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// Load reads the project whose root is dir. Directories the configuration
+// leaves out get their default names.
+func Load(dir string) (*Workspace, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, ConfigFile))
+	if err != nil {
+		return nil, err
+	}
+
+	config := DefaultConfig(filepath.Base(dir))
+	if err := yaml.Unmarshal(raw, &config); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", filepath.Join(dir, ConfigFile), err)
+	}
+	for _, folder := range []string{config.Presentations, config.Templates, config.Assets} {
+		if filepath.IsAbs(folder) || strings.HasPrefix(filepath.Clean(folder), "..") {
+			return nil, fmt.Errorf("invalid %s: directory %q must be inside the project", filepath.Join(dir, ConfigFile), folder)
+		}
+	}
+
+	return &Workspace{Root: dir, Config: config}, nil
+}
+
+// Init creates a project in dir, creating dir if needed: the configuration
+// file and empty presentations, templates, and assets folders. It fails if
+// dir already holds a project.
+func Init(dir, name string) (*Workspace, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, ConfigFile)); err == nil {
+		return nil, fmt.Errorf("%s is already a pres project", dir)
+	}
+	if name == "" {
+		name = filepath.Base(dir)
+	}
+
+	ws := &Workspace{Root: dir, Config: DefaultConfig(name)}
+	for _, folder := range ws.Folders() {
+		if err := os.MkdirAll(folder, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create %s: %w", folder, err)
+		}
+		// Keep the empty folders in version control
+		keep := filepath.Join(folder, ".gitkeep")
+		if _, err := os.Stat(keep); errors.Is(err, os.ErrNotExist) {
+			if err := os.WriteFile(keep, nil, 0644); err != nil {
+				return nil, fmt.Errorf("failed to create %s: %w", keep, err)
+			}
+		}
+	}
+
+	config, err := yaml.Marshal(ws.Config)
+	if err != nil {
+		return nil, err
+	}
+	header := "# pres project configuration; directories are relative to this file\n"
+	if err := os.WriteFile(filepath.Join(dir, ConfigFile), append([]byte(header), config...), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", ConfigFile, err)
+	}
+
+	return ws, nil
+}
+
+// Folders returns the project's presentations, templates, and assets
+// directories
+func (ws *Workspace) Folders() []string {
+	return []string{ws.PresentationsDir(), ws.TemplatesDir(), ws.AssetsDir()}
+}
+
+// PresentationsDir returns the directory holding the project's presentations
+func (ws *Workspace) PresentationsDir() string {
+	return filepath.Join(ws.Root, ws.Config.Presentations)
+}
+
+// TemplatesDir returns the directory holding the project's HTML templates
+func (ws *Workspace) TemplatesDir() string {
+	return filepath.Join(ws.Root, ws.Config.Templates)
+}
+
+// AssetsDir returns the directory holding media shared by the project's
+// presentations
+func (ws *Workspace) AssetsDir() string {
+	return filepath.Join(ws.Root, ws.Config.Assets)
+}
+
+// Resolve finds a file given relative to the project root. A path that
+// exists relative to the working directory, or is absolute, is returned
+// unchanged, so paths keep working as typed; otherwise the path under the
+// root is returned if it exists there.
+func (ws *Workspace) Resolve(path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+	if _, err := os.Stat(filepath.Join(ws.Root, path)); err == nil {
+		return Relative(filepath.Join(ws.Root, path))
+	}
+	return path
+}
+
+// Relative returns path relative to the working directory, or unchanged
+// when it can't be made relative
+func Relative(path string) string {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(cwd, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}